@@ -0,0 +1,220 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// apiKeyRow is an API key as returned by /authz/api-keys.
+type apiKeyRow struct {
+	KeyId         string     `json:"keyId"`
+	Name          string     `json:"name,omitempty"`
+	IdentityId    string     `json:"identityId"`
+	ResourceTypes []string   `json:"resourceTypes"`
+	Actions       []string   `json:"actions"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+}
+
+// newAPIKeyCmd builds the "apikey" command managing API keys for machine
+// clients such as sensors and CI pipelines.
+func newAPIKeyCmd() *cobra.Command {
+	apiKeyCmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "Manage API keys bound to Identities",
+	}
+	apiKeyCmd.AddCommand(newAPIKeyCreateCmd())
+	apiKeyCmd.AddCommand(newAPIKeyListCmd())
+	apiKeyCmd.AddCommand(newAPIKeyRevokeCmd())
+	return apiKeyCmd
+}
+
+func newAPIKeyCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an API key; the token is shown only once",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			identity, _ := cmd.Flags().GetString("identity")
+			id, err := uuid.Parse(identity)
+			if err != nil {
+				return fmt.Errorf("invalid --identity %q: %w", identity, err)
+			}
+			body := map[string]any{"identityId": id}
+			if name, _ := cmd.Flags().GetString("name"); name != "" {
+				body["name"] = name
+			}
+			if types, _ := cmd.Flags().GetStringSlice("types"); len(types) > 0 {
+				body["resourceTypes"] = types
+			}
+			if actions, _ := cmd.Flags().GetStringSlice("actions"); len(actions) > 0 {
+				body["actions"] = actions
+			}
+			if expires, _ := cmd.Flags().GetString("expires"); expires != "" {
+				t, err := parseUntil(expires, time.Now())
+				if err != nil {
+					return fmt.Errorf("invalid --expires %q: expected RFC 3339 time or duration", expires)
+				}
+				body["expiresAt"] = t.UTC().Format(time.RFC3339)
+			}
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			var created struct {
+				Key   apiKeyRow `json:"key"`
+				Token string    `json:"token"`
+			}
+			if err := sendJSON(http.MethodPost, base+"/authz/api-keys", body, http.StatusCreated, &created); err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if _, err := fmt.Fprintf(out, "created api key %s for identity %s\n", created.Key.KeyId, created.Key.IdentityId); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(out, "token (shown only once): %s\n", created.Token)
+			return err
+		},
+	}
+	cmd.Flags().String("identity", "", "Id of the Identity the key acts as")
+	_ = cmd.MarkFlagRequired("identity")
+	cmd.Flags().String("name", "", "Name describing the key's use")
+	cmd.Flags().StringSlice("types", nil, "Resource types the key may access (default all)")
+	cmd.Flags().StringSlice("actions", nil, "Permitted actions: read, write, push or * (default read)")
+	cmd.Flags().String("expires", "", "Expiry as RFC 3339 time or duration from now (e.g. 720h); default never")
+	return cmd
+}
+
+func newAPIKeyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List API keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			var rows []apiKeyRow
+			if err := getJSON(base+"/authz/api-keys", &rows); err != nil {
+				return err
+			}
+			return renderAPIKeys(cmd, outputFormat, rows)
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	return cmd
+}
+
+func newAPIKeyRevokeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <key-id>",
+		Short: "Revoke an API key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			if err := sendJSON(http.MethodDelete, base+"/authz/api-keys/"+url.PathEscape(args[0]), nil, http.StatusNoContent, nil); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "api key %s revoked\n", args[0])
+			return err
+		},
+	}
+}
+
+// sendJSON sends body (if any) as JSON and decodes the response into out (if
+// any). Responses other than want are reported with the server's message.
+func sendJSON(method, u string, body any, want int, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, u, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != want {
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil && msg != "" {
+			return fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
+		}
+		return fmt.Errorf("expected HTTP %d but received %d", want, resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+func renderAPIKeys(cmd *cobra.Command, format string, rows []apiKeyRow) error {
+	if format == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "ID\tNAME\tIDENTITY\tACTIONS\tTYPES\tEXPIRES"); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		types, expires := "*", "never"
+		if len(r.ResourceTypes) > 0 {
+			types = strings.Join(r.ResourceTypes, ",")
+		}
+		if r.ExpiresAt != nil {
+			expires = r.ExpiresAt.Format(time.RFC3339)
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", r.KeyId, r.Name, r.IdentityId, strings.Join(r.Actions, ","), types, expires); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
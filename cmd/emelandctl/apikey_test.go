@@ -0,0 +1,77 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIdentityID = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+
+func TestAPIKeyCreatePrintsToken(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/authz/api-keys", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"key":{"keyId":"k1","identityId":"` + testIdentityID + `","resourceTypes":[],"actions":["push"],"createdAt":"2026-01-01T00:00:00Z"},"token":"emk_k1_secret"}`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("apikey", "create", "--identity", testIdentityID, "--actions", "push", "--types", "ApiInstance,System", "--expires", "24h", "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, testIdentityID, body["identityId"])
+	assert.Equal(t, []any{"push"}, body["actions"])
+	assert.Equal(t, []any{"ApiInstance", "System"}, body["resourceTypes"])
+	assert.NotEmpty(t, body["expiresAt"])
+	assert.Contains(t, out, "created api key k1")
+	assert.Contains(t, out, "emk_k1_secret")
+}
+
+func TestAPIKeyListTable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/authz/api-keys", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"keyId":"k1","name":"ci","identityId":"` + testIdentityID + `","resourceTypes":[],"actions":["read","push"],"createdAt":"2026-01-01T00:00:00Z"}]`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("apikey", "list", "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Contains(t, out, "k1")
+	assert.Contains(t, out, "read,push")
+	assert.Contains(t, out, "never")
+}
+
+func TestAPIKeyRevokeReportsServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/authz/api-keys/k1", r.URL.Path)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`"not allowed to manage api keys of this identity"`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("apikey", "revoke", "k1", "--server", srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP 403: not allowed")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newFilterCmd builds the "filter" command used to switch filters of the
+// event filter chain on and off.
+func newFilterCmd() *cobra.Command {
+	filterCmd := &cobra.Command{
+		Use:   "filter",
+		Short: "Enable or disable filters of the event filter chain",
+	}
+	filterCmd.AddCommand(newFilterEnabledCmd("enable", true, "Enable the filter behind a filter rule"))
+	filterCmd.AddCommand(newFilterEnabledCmd("disable", false, "Disable the filter behind a filter rule; events pass it unchanged"))
+	return filterCmd
+}
+
+func newFilterEnabledCmd(use string, enabled bool, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <rule-id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid rule id %q: %w", args[0], err)
+			}
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			name, err := putFilterEnabled(base, id, enabled)
+			if err != nil {
+				return err
+			}
+			state := "enabled"
+			if !enabled {
+				state = "disabled"
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "filter %s (%s) is %s\n", name, id, state)
+			return err
+		},
+	}
+}
+
+func putFilterEnabled(base string, id uuid.UUID, enabled bool) (string, error) {
+	payload, err := json.Marshal(map[string]bool{"enabled": enabled})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/landscape/filter-rules/%s/enabled", base, id), bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil && msg != "" {
+			return "", fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
+		}
+		return "", fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode)
+	}
+	var rule struct {
+		DisplayName string `json:"displayName"`
+	}
+	if err := json.Unmarshal(raw, &rule); err != nil {
+		return "", fmt.Errorf("decoding response: %w", err)
+	}
+	return rule.DisplayName, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRuleID = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+
+func TestFilterDisablePutsState(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/landscape/filter-rules/"+testRuleID+"/enabled", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"ruleId":"` + testRuleID + `","displayName":"Phase 0","disabled":true}`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("filter", "disable", testRuleID, "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, false, body["enabled"])
+	assert.Contains(t, out, "filter Phase 0 ("+testRuleID+") is disabled")
+}
+
+func TestFilterEnableUnknownRule(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`"filter rule ` + testRuleID + ` not found"`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("filter", "enable", testRuleID, "--server", srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,374 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// findingRow is the subset of a FindingView shown by "finding list".
+type findingRow struct {
+	FindingId   string `json:"findingId"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	FindingType struct {
+		DisplayName string `json:"displayName"`
+		Severity    string `json:"severity,omitempty"`
+	} `json:"findingType"`
+	Triage *struct {
+		Reason          string     `json:"reason,omitempty"`
+		By              string     `json:"by,omitempty"`
+		SuppressedUntil *time.Time `json:"suppressedUntil,omitempty"`
+	} `json:"triage,omitempty"`
+}
+
+// newFindingCmd builds the "finding" command used to list and triage findings.
+func newFindingCmd() *cobra.Command {
+	findingCmd := &cobra.Command{
+		Use:   "finding",
+		Short: "List findings and change their triage status",
+	}
+
+	findingCmd.AddCommand(newFindingListCmd())
+	findingCmd.AddCommand(newFindingSummaryCmd())
+	findingCmd.AddCommand(newFindingHealthCmd())
+	findingCmd.AddCommand(newFindingStatusCmd("ack", "acknowledged", "Acknowledge a finding"))
+	findingCmd.AddCommand(newFindingStatusCmd("suppress", "suppressed", "Suppress a finding, optionally until a point in time"))
+	findingCmd.AddCommand(newFindingStatusCmd("resolve", "resolved", "Mark a finding as resolved; a re-detection reopens it"))
+	findingCmd.AddCommand(newFindingStatusCmd("reopen", "open", "Reopen a finding"))
+
+	return findingCmd
+}
+
+func newFindingListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List findings with their status and severity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			status, _ := cmd.Flags().GetString("status")
+			severity, _ := cmd.Flags().GetString("severity")
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			q := url.Values{}
+			if status != "" {
+				q.Set("status", status)
+			}
+			if severity != "" {
+				q.Set("severity", severity)
+			}
+			path := "/landscape/findings"
+			if len(q) > 0 {
+				path += "?" + q.Encode()
+			}
+			rows, err := fetchFindings(base + path)
+			if err != nil {
+				return fmt.Errorf("fetching findings: %w", err)
+			}
+			return renderFindings(cmd, outputFormat, rows)
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	cmd.Flags().String("status", "", "Only list findings with this status (open, acknowledged, suppressed, resolved)")
+	cmd.Flags().String("severity", "", "Only list findings of at least this severity (info, low, medium, high, critical)")
+	return cmd
+}
+
+// summaryBucket and findingSummary mirror the FindingSummary API schema.
+type summaryBucket struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Count       int    `json:"count"`
+}
+
+type findingSummary struct {
+	Total          int             `json:"total"`
+	ByStatus       []summaryBucket `json:"byStatus"`
+	ByFindingType  []summaryBucket `json:"byFindingType"`
+	BySeverity     []summaryBucket `json:"bySeverity"`
+	ByOwnerGroup   []summaryBucket `json:"byOwnerGroup"`
+	ByContext      []summaryBucket `json:"byContext"`
+	ByResourceType []summaryBucket `json:"byResourceType"`
+}
+
+// resourceHealth mirrors the ResourceHealth API schema.
+type resourceHealth struct {
+	Resource struct {
+		Id           string `json:"id"`
+		ResourceType string `json:"resourceType"`
+		DisplayName  string `json:"displayName,omitempty"`
+	} `json:"resource"`
+	Healthy        bool         `json:"healthy"`
+	WorstSeverity  string       `json:"worstSeverity,omitempty"`
+	ActiveFindings int          `json:"activeFindings"`
+	Findings       []findingRow `json:"findings"`
+}
+
+func newFindingSummaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Count findings by status, type, severity, owner group, context and resource type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			status, _ := cmd.Flags().GetString("status")
+			severity, _ := cmd.Flags().GetString("severity")
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			q := url.Values{}
+			if status != "" {
+				q.Set("status", status)
+			}
+			if severity != "" {
+				q.Set("severity", severity)
+			}
+			path := "/landscape/findings/summary"
+			if len(q) > 0 {
+				path += "?" + q.Encode()
+			}
+			var summary findingSummary
+			if err := getJSON(base+path, &summary); err != nil {
+				return fmt.Errorf("fetching finding summary: %w", err)
+			}
+			return renderFindingSummary(cmd, outputFormat, summary)
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	cmd.Flags().String("status", "", "Only count findings with this status (open, acknowledged, suppressed, resolved)")
+	cmd.Flags().String("severity", "", "Only count findings of at least this severity (info, low, medium, high, critical)")
+	return cmd
+}
+
+func newFindingHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health <resource-id>",
+		Short: "Show all findings citing a resource",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid resource id %q: %w", args[0], err)
+			}
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			var health resourceHealth
+			if err := getJSON(fmt.Sprintf("%s/landscape/resources/%s/health", base, id), &health); err != nil {
+				return fmt.Errorf("fetching resource health: %w", err)
+			}
+			if outputFormat == "json" {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(health)
+			}
+			verdict := "healthy"
+			if !health.Healthy {
+				verdict = fmt.Sprintf("unhealthy (%d active, worst severity %s)", health.ActiveFindings, health.WorstSeverity)
+			}
+			if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s %s (%s): %s\n", health.Resource.ResourceType, health.Resource.DisplayName, health.Resource.Id, verdict); err != nil {
+				return err
+			}
+			if len(health.Findings) == 0 {
+				return nil
+			}
+			return renderFindings(cmd, outputFormat, health.Findings)
+		},
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	return cmd
+}
+
+func newFindingStatusCmd(use, status, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " <finding-id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid finding id %q: %w", args[0], err)
+			}
+			base, err := serverURL()
+			if err != nil {
+				return err
+			}
+			body := map[string]any{"status": status}
+			if reason, _ := cmd.Flags().GetString("reason"); reason != "" {
+				body["reason"] = reason
+			}
+			if by, _ := cmd.Flags().GetString("by"); by != "" {
+				body["by"] = by
+			}
+			if until, _ := cmd.Flags().GetString("until"); until != "" {
+				t, err := parseUntil(until, time.Now())
+				if err != nil {
+					return err
+				}
+				body["suppressedUntil"] = t.UTC().Format(time.RFC3339)
+			}
+			row, err := postFindingStatus(base, id, body)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "finding %s is %s\n", row.FindingId, row.Status)
+			return err
+		},
+	}
+	cmd.Flags().String("reason", "", "Why the status changes")
+	cmd.Flags().String("by", "", "Who changes the status (ignored when the server authenticates the caller)")
+	if status == "suppressed" {
+		cmd.Flags().String("until", "", "End of the suppression as RFC 3339 time or duration from now (e.g. 72h)")
+	}
+	return cmd
+}
+
+// parseUntil accepts an RFC 3339 timestamp or a Go duration relative to now.
+func parseUntil(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --until %q: expected RFC 3339 time or duration", s)
+	}
+	return now.Add(d), nil
+}
+
+func fetchFindings(u string) ([]findingRow, error) {
+	var rows []findingRow
+	if err := getJSON(u, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func getJSON(u string, out any) error {
+	resp, err := httpClient().Get(u)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		var msg string
+		if json.NewDecoder(resp.Body).Decode(&msg) == nil && msg != "" {
+			return errors.New(msg)
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+func postFindingStatus(base string, id uuid.UUID, body map[string]any) (findingRow, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return findingRow{}, err
+	}
+	resp, err := httpClient().Post(fmt.Sprintf("%s/landscape/findings/%s/status", base, id), "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return findingRow{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return findingRow{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil && msg != "" {
+			return findingRow{}, fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
+		}
+		return findingRow{}, fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode)
+	}
+	var row findingRow
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return findingRow{}, fmt.Errorf("decoding response: %w", err)
+	}
+	return row, nil
+}
+
+func renderFindings(cmd *cobra.Command, format string, rows []findingRow) error {
+	if format == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "ID\tSEVERITY\tSTATUS\tTYPE\tNAME"); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.FindingId, r.FindingType.Severity, r.Status, r.FindingType.DisplayName, r.DisplayName); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func renderFindingSummary(cmd *cobra.Command, format string, s findingSummary) error {
+	if format == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintf(w, "TOTAL\t%d\n\nDIMENSION\tKEY\tNAME\tCOUNT\n", s.Total); err != nil {
+		return err
+	}
+	for _, dim := range []struct {
+		name    string
+		buckets []summaryBucket
+	}{
+		{"status", s.ByStatus},
+		{"severity", s.BySeverity},
+		{"type", s.ByFindingType},
+		{"owner-group", s.ByOwnerGroup},
+		{"context", s.ByContext},
+		{"resource-type", s.ByResourceType},
+	} {
+		for _, b := range dim.buckets {
+			key := b.Key
+			if key == "" {
+				key = "-"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", dim.name, key, b.DisplayName, b.Count); err != nil {
+				return err
+			}
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,153 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFindingID = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+
+func TestFindingListPassesFilters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/landscape/findings", r.URL.Path)
+		assert.Equal(t, "open", r.URL.Query().Get("status"))
+		assert.Equal(t, "high", r.URL.Query().Get("severity"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"findingId":"` + testFindingID + `","displayName":"Product lifecycle check","status":"open","findingType":{"displayName":"ProductVersionTerminated","severity":"critical"},"resources":[],"reference":"x"}]`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("finding", "list", "--server", srv.URL, "--status", "open", "--severity", "high")
+	require.NoError(t, err)
+	assert.Contains(t, out, "SEVERITY")
+	assert.Contains(t, out, "critical")
+	assert.Contains(t, out, "ProductVersionTerminated")
+	assert.Contains(t, out, testFindingID)
+}
+
+func TestFindingAckPostsStatus(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/landscape/findings/"+testFindingID+"/status", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"findingId":"` + testFindingID + `","displayName":"x","status":"acknowledged"}`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("finding", "ack", testFindingID, "--server", srv.URL, "--reason", "on it", "--by", "alice")
+	require.NoError(t, err)
+	assert.Contains(t, out, "is acknowledged")
+	assert.Equal(t, "acknowledged", body["status"])
+	assert.Equal(t, "on it", body["reason"])
+	assert.Equal(t, "alice", body["by"])
+}
+
+func TestFindingSuppressUntil(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"findingId":"` + testFindingID + `","status":"suppressed"}`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("finding", "suppress", testFindingID, "--server", srv.URL, "--until", "2030-01-02T03:04:05Z")
+	require.NoError(t, err)
+	assert.Equal(t, "suppressed", body["status"])
+	assert.Equal(t, "2030-01-02T03:04:05Z", body["suppressedUntil"])
+}
+
+func TestFindingStatusErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`"finding ` + testFindingID + ` not found"`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("finding", "resolve", testFindingID, "--server", srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = executeCmdOut("finding", "reopen", "not-a-uuid", "--server", srv.URL)
+	require.Error(t, err)
+}
+
+func TestParseUntil(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := parseUntil("72h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(72*time.Hour), got)
+
+	_, err = parseUntil("next week", now)
+	assert.Error(t, err)
+}
+
+func TestFindingSummaryTable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/landscape/findings/summary", r.URL.Path)
+		assert.Equal(t, "open", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total":3,"byStatus":[{"key":"open","count":3}],"byFindingType":[],"bySeverity":[{"key":"high","count":2},{"key":"","count":1}],` +
+			`"byOwnerGroup":[{"key":"team-a","count":3}],"byContext":[{"key":"c1","displayName":"Payments","count":3}],"byResourceType":[{"key":"System","count":3}]}`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("finding", "summary", "--server", srv.URL, "--status", "open")
+	require.NoError(t, err)
+	assert.Contains(t, out, "TOTAL")
+	assert.Regexp(t, `severity\s+high\s+2`, out)
+	assert.Regexp(t, `severity\s+-\s+1`, out)
+	assert.Regexp(t, `context\s+c1\s+Payments\s+3`, out)
+	assert.Regexp(t, `owner-group\s+team-a\s+3`, out)
+}
+
+func TestFindingHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/landscape/resources/"+testFindingID+"/health", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"resource":{"id":"` + testFindingID + `","resourceType":"System","displayName":"billing"},"healthy":false,"worstSeverity":"high","activeFindings":1,` +
+			`"findings":[{"findingId":"f1","displayName":"Check","status":"open","findingType":{"displayName":"OwnerMissing","severity":"high"}}]}`))
+	}))
+	defer srv.Close()
+
+	out, err := executeCmdOut("finding", "health", testFindingID, "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Contains(t, out, "System billing")
+	assert.Contains(t, out, "unhealthy (1 active, worst severity high)")
+	assert.Contains(t, out, "OwnerMissing")
+}
+
+func TestFindingHealthNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`"resource ` + testFindingID + ` not found"`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("finding", "health", testFindingID, "--server", srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -46,7 +46,7 @@ func renderInstanceList(cmd *cobra.Command, format string, items []common.Instan
 }
 
 func fetchResourceList(baseURL, path string) ([]common.InstanceListItem, error) {
-	resp, err := http.Get(baseURL + path)
+	resp, err := httpClient().Get(baseURL + path)
 	if err != nil {
 		return nil, err
 	}
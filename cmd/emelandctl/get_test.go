@@ -94,3 +94,33 @@ func TestGetFindingsNoServer(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "server URL required")
 }
+
+func TestGetSendsBearerToken(t *testing.T) {
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("get", "findings", "--server", srv.URL, "--token", "abc.def.ghi")
+	require.NoError(t, err)
+	_, err = executeCmdOut("get", "findings", "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer abc.def.ghi", ""}, auth)
+}
+
+func TestGetSendsTenant(t *testing.T) {
+	var tenants []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get("X-Tenant"))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	_, err := executeCmdOut("get", "findings", "--server", srv.URL, "--tenant", "payments")
+	require.NoError(t, err)
+	_, err = executeCmdOut("get", "findings", "--server", srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"payments", ""}, tenants)
+}
@@ -17,6 +17,7 @@ package main
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,10 +46,20 @@ func newRootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.modelsrv.yaml)")
 	rootCmd.PersistentFlags().String("server", "", "EmELand server base URL (e.g. http://localhost:8082)")
 	_ = viper.BindPFlag("server.url", rootCmd.PersistentFlags().Lookup("server"))
+	rootCmd.PersistentFlags().String("token", "", "Bearer token sent to the server (or EMELAND_TOKEN)")
+	_ = viper.BindPFlag("server.token", rootCmd.PersistentFlags().Lookup("token"))
+	_ = viper.BindEnv("server.token", "EMELAND_TOKEN")
+	rootCmd.PersistentFlags().String("tenant", "", "Tenant whose landscape is addressed (or EMELAND_TENANT)")
+	_ = viper.BindPFlag("server.tenant", rootCmd.PersistentFlags().Lookup("tenant"))
+	_ = viper.BindEnv("server.tenant", "EMELAND_TENANT")
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 
 	rootCmd.AddCommand(newCreateCmd())
 	rootCmd.AddCommand(newGetCmd())
+	rootCmd.AddCommand(newFindingCmd())
+	rootCmd.AddCommand(newFilterCmd())
+	rootCmd.AddCommand(newAPIKeyCmd())
+	rootCmd.AddCommand(newValidateCmd())
 
 	return rootCmd
 }
@@ -89,6 +100,35 @@ func serverURL() (string, error) {
 	return strings.TrimRight(u, "/") + "/api", nil
 }
 
+// httpClient returns the client for server requests. When a token is
+// configured it is sent as "Authorization: Bearer", when a tenant is
+// configured it is sent as "X-Tenant" on every request.
+func httpClient() *http.Client {
+	token := viper.GetString("server.token")
+	tenant := viper.GetString("server.tenant")
+	if token == "" && tenant == "" {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: serverTransport{token: token, tenant: tenant, next: http.DefaultTransport}}
+}
+
+type serverTransport struct {
+	token  string
+	tenant string
+	next   http.RoundTripper
+}
+
+func (t serverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	if t.tenant != "" {
+		req.Header.Set("X-Tenant", t.tenant)
+	}
+	return t.next.RoundTrip(req)
+}
+
 // Execute builds the command tree and runs it. Called by main.
 func Execute() {
 	if err := newRootCmd().Execute(); err != nil {
@@ -0,0 +1,130 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/validation"
+)
+
+// newValidateCmd builds the "validate" command, a dry run of landscape files.
+func newValidateCmd() *cobra.Command {
+	var paths []string
+	var strict bool
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Dry-run landscape files and report mistakes",
+		Long: `Apply landscape files (YAML, JSON, CSV) to a scratch landscape with the filters
+the server runs and report per file the parse errors, unknown kinds, invalid
+documents, dangling references and findings applying them would raise.
+
+When a server is configured (--server), the files are compared with its
+landscape: resources are reported as created, updated or unchanged. What a
+pruning Source would delete is only known for the Sources of a sensor config;
+use "modelsrv validate --sensor-config" for that.
+With --strict, keys a document's kind does not read are parse errors.
+Nothing is written to the server. The command fails when a file has a problem.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, _ := cmd.Flags().GetString("output")
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("unknown output format %q; use text or json", outputFormat)
+			}
+			sources, err := pathSources(paths, strict)
+			if err != nil {
+				return err
+			}
+			files, err := validation.ReadSources(cmd.Context(), sources)
+			if err != nil {
+				return err
+			}
+			opts := validation.Options{}
+			if viper.GetString("server.url") != "" {
+				base, err := serverURL()
+				if err != nil {
+					return err
+				}
+				opts.Baseline = validation.ServerBaseline{URL: base, Client: httpClient()}
+			}
+			report, err := validation.Run(files, opts)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(report); err != nil {
+					return err
+				}
+			} else if err := report.WriteText(cmd.OutOrStdout()); err != nil {
+				return err
+			}
+			if n := report.Problems(); n > 0 {
+				return fmt.Errorf("validation found %d problem(s)", n)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&paths, "filename", "f", nil, "File or directory to validate (repeatable)")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Reject document and spec keys the document's kind does not read")
+	cmd.Flags().StringP("output", "o", "text", "Output format: text or json")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+// pathSources turns files and directories into local Sources; a file becomes
+// a Source of its directory listing only that file. strict turns on strict
+// parsing for every file.
+func pathSources(paths []string, strict bool) ([]filesensor.OpenSource, error) {
+	out := make([]filesensor.OpenSource, 0, len(paths))
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		var src filesensor.Source = filesensor.NewLocalSource(p)
+		if !info.IsDir() {
+			src = singleFileSource{LocalSource: filesensor.NewLocalSource(filepath.Dir(p)), name: filepath.Base(p)}
+		}
+		out = append(out, filesensor.OpenSource{
+			Config: filesensor.SourceConfig{URI: p, Strict: strict},
+			Source: src,
+			Parser: filesensor.StaticParserConfig{Opts: ingress.ParseOptions{Strict: strict}},
+		})
+	}
+	return out, nil
+}
+
+// singleFileSource lists one file of a local directory.
+type singleFileSource struct {
+	*filesensor.LocalSource
+	name string
+}
+
+func (s singleFileSource) List(context.Context) ([]filesensor.FileMeta, error) {
+	return []filesensor.FileMeta{{Name: s.name}}, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright © 2025 Lutz Behnke
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validateProdID    = "22222222-2222-2222-2222-222222222222"
+	validateStagingID = "33333333-3333-3333-3333-333333333333"
+)
+
+func writeLandscapeFile(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	p := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(p, []byte(body), 0o644))
+	return p
+}
+
+func TestValidateReportsProblemsPerFile(t *testing.T) {
+	dir := t.TempDir()
+	writeLandscapeFile(t, dir, "contexts.yaml", "version: emeland.io/v1\nkind: Context\nspec:\n  contextId: \""+validateProdID+"\"\n  displayName: Production\n  parent: \""+validateStagingID+"\"\n")
+	writeLandscapeFile(t, dir, "broken.json", "{")
+
+	out, err := executeCmdOut("validate", "-f", dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "2 problem(s)")
+	assert.Contains(t, out, "ParseError")
+	assert.Contains(t, out, "DanglingReference")
+	assert.Contains(t, out, "document 0")
+}
+
+func TestValidateComparesWithServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/landscape/contexts":
+			_ = json.NewEncoder(w).Encode([]map[string]string{
+				{"instanceId": validateProdID, "displayName": "Production"},
+				{"instanceId": validateStagingID, "displayName": "Staging"},
+			})
+		case "/api/landscape/contexts/" + validateProdID:
+			_ = json.NewEncoder(w).Encode(map[string]string{"contextId": validateProdID, "displayName": "Production"})
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer srv.Close()
+
+	file := writeLandscapeFile(t, t.TempDir(), "prod.yaml", "version: emeland.io/v1\nkind: Context\nspec:\n  contextId: \""+validateProdID+"\"\n  displayName: Production\n  parent: \""+validateStagingID+"\"\n")
+
+	out, err := executeCmdOut("validate", "-f", file, "--server", srv.URL, "-o", "json")
+	require.NoError(t, err, out)
+	var report struct {
+		Files []struct {
+			Name    string `json:"name"`
+			Changes []struct {
+				Op string `json:"op"`
+				Id string `json:"id"`
+			} `json:"changes"`
+		} `json:"files"`
+		Deletes []struct {
+			Op string `json:"op"`
+			Id string `json:"id"`
+		} `json:"deletes"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.Len(t, report.Files, 1)
+	assert.Equal(t, "prod.yaml", report.Files[0].Name)
+	require.Len(t, report.Files[0].Changes, 1)
+	assert.Equal(t, "update", report.Files[0].Changes[0].Op, "the file adds a parent")
+	assert.Empty(t, report.Deletes, "local paths carry no prune ownership")
+}
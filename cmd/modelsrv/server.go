@@ -13,11 +13,13 @@ import (
 
 	"github.com/spf13/cobra"
 	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/pkg/audit"
 	"go.emeland.io/modelsrv/pkg/authz"
 	"go.emeland.io/modelsrv/pkg/backend"
 	"go.emeland.io/modelsrv/pkg/endpoint"
 	"go.emeland.io/modelsrv/pkg/endpointprobe"
 	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/eventfilter/lifecycle"
 	"go.emeland.io/modelsrv/pkg/filesensor"
 	"go.uber.org/zap"
 )
@@ -34,7 +36,20 @@ var trustAuthHeaders bool
 var auditorIdentity string
 var auditorGroup string
 var publicResourceTypes string
+var redactAnnotations string
+var jwtIssuer string
+var jwtAudience string
+var jwtJWKSFile string
+var jwtJWKSURL string
+var jwtSubjectClaim string
+var jwtGroupsClaim string
+var apiKeyFile string
+var subscriberToken string
 var subscribersFlag string
+var auditFile string
+var auditLevel string
+var auditMaxSizeMB int
+var auditMaxBackups int
 var eventHistoryLimit int
 var otelConfigOut string
 var otelConfigDebounce time.Duration
@@ -42,6 +57,9 @@ var otelCollectionInterval time.Duration
 var otelListenAddr string
 var otelExpiryThreshold time.Duration
 var otelSubscribers []string
+var lifecycleInterval time.Duration
+var tenantsFlag string
+var tenantGroupPrefix string
 
 // serverCmd represents the server command
 var serverCmd = &cobra.Command{
@@ -72,6 +90,9 @@ func runServer(cmd *cobra.Command, _ []string) error {
 	default:
 		return fmt.Errorf("invalid log encoding %q: must be console or json", logEncoding)
 	}
+	if auditFile != "" && auditMaxSizeMB > 0 && auditMaxBackups < 1 {
+		return fmt.Errorf("invalid --audit-max-backups %d: rotation needs at least 1 backup", auditMaxBackups)
+	}
 	log, err := cfg.Build()
 	if err != nil {
 		return fmt.Errorf("logger: %w", err)
@@ -80,13 +101,15 @@ func runServer(cmd *cobra.Command, _ []string) error {
 
 	logger := log.Sugar()
 
-	b, err := backend.New(
+	tenants, err := backend.NewTenants(parseCommaSeparatedList(tenantsFlag),
 		backend.WithEventHistoryLimit(eventHistoryLimit),
 		backend.WithLogger(logger),
+		backend.WithSubscriberToken(subscriberToken),
 	)
 	if err != nil {
 		return fmt.Errorf("creating backend: %w", err)
 	}
+	b := tenants.Default()
 
 	dataPath := dataDir
 	if !filepath.IsAbs(dataPath) {
@@ -99,6 +122,7 @@ func runServer(cmd *cobra.Command, _ []string) error {
 		"dataDir", dataPath,
 		"sensorConfig", sensorConfig,
 		"otelConfigOut", otelConfigOut,
+		"tenants", tenants.Names(),
 	)
 	logger.Infof("REST API: http://%s/api", serviceAddr)
 	logger.Infof("Swagger UI: http://%s/swagger/", serviceAddr)
@@ -141,12 +165,27 @@ func runServer(cmd *cobra.Command, _ []string) error {
 		if err != nil {
 			return fmt.Errorf("filesensor: could not open sources from %s: %w", sensorConfig, err)
 		}
-		summary := filesensor.ApplySources(ctx, sources, b.GetModel(), logger)
+		defer func() { _ = filesensor.CloseSources(sources) }()
+		byTenant, err := sourcesByTenant(sources, tenants)
+		if err != nil {
+			return fmt.Errorf("filesensor: %w", err)
+		}
+		var summary filesensor.ApplySummary
+		for name, tenantSources := range byTenant {
+			tb, _ := tenants.Get(name)
+			guardSources(tenantSources, tb.GetModel())
+			s := filesensor.ApplySources(ctx, tenantSources, tb.GetModel(), logger.With("tenant", name))
+			summary.Applied += s.Applied
+			summary.Failed += s.Failed
+		}
 		if err := summary.Err(); err != nil {
 			return fmt.Errorf("filesensor: %w", err)
 		}
 		registerStartupSubscribers(b.GetEventManager(), parseCommaSeparatedList(subscribersFlag), logger)
-		filesensor.StartSources(ctx, sources, b.GetModel(), logger)
+		for name, tenantSources := range byTenant {
+			tb, _ := tenants.Get(name)
+			filesensor.StartSources(ctx, tenantSources, tb.GetModel(), logger.With("tenant", name))
+		}
 	} else {
 		logger.Info("file sensor: watching for YAML/JSON/CSV in data directory")
 		filesensor.ApplyExisting(dataPath, b.GetModel(), logger)
@@ -154,14 +193,90 @@ func runServer(cmd *cobra.Command, _ []string) error {
 		filesensor.StartWatch(ctx, dataPath, b.GetModel(), logger)
 	}
 
+	if lifecycleInterval > 0 {
+		for _, name := range tenants.Names() {
+			tb, _ := tenants.Get(name)
+			go lifecycle.Run(ctx, tb.GetModel(), lifecycleInterval)
+		}
+		logger.Infow("product lifecycle re-evaluation started", "interval", lifecycleInterval)
+	}
+
+	var bearerAuth authz.Authenticator
+	if jwtJWKSFile != "" || jwtJWKSURL != "" {
+		jwtAuth, err := authz.NewJWTAuthenticator(authz.JWTConfig{
+			Issuer:       jwtIssuer,
+			Audience:     jwtAudience,
+			JWKSFile:     jwtJWKSFile,
+			JWKSURL:      jwtJWKSURL,
+			SubjectClaim: jwtSubjectClaim,
+			GroupsClaim:  jwtGroupsClaim,
+			Leeway:       time.Minute,
+		})
+		if err != nil {
+			return fmt.Errorf("configuring JWT authentication: %w", err)
+		}
+		bearerAuth = jwtAuth
+		logger.Infow("JWT bearer authentication enabled", "issuer", jwtIssuer, "audience", jwtAudience, "trustAuthHeaders", trustAuthHeaders)
+	}
+
+	var apiKeys *authz.APIKeyStore
+	tenantAPIKeys := map[string]*authz.APIKeyStore{}
+	if apiKeyFile != "" {
+		apiKeys, err = authz.NewAPIKeyStore(apiKeyFile, b.GetModel())
+		if err != nil {
+			return fmt.Errorf("configuring API keys: %w", err)
+		}
+		logger.Infow("API key authentication enabled", "path", apiKeyFile, "keys", len(apiKeys.List()))
+		for _, name := range tenants.Names()[1:] {
+			tb, _ := tenants.Get(name)
+			path := tenantAPIKeyFile(apiKeyFile, name)
+			keys, err := authz.NewAPIKeyStore(path, tb.GetModel())
+			if err != nil {
+				return fmt.Errorf("configuring API keys of tenant %s: %w", name, err)
+			}
+			tenantAPIKeys[name] = keys
+			logger.Infow("API key authentication enabled", "tenant", name, "path", path, "keys", len(keys.List()))
+		}
+	}
+
+	redactions, err := authz.ParseRedactionRules(redactAnnotations)
+	if err != nil {
+		return fmt.Errorf("invalid --redact-annotations: %w", err)
+	}
+
+	level, err := audit.ParseLevel(auditLevel)
+	if err != nil {
+		return err
+	}
+	var auditSink *audit.FileSink
+	if auditFile != "" && level != audit.LevelOff {
+		auditSink, err = audit.NewFileSink(auditFile, int64(auditMaxSizeMB)<<20, auditMaxBackups)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = auditSink.Close() }()
+		logger.Infow("audit log enabled", "path", auditFile, "level", auditLevel)
+	}
+
 	webOpts := endpoint.WebListenerOptions{
 		TrustAuthHeaders: trustAuthHeaders,
+		Authenticator:    bearerAuth,
+		APIKeys:          apiKeys,
+		TenantAPIKeys:    tenantAPIKeys,
 		AuthzConfig: authz.Config{
-			AuditorIdentity: auditorIdentity,
-			AuditorGroup:    auditorGroup,
-			PublicTypes:     authz.ParsePublicResourceTypes(publicResourceTypes),
+			AuditorIdentity:   auditorIdentity,
+			AuditorGroup:      auditorGroup,
+			PublicTypes:       authz.ParsePublicResourceTypes(publicResourceTypes),
+			Redactions:        redactions,
+			TenantGroupPrefix: tenantGroupPrefix,
 		},
-		Logger: logger,
+		Logger:     logger,
+		Chain:      b.GetChain(),
+		Tenants:    tenants,
+		AuditLevel: level,
+	}
+	if auditSink != nil {
+		webOpts.Audit = auditSink
 	}
 	if err := endpoint.StartWebListener(b.GetModel(), b.GetEventManager(), serviceAddr, webOpts); err != nil {
 		return fmt.Errorf("starting web listener: %w", err)
@@ -218,14 +333,30 @@ func init() {
 	serverCmd.Flags().BoolVar(&trustAuthHeaders, "trust-auth-headers", envOrDefault("TRUST_AUTH_HEADERS", "") == "true", "Trust X-Auth-* identity headers from the BFF and enforce ownership visibility")
 	serverCmd.Flags().StringVar(&auditorIdentity, "auditor-identity", envOrDefault("AUDITOR_IDENTITY", ""), "OIDC subject treated as auditor when matching X-Auth-Subject")
 	serverCmd.Flags().StringVar(&auditorGroup, "auditor-group", envOrDefault("AUDITOR_GROUP", ""), "Group id treated as auditor when present in X-Auth-Groups")
+	serverCmd.Flags().StringVar(&jwtIssuer, "jwt-issuer", envOrDefault("JWT_ISSUER", ""), "Required iss claim of bearer tokens")
+	serverCmd.Flags().StringVar(&jwtAudience, "jwt-audience", envOrDefault("JWT_AUDIENCE", ""), "Required aud claim of bearer tokens")
+	serverCmd.Flags().StringVar(&jwtJWKSFile, "jwt-jwks-file", envOrDefault("JWT_JWKS_FILE", ""), "Local JWKS file with the token issuer's public keys; enables bearer token authentication")
+	serverCmd.Flags().StringVar(&jwtJWKSURL, "jwt-jwks-url", envOrDefault("JWT_JWKS_URL", ""), "JWKS URL of the token issuer (e.g. the OIDC jwks_uri); enables bearer token authentication")
+	serverCmd.Flags().StringVar(&jwtSubjectClaim, "jwt-subject-claim", envOrDefault("JWT_SUBJECT_CLAIM", authz.DefaultSubjectClaim), "Token claim used as the principal's subject")
+	serverCmd.Flags().StringVar(&jwtGroupsClaim, "jwt-groups-claim", envOrDefault("JWT_GROUPS_CLAIM", authz.DefaultGroupsClaim), "Token claim holding the principal's groups; nested claims use dots (e.g. realm_access.roles)")
+	serverCmd.Flags().StringVar(&apiKeyFile, "api-key-file", envOrDefault("API_KEY_FILE", ""), "File storing hashed API keys bound to Identities; enables API key authentication and /api/authz/api-keys")
+	serverCmd.Flags().StringVar(&tenantsFlag, "tenants", envOrDefault("TENANTS", ""), "Comma-separated tenants hosted besides \"default\", each with an isolated landscape under /api/tenants/<name> or the X-Tenant header")
+	serverCmd.Flags().StringVar(&tenantGroupPrefix, "tenant-group-prefix", envOrDefault("TENANT_GROUP_PREFIX", ""), "If set, only principals in group <prefix><tenant> (or auditors) see a tenant's landscape (e.g. tenant: for group tenant:payments)")
 	serverCmd.Flags().StringVar(&publicResourceTypes, "public-resource-types", envOrDefault("PUBLIC_RESOURCE_TYPES", ""), "Comma-separated resource types always visible (e.g. ContextType,FindingType)")
+	serverCmd.Flags().StringVar(&redactAnnotations, "redact-annotations", envOrDefault("REDACT_ANNOTATIONS", ""), "Comma-separated annotation key prefixes shown only to owners and auditors, optionally limited to types (e.g. emeland.io/endpoint.host=ApiInstance|SystemInstance,contact.)")
 	serverCmd.Flags().StringVar(&subscribersFlag, "subscribers", envOrDefault("SUBSCRIBERS", ""), "Comma-separated downstream modelsrv base API URLs to pre-register (e.g. http://host:8080/api)")
+	serverCmd.Flags().StringVar(&subscriberToken, "subscriber-token", envOrDefault("SUBSCRIBER_TOKEN", ""), "Bearer token (e.g. an API key of the downstream modelsrv) sent with events pushed to subscribers")
+	serverCmd.Flags().StringVar(&auditFile, "audit-file", envOrDefault("AUDIT_FILE", ""), "If set, append an audit entry per API call to this file as JSON lines")
+	serverCmd.Flags().StringVar(&auditLevel, "audit-level", envOrDefault("AUDIT_LEVEL", "all"), "Audited calls: all, write (writes and refusals), denied (refusals only) or off; independent of --log-level")
+	serverCmd.Flags().IntVar(&auditMaxSizeMB, "audit-max-size", envIntOrDefault("AUDIT_MAX_SIZE", 100), "Size in MB at which --audit-file is rotated; 0 disables rotation")
+	serverCmd.Flags().IntVar(&auditMaxBackups, "audit-max-backups", envIntOrDefault("AUDIT_MAX_BACKUPS", 5), "Number of rotated audit files to keep; at least 1 while --audit-max-size is set")
 	serverCmd.Flags().IntVar(&eventHistoryLimit, "event-history-limit", envIntOrDefault("EVENT_HISTORY_LIMIT", eventmgr.DefaultHistoryLimit), "Number of recent events the /events history API can serve exactly; older queries return synthesized current-state entries instead of an error")
 	serverCmd.Flags().StringVar(&otelConfigOut, "otel-config-out", envOrDefault("OTEL_CONFIG_OUT", ""), "If set, keep an OTel collector config at this path in sync with ApiInstance endpoint annotations")
 	serverCmd.Flags().DurationVar(&otelConfigDebounce, "otel-config-debounce", envDurationOrDefault("OTEL_CONFIG_DEBOUNCE", 2*time.Second), "Debounce window before rewriting --otel-config-out")
 	serverCmd.Flags().DurationVar(&otelCollectionInterval, "otel-collection-interval", 5*time.Minute, "collection_interval for the http_check receiver in --otel-config-out")
 	serverCmd.Flags().StringVar(&otelListenAddr, "otel-listen-addr", "0.0.0.0:24200", "listen_addr for the emeland exporter in --otel-config-out")
 	serverCmd.Flags().DurationVar(&otelExpiryThreshold, "otel-expiry-threshold", 30*24*time.Hour, "Expiry threshold for the emeland exporter in --otel-config-out")
+	serverCmd.Flags().DurationVar(&lifecycleInterval, "lifecycle-interval", envDurationOrDefault("LIFECYCLE_INTERVAL", time.Hour), "Interval for re-evaluating product lifecycle findings on ArtifactInstances so date transitions are noticed; 0 disables the tick")
 	serverCmd.Flags().StringArrayVar(&otelSubscribers, "otel-subscriber", nil, "Downstream modelsrv URL for the emeland exporter in --otel-config-out (repeatable)")
 }
 
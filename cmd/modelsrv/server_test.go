@@ -117,3 +117,21 @@ func TestServerCmd_LogLevelErrorSuppressesInfo(t *testing.T) {
 		t.Fatalf("unexpected log level error: %v", err)
 	}
 }
+
+func TestServerCmd_AuditRotationWithoutBackupsReturnsError(t *testing.T) {
+	t.Cleanup(func() {
+		auditFile = ""
+		auditMaxBackups = 5
+	})
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	err := executeServer(t, "--audit-file", path, "--audit-max-backups", "0")
+	if err == nil {
+		t.Fatal("expected error for --audit-max-backups 0")
+	}
+	if !strings.Contains(err.Error(), "invalid --audit-max-backups") {
+		t.Fatalf("error %q does not mention --audit-max-backups", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("audit file was created: %v", err)
+	}
+}
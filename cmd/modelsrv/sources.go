@@ -0,0 +1,25 @@
+package main
+
+import (
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+	"go.emeland.io/modelsrv/pkg/model"
+)
+
+// guardSources makes every source that names a principal write as that
+// principal: its documents are authorized against the IAM Bindings of m, and
+// refused ones are recorded as findings in m instead of being applied.
+func guardSources(sources []filesensor.OpenSource, m model.Model) {
+	eval := authz.NewEvaluator(authz.Config{}, authz.NewBindingRule(m))
+	for i, s := range sources {
+		if s.Config.Principal == "" {
+			continue
+		}
+		sources[i].Authorizer = authz.WriteGuard{
+			Evaluator: eval,
+			Principal: authz.Principal{Subject: s.Config.Principal},
+			Origin:    s.Config.URI,
+			Model:     m,
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+)
+
+// sourcesByTenant groups the opened sources by the tenant they feed. Sources
+// without a tenant feed the default tenant; a tenant that is not hosted is an
+// error.
+func sourcesByTenant(sources []filesensor.OpenSource, tenants *backend.Tenants) (map[string][]filesensor.OpenSource, error) {
+	out := map[string][]filesensor.OpenSource{}
+	for _, s := range sources {
+		name := s.Config.Tenant
+		if name == "" {
+			name = backend.DefaultTenant
+		}
+		if _, ok := tenants.Get(name); !ok {
+			return nil, fmt.Errorf("source %s: tenant %q is not hosted (see --tenants)", s.Config.URI, name)
+		}
+		out[name] = append(out[name], s)
+	}
+	return out, nil
+}
+
+// tenantAPIKeyFile derives the API key file of a non-default tenant from the
+// configured one by inserting the tenant name before the extension, e.g.
+// keys.json becomes keys.payments.json.
+func tenantAPIKeyFile(path, tenant string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + tenant + ext
+}
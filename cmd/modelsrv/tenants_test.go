@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+)
+
+func TestSourcesByTenant(t *testing.T) {
+	tenants, err := backend.NewTenants([]string{"payments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := []filesensor.OpenSource{
+		{Config: filesensor.SourceConfig{URI: "file:///a"}},
+		{Config: filesensor.SourceConfig{URI: "file:///b", Tenant: "payments"}},
+	}
+	got, err := sourcesByTenant(sources, tenants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got[backend.DefaultTenant]) != 1 || len(got["payments"]) != 1 {
+		t.Fatalf("unexpected grouping: %v", got)
+	}
+
+	sources = append(sources, filesensor.OpenSource{Config: filesensor.SourceConfig{URI: "file:///c", Tenant: "retail"}})
+	if _, err := sourcesByTenant(sources, tenants); err == nil || !strings.Contains(err.Error(), "not hosted") {
+		t.Fatalf("expected an unhosted tenant error, got %v", err)
+	}
+}
+
+func TestTenantAPIKeyFile(t *testing.T) {
+	for path, want := range map[string]string{
+		"/etc/emeland/keys.json": "/etc/emeland/keys.payments.json",
+		"keys":                   "keys.payments",
+	} {
+		if got := tenantAPIKeyFile(path, "payments"); got != want {
+			t.Errorf("tenantAPIKeyFile(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
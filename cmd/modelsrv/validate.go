@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+	"go.emeland.io/modelsrv/pkg/validation"
+)
+
+var (
+	validateSensorConfig string
+	validateServerURL    string
+	validateToken        string
+	validateOutput       string
+)
+
+// validateCmd dry-runs the Sources of a sensor config.
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Dry-run the Sources of a sensor config and report mistakes",
+	Long: `Read every file of the Sources in --sensor-config, apply them to a scratch
+landscape with the same filters the server runs, and print per file the parse
+errors, unknown kinds, invalid documents, dangling references and findings
+applying them would raise. Nothing is written to a server.
+
+With --server the files are also compared with the landscape of a running
+server: resources are reported as created, updated or unchanged, references to
+resources the server holds are not dangling, and for Sources with prune: true
+the resources pruning would delete are listed: those whose provenance
+annotations name the Source, of the kinds its files define, that no file
+defines any more.
+
+The command fails when any file has a problem, so it can gate a CI pipeline.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runValidate(cmd.Context(), cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringVar(&validateSensorConfig, "sensor-config", envOrDefault("SENSOR_CONFIG", ""), "YAML file listing the Sources to validate (required)")
+	validateCmd.Flags().StringVarP(&validateServerURL, "server", "s", envOrDefault("MODELSRV_URL", ""), "Running modelsrv API base URL to compare with (optional)")
+	validateCmd.Flags().StringVar(&validateToken, "token", envOrDefault("EMELAND_TOKEN", ""), "Bearer token for --server")
+	validateCmd.Flags().StringVarP(&validateOutput, "output", "o", "text", "Output format: text or json")
+}
+
+func runValidate(ctx context.Context, out io.Writer) error {
+	if validateSensorConfig == "" {
+		return fmt.Errorf("--sensor-config is required")
+	}
+	if validateOutput != "text" && validateOutput != "json" {
+		return fmt.Errorf("unknown output format %q; use text or json", validateOutput)
+	}
+	cfg, err := filesensor.LoadConfigFile(validateSensorConfig)
+	if err != nil {
+		return fmt.Errorf("could not load sensor config %s: %w", validateSensorConfig, err)
+	}
+	sources, err := filesensor.OpenSources(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("could not open sources from %s: %w", validateSensorConfig, err)
+	}
+	defer func() { _ = filesensor.CloseSources(sources) }()
+
+	// Each tenant is a landscape of its own.
+	byTenant := map[string][]filesensor.OpenSource{}
+	for _, s := range sources {
+		name := s.Config.Tenant
+		if name == "" {
+			name = backend.DefaultTenant
+		}
+		byTenant[name] = append(byTenant[name], s)
+	}
+	names := make([]string, 0, len(byTenant))
+	for name := range byTenant {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	reports := map[string]*validation.Report{}
+	problems := 0
+	for _, name := range names {
+		report, err := validateTenant(ctx, name, byTenant[name])
+		if err != nil {
+			return fmt.Errorf("tenant %s: %w", name, err)
+		}
+		reports[name] = report
+		problems += report.Problems()
+	}
+
+	if validateOutput == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			return err
+		}
+	} else {
+		for _, name := range names {
+			if len(names) > 1 {
+				fmt.Fprintf(out, "tenant %s:\n", name)
+			}
+			if err := reports[name].WriteText(out); err != nil {
+				return err
+			}
+		}
+	}
+	if problems > 0 {
+		return fmt.Errorf("validation found %d problem(s)", problems)
+	}
+	return nil
+}
+
+func validateTenant(ctx context.Context, tenant string, sources []filesensor.OpenSource) (*validation.Report, error) {
+	files, err := validation.ReadSources(ctx, sources)
+	if err != nil {
+		return nil, err
+	}
+	opts := validation.Options{}
+	if validateServerURL != "" {
+		sb := validation.ServerBaseline{URL: normalizeAPIBaseURL(validateServerURL), Token: validateToken}
+		if tenant != backend.DefaultTenant {
+			sb.Tenant = tenant
+		}
+		opts.Baseline = sb
+	}
+	return validation.Run(files, opts)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func executeValidate(t *testing.T, out io.Writer, args ...string) error {
+	t.Helper()
+	rootCmd.SetArgs(append([]string{"validate"}, args...))
+	rootCmd.SetOut(out)
+	rootCmd.SetErr(io.Discard)
+	t.Cleanup(func() {
+		rootCmd.SetArgs(nil)
+		validateSensorConfig = ""
+		validateServerURL = ""
+		validateOutput = "text"
+	})
+	return rootCmd.Execute()
+}
+
+func writeSensorConfig(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfgPath := filepath.Join(t.TempDir(), "sensor.yaml")
+	if err := os.WriteFile(cfgPath, []byte("sources:\n  - uri: "+dir+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return cfgPath
+}
+
+func TestValidateCmd_ReportsProblemsAndFails(t *testing.T) {
+	cfgPath := writeSensorConfig(t, map[string]string{
+		"good.yaml": "version: emeland.io/v1\nkind: ContextType\nspec:\n  contextTypeId: \"44444444-4444-4444-4444-444444444444\"\n  displayName: Environment\n",
+		"bad.yaml":  "version: emeland.io/v1\nkind: Spaceship\nspec: {}\n",
+	})
+	var out bytes.Buffer
+	err := executeValidate(t, &out, "--sensor-config", cfgPath)
+	if err == nil || !strings.Contains(err.Error(), "1 problem(s)") {
+		t.Fatalf("expected a failing validation, got %v", err)
+	}
+	for _, want := range []string{"bad.yaml: 0 document(s), 1 problem(s)", "UnknownKind", "good.yaml: 1 document(s), ok"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("report lacks %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestValidateCmd_CleanSourcesSucceedAsJSON(t *testing.T) {
+	cfgPath := writeSensorConfig(t, map[string]string{
+		"good.yaml": "version: emeland.io/v1\nkind: ContextType\nspec:\n  contextTypeId: \"44444444-4444-4444-4444-444444444444\"\n  displayName: Environment\n",
+	})
+	var out bytes.Buffer
+	if err := executeValidate(t, &out, "--sensor-config", cfgPath, "-o", "json"); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if !strings.Contains(out.String(), `"name": "good.yaml"`) {
+		t.Fatalf("json report lacks the file:\n%s", out.String())
+	}
+}
@@ -0,0 +1,367 @@
+// Package celrule compiles declarative [mdlfilterrule.FilterRule] resources
+// into [eventfilter.Filter]s.
+//
+// A declarative rule carries a CEL expression that is evaluated for every
+// event of the rule's ResourceType (or every event when no type is set). The
+// expression sees these variables:
+//
+//	event        map(string, string)  kind, operation and id of the event
+//	resource     map(string, dyn)     the resource in its API (JSON) shape; empty on delete
+//	annotations  map(string, string)  the resource's annotations
+//
+// and these functions, which look into the model:
+//
+//	resourceExists(kind, id) bool
+//	resourceName(kind, id)   string
+//
+// For example, to flag ApiInstances in a given Context that lack an owner:
+//
+//	resource.contextId == "5f0c…" && !("owner" in annotations)
+//
+// The rule's Action decides what happens when the expression is true: drop
+// suppresses the event, finding raises a Finding of the rule's FindingType on
+// the resource and deletes it once the expression no longer holds. The
+// optional Message is a CEL string expression rendering the finding
+// description. [Controller] keeps the chain in sync with the FilterRule
+// resources in the model.
+package celrule
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"cel.dev/cel-go/cel"
+	"cel.dev/cel-go/common/types"
+	"cel.dev/cel-go/common/types/ref"
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/annotations"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	mdlfilterrule "go.emeland.io/modelsrv/pkg/model/filterrule"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// SHA-1 namespace so each (rule id, resource id) maps to one finding id.
+var celruleNamespace = uuid.MustParse("0b6f3e2d-9c1a-4f58-8e7d-6a5b4c3d2e1f")
+
+// AnnotationFilterRuleID marks findings raised by a declarative rule with the
+// rule's id so they can be removed together with the rule.
+const AnnotationFilterRuleID = "filterRuleId"
+
+func findingID(ruleID, resourceID uuid.UUID) uuid.UUID {
+	key := append(ruleID[:], resourceID[:]...)
+	return uuid.NewSHA1(celruleNamespace, key)
+}
+
+// annotated is implemented by every model resource carrying annotations.
+type annotated interface {
+	GetAnnotations() annotations.Annotations
+}
+
+// rule is a compiled declarative FilterRule. CEL programs are built per model
+// because the model lookup functions are bound at program construction.
+type rule struct {
+	id           uuid.UUID
+	name         string
+	resourceType events.ResourceType
+	action       mdlfilterrule.Action
+	findingKind  finding.FindingKind
+	expression   string
+	message      string
+
+	mu     sync.Mutex
+	model  model.Model
+	match  cel.Program
+	render cel.Program
+}
+
+// Compile checks fr and returns the filter implementing it. It fails when fr
+// has no expression, an unknown resource type or action, a finding action
+// without FindingType, or an expression that does not compile to a bool
+// (a message to a string).
+func Compile(fr mdlfilterrule.FilterRule) (eventfilter.Filter, error) {
+	r, err := newRule(fr)
+	if err != nil {
+		return eventfilter.Filter{}, err
+	}
+	return eventfilter.Filter{
+		DisplayName: fr.GetDisplayName(),
+		Description: fr.GetDescription(),
+		Fn:          r.filter,
+	}, nil
+}
+
+func newRule(fr mdlfilterrule.FilterRule) (*rule, error) {
+	if !mdlfilterrule.IsDeclarative(fr) {
+		return nil, errors.New("filter rule has no expression")
+	}
+	r := &rule{
+		id:          fr.GetRuleId(),
+		name:        fr.GetDisplayName(),
+		findingKind: finding.FindingKind(strings.TrimSpace(fr.GetFindingType())),
+		expression:  fr.GetExpression(),
+		message:     strings.TrimSpace(fr.GetMessage()),
+	}
+	if kind := strings.TrimSpace(fr.GetResourceType()); kind != "" {
+		r.resourceType = events.ParseWireKind(kind)
+		if r.resourceType == events.UnknownResourceType {
+			return nil, fmt.Errorf("unknown resourceType %q", kind)
+		}
+	}
+	action, err := mdlfilterrule.ParseAction(string(fr.GetAction()))
+	if err != nil {
+		return nil, err
+	}
+	switch action {
+	case mdlfilterrule.ActionDrop:
+	case mdlfilterrule.ActionFinding:
+		if r.findingKind == "" {
+			return nil, errors.New("action finding requires findingType")
+		}
+	default:
+		return nil, errors.New("filter rule with an expression requires action drop or finding")
+	}
+	r.action = action
+	if _, _, err := r.programs(nil); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func newEnv(m model.Model) (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("event", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("annotations", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Function("resourceExists",
+			cel.Overload("resourceExists_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(kind, id ref.Val) ref.Val {
+					return types.Bool(model.ResourceExists(m, resourceRef(kind, id)))
+				}),
+			),
+		),
+		cel.Function("resourceName",
+			cel.Overload("resourceName_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(kind, id ref.Val) ref.Val {
+					return types.String(model.ResourceDisplayName(m, resourceRef(kind, id)))
+				}),
+			),
+		),
+	)
+}
+
+func resourceRef(kind, id ref.Val) *common.ResourceRef {
+	k, _ := kind.Value().(string)
+	s, _ := id.Value().(string)
+	rid, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+	return &common.ResourceRef{ResourceId: rid, ResourceType: events.ParseWireKind(k)}
+}
+
+// programs returns the match and render programs bound to m, building them on
+// first use or when the model changed.
+func (r *rule) programs(m model.Model) (cel.Program, cel.Program, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.match != nil && r.model == m {
+		return r.match, r.render, nil
+	}
+
+	env, err := newEnv(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	match, err := compileProgram(env, r.expression, cel.BoolType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expression: %w", err)
+	}
+	var render cel.Program
+	if r.message != "" {
+		if render, err = compileProgram(env, r.message, cel.StringType); err != nil {
+			return nil, nil, fmt.Errorf("message: %w", err)
+		}
+	}
+	r.model, r.match, r.render = m, match, render
+	return match, render, nil
+}
+
+func compileProgram(env *cel.Env, src string, want *cel.Type) (cel.Program, error) {
+	ast, iss := env.Compile(src)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if !ast.OutputType().IsExactType(want) {
+		return nil, fmt.Errorf("must evaluate to %s, not %s", want, ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+// skips reports whether ev is outside the rule's scope. Filter rules and
+// merge rules are never evaluated, and finding rules ignore findings so that
+// a rule cannot trigger itself.
+func (r *rule) skips(ev events.Event) bool {
+	switch ev.ResourceType {
+	case events.FilterRuleResource, events.MergeRuleResource, events.AnnotationsResource:
+		return true
+	case events.FindingResource, events.FindingTypeResource:
+		if r.action == mdlfilterrule.ActionFinding {
+			return true
+		}
+	}
+	return r.resourceType != events.UnknownResourceType && ev.ResourceType != r.resourceType
+}
+
+func (r *rule) filter(m model.Model, ev events.Event) []events.Event {
+	if r.skips(ev) {
+		return []events.Event{ev}
+	}
+	if r.action == mdlfilterrule.ActionFinding && ev.Operation == events.DeleteOperation {
+		deleteFinding(m, findingID(r.id, ev.ResourceId))
+		return []events.Event{ev}
+	}
+
+	matched, msg, err := r.evaluate(m, ev)
+	if err != nil {
+		log.Printf("celrule: rule %s on %s %s: %v", r.id, ev.ResourceType, ev.ResourceId, err)
+		return []events.Event{ev}
+	}
+
+	switch r.action {
+	case mdlfilterrule.ActionDrop:
+		if matched {
+			return nil
+		}
+	case mdlfilterrule.ActionFinding:
+		if matched {
+			r.upsertFinding(m, ev, msg)
+		} else {
+			deleteFinding(m, findingID(r.id, ev.ResourceId))
+		}
+	}
+	return []events.Event{ev}
+}
+
+func (r *rule) evaluate(m model.Model, ev events.Event) (bool, string, error) {
+	match, render, err := r.programs(m)
+	if err != nil {
+		return false, "", err
+	}
+	vars := activation(ev)
+
+	out, _, err := match.Eval(vars)
+	if err != nil {
+		return false, "", err
+	}
+	matched, ok := out.Value().(bool)
+	if !ok || !matched {
+		return false, "", nil
+	}
+
+	msg := fmt.Sprintf("%s: %s %s matches %q", r.findingKind, ev.ResourceType.WireKind(), ev.ResourceId, r.expression)
+	if render != nil {
+		out, _, err := render.Eval(vars)
+		if err != nil {
+			return false, "", fmt.Errorf("message: %w", err)
+		}
+		if s, ok := out.Value().(string); ok {
+			msg = s
+		}
+	}
+	return true, msg, nil
+}
+
+func activation(ev events.Event) map[string]any {
+	resource := map[string]any{}
+	ann := map[string]string{}
+	if ev.Operation != events.DeleteOperation && len(ev.Objects) > 0 {
+		if wm, err := oapi.ResourceWireMap(ev.ResourceType, ev.Objects[0]); err == nil && wm != nil {
+			resource = wm
+		}
+		if a, ok := ev.Objects[0].(annotated); ok && a.GetAnnotations() != nil {
+			for k := range a.GetAnnotations().GetKeys() {
+				ann[k] = a.GetAnnotations().GetValue(k)
+			}
+		}
+	}
+	return map[string]any{
+		"event": map[string]string{
+			"kind":      ev.ResourceType.WireKind(),
+			"operation": ev.Operation.WireOperation(),
+			"id":        ev.ResourceId.String(),
+		},
+		"resource":    resource,
+		"annotations": ann,
+	}
+}
+
+func (r *rule) upsertFinding(m model.Model, ev events.Event, description string) {
+	id := findingID(r.id, ev.ResourceId)
+	if cur := m.GetFindingById(id); cur != nil && cur.GetDescription() == description {
+		return // unchanged; avoid an update event per matching event
+	}
+
+	typeID, err := finding.EnsureType(m, r.findingKind)
+	if err != nil {
+		log.Printf("celrule: %v", err)
+	}
+	f := finding.NewFinding(id)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName(r.name)
+	f.SetDescription(description)
+	f.SetResources([]*common.ResourceRef{
+		{ResourceId: ev.ResourceId, ResourceType: ev.ResourceType},
+	})
+	f.GetAnnotations().Add(AnnotationFilterRuleID, r.id.String())
+
+	if err := m.AddFinding(f); err != nil {
+		log.Printf("celrule: AddFinding id=%s rule=%s: %v", id, r.id, err)
+	}
+}
+
+// reconcile evaluates a finding rule against every resource already in the
+// model, so a rule added after the resources it checks still raises findings.
+func (r *rule) reconcile(m model.Model) {
+	if r.action != mdlfilterrule.ActionFinding {
+		return
+	}
+	for _, info := range model.ResourceTypes(m) {
+		rt := events.ParseWireKind(info.Name)
+		if r.skips(events.Event{ResourceType: rt}) {
+			continue
+		}
+		items, err := info.List()
+		if err != nil {
+			log.Printf("celrule: reconcile rule %s list %s: %v", r.id, info.Name, err)
+			continue
+		}
+		for _, item := range items {
+			res, ok := item.(interface{ GetResourceId() uuid.UUID })
+			if !ok {
+				continue
+			}
+			r.filter(m, events.Event{
+				ResourceType: rt,
+				Operation:    events.UpdateOperation,
+				ResourceId:   res.GetResourceId(),
+				Objects:      []any{item},
+			})
+		}
+	}
+}
+
+func deleteFinding(m model.Model, id uuid.UUID) {
+	if m.GetFindingById(id) == nil {
+		return
+	}
+	if err := m.DeleteFindingById(id); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+		log.Printf("celrule: DeleteFindingById id=%s: %v", id, err)
+	}
+}
@@ -0,0 +1,204 @@
+package celrule_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/internal/celrule"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	mdlapi "go.emeland.io/modelsrv/pkg/model/api"
+	mdlfilterrule "go.emeland.io/modelsrv/pkg/model/filterrule"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+// newModelWithRules returns a model whose sink runs the celrule controller and
+// records every event that passes the chain.
+func newModelWithRules() (model.Model, *events.ListSink) {
+	sink := events.NewListSink()
+	chain := eventfilter.NewChain(nil)
+	m, err := model.NewModel(eventfilter.NewFilteringSink(chain, sink))
+	Expect(err).NotTo(HaveOccurred())
+	chain.SetModel(m)
+	chain.RegisterFilter(celrule.NewController(chain).Filter())
+	return m, sink
+}
+
+func ownerRule(id uuid.UUID) mdlfilterrule.FilterRule {
+	fr := mdlfilterrule.NewFilterRule(id)
+	fr.SetDisplayName("ApiInstances need an owner")
+	fr.SetResourceType("ApiInstance")
+	fr.SetExpression(`!("owner" in annotations)`)
+	fr.SetAction(mdlfilterrule.ActionFinding)
+	fr.SetFindingType("OwnerMissing")
+	fr.SetMessage(`"ApiInstance " + resource.displayName + " has no owner"`)
+	return fr
+}
+
+func addApiInstance(m model.Model, name string, annotations map[string]string) mdlapi.ApiInstance {
+	ai := mdlapi.NewApiInstance(uuid.New())
+	ai.SetDisplayName(name)
+	for k, v := range annotations {
+		ai.GetAnnotations().Add(k, v)
+	}
+	Expect(m.AddApiInstance(ai)).To(Succeed())
+	return ai
+}
+
+func ruleFindings(m model.Model, ruleID uuid.UUID) []finding.Finding {
+	all, err := m.GetFindings()
+	Expect(err).NotTo(HaveOccurred())
+	var out []finding.Finding
+	for _, f := range all {
+		if f.GetAnnotations().GetValue(celrule.AnnotationFilterRuleID) == ruleID.String() {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func invalidFindings(m model.Model, ruleID uuid.UUID) []finding.Finding {
+	var out []finding.Finding
+	for _, f := range m.GetFindingsReferencingResource(ruleID) {
+		if f.GetFindingTypeId() == finding.TypeIDForKind(finding.FilterRuleInvalid) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+var _ = Describe("Compile", func() {
+	It("rejects rules that cannot be evaluated", func() {
+		for _, tc := range []struct {
+			name   string
+			mutate func(mdlfilterrule.FilterRule)
+		}{
+			{"syntax error", func(fr mdlfilterrule.FilterRule) { fr.SetExpression("resource.(") }},
+			{"non-bool expression", func(fr mdlfilterrule.FilterRule) { fr.SetExpression(`"yes"`) }},
+			{"unknown resource type", func(fr mdlfilterrule.FilterRule) { fr.SetResourceType("Spaceship") }},
+			{"missing finding type", func(fr mdlfilterrule.FilterRule) { fr.SetFindingType("") }},
+			{"missing action", func(fr mdlfilterrule.FilterRule) { fr.SetAction(mdlfilterrule.ActionNone) }},
+			{"non-string message", func(fr mdlfilterrule.FilterRule) { fr.SetMessage("42") }},
+		} {
+			fr := ownerRule(uuid.New())
+			tc.mutate(fr)
+			_, err := celrule.Compile(fr)
+			Expect(err).To(HaveOccurred(), tc.name)
+		}
+	})
+
+	It("drops matching events", func() {
+		fr := mdlfilterrule.NewFilterRule(uuid.New())
+		fr.SetDisplayName("Drop scratch systems")
+		fr.SetResourceType("System")
+		fr.SetExpression(`resource.displayName.startsWith("scratch-")`)
+		fr.SetAction(mdlfilterrule.ActionDrop)
+		f, err := celrule.Compile(fr)
+		Expect(err).NotTo(HaveOccurred())
+
+		m, _ := newModelWithRules()
+		scratch := system.NewSystem(uuid.New())
+		scratch.SetDisplayName("scratch-1")
+		keep := system.NewSystem(uuid.New())
+		keep.SetDisplayName("payments")
+
+		ev := func(s system.System) events.Event {
+			return events.Event{ResourceType: events.SystemResource, Operation: events.CreateOperation, ResourceId: s.GetSystemId(), Objects: []any{s}}
+		}
+		Expect(f.Fn(m, ev(scratch))).To(BeEmpty())
+		Expect(f.Fn(m, ev(keep))).To(HaveLen(1))
+		Expect(f.Fn(m, events.Event{ResourceType: events.NodeResource, Operation: events.CreateOperation, ResourceId: uuid.New()})).To(HaveLen(1))
+	})
+
+	It("looks resources up in the model", func() {
+		m, _ := newModelWithRules()
+		sys := system.NewSystem(uuid.New())
+		sys.SetDisplayName("billing")
+		Expect(m.AddSystem(sys)).To(Succeed())
+
+		fr := mdlfilterrule.NewFilterRule(uuid.New())
+		fr.SetDisplayName("Drop events about known systems")
+		fr.SetExpression(`resourceExists("System", event.id) && resourceName("System", event.id) == "billing"`)
+		fr.SetAction(mdlfilterrule.ActionDrop)
+		f, err := celrule.Compile(fr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(f.Fn(m, events.Event{ResourceType: events.SystemResource, Operation: events.UpdateOperation, ResourceId: sys.GetSystemId(), Objects: []any{sys}})).To(BeEmpty())
+		Expect(f.Fn(m, events.Event{ResourceType: events.SystemResource, Operation: events.UpdateOperation, ResourceId: uuid.New()})).To(HaveLen(1))
+	})
+})
+
+var _ = Describe("Controller", func() {
+	It("raises and clears findings as resources change", func() {
+		m, _ := newModelWithRules()
+		ruleID := uuid.New()
+		Expect(m.AddFilterRule(ownerRule(ruleID))).To(Succeed())
+
+		ai := addApiInstance(m, "orders-prod", nil)
+		fs := ruleFindings(m, ruleID)
+		Expect(fs).To(HaveLen(1))
+		Expect(fs[0].GetDescription()).To(Equal("ApiInstance orders-prod has no owner"))
+		Expect(fs[0].GetResources()[0].ResourceId).To(Equal(ai.GetInstanceId()))
+		Expect(m.GetFindingTypeByName("OwnerMissing")).NotTo(BeNil())
+
+		addApiInstance(m, "orders-dev", map[string]string{"owner": "team-a"})
+		Expect(ruleFindings(m, ruleID)).To(HaveLen(1))
+
+		fixed := mdlapi.NewApiInstance(ai.GetInstanceId())
+		fixed.SetDisplayName("orders-prod")
+		fixed.GetAnnotations().Add("owner", "team-b")
+		Expect(m.AddApiInstance(fixed)).To(Succeed())
+		Expect(ruleFindings(m, ruleID)).To(BeEmpty())
+	})
+
+	It("evaluates resources that existed before the rule", func() {
+		m, _ := newModelWithRules()
+		addApiInstance(m, "legacy", nil)
+
+		ruleID := uuid.New()
+		Expect(m.AddFilterRule(ownerRule(ruleID))).To(Succeed())
+		Expect(ruleFindings(m, ruleID)).To(HaveLen(1))
+	})
+
+	It("unregisters the rule and its findings on delete", func() {
+		m, _ := newModelWithRules()
+		ruleID := uuid.New()
+		Expect(m.AddFilterRule(ownerRule(ruleID))).To(Succeed())
+		addApiInstance(m, "orders-prod", nil)
+		Expect(ruleFindings(m, ruleID)).To(HaveLen(1))
+
+		Expect(m.DeleteFilterRuleById(ruleID)).To(Succeed())
+		Expect(ruleFindings(m, ruleID)).To(BeEmpty())
+
+		addApiInstance(m, "orders-test", nil)
+		Expect(ruleFindings(m, ruleID)).To(BeEmpty())
+	})
+
+	It("flags invalid rules and recovers when they are fixed", func() {
+		m, sink := newModelWithRules()
+		ruleID := uuid.New()
+		broken := ownerRule(ruleID)
+		broken.SetExpression("annotations.(")
+		Expect(m.AddFilterRule(broken)).To(Succeed())
+		Expect(invalidFindings(m, ruleID)).To(HaveLen(1))
+
+		addApiInstance(m, "orders-prod", nil)
+		Expect(ruleFindings(m, ruleID)).To(BeEmpty())
+		Expect(sink.GetEvents()).NotTo(BeEmpty())
+
+		Expect(m.AddFilterRule(ownerRule(ruleID))).To(Succeed())
+		Expect(invalidFindings(m, ruleID)).To(BeEmpty())
+		Expect(ruleFindings(m, ruleID)).To(HaveLen(1))
+	})
+
+	It("ignores filter rules that only document compiled filters", func() {
+		m, _ := newModelWithRules()
+		fr := mdlfilterrule.NewFilterRule(uuid.New())
+		fr.SetDisplayName("Phase 0")
+		Expect(m.AddFilterRule(fr)).To(Succeed())
+		Expect(invalidFindings(m, fr.GetRuleId())).To(BeEmpty())
+	})
+})
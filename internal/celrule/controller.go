@@ -0,0 +1,189 @@
+package celrule
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	mdlfilterrule "go.emeland.io/modelsrv/pkg/model/filterrule"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// SHA-1 namespace for the FilterRuleInvalid finding raised on a rule.
+var invalidNamespace = uuid.MustParse("9e8d7c6b-5a49-4382-a1b0-c9d8e7f6a5b4")
+
+// Controller registers declarative FilterRules into a chain as they are added
+// to the model and unregisters them when they are deleted. Its own filter,
+// returned by [Controller.Filter], watches FilterRule events and must be
+// registered in the same chain.
+type Controller struct {
+	chain eventfilter.Chain
+
+	mu      sync.Mutex
+	managed map[uuid.UUID]struct{}
+}
+
+// NewController returns a Controller managing declarative rules in chain.
+func NewController(chain eventfilter.Chain) *Controller {
+	return &Controller{
+		chain:   chain,
+		managed: make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Filter returns the filter that keeps the chain in sync with FilterRule
+// create, update and delete events. The incoming event is returned as-is.
+func (c *Controller) Filter() eventfilter.Filter {
+	return eventfilter.Filter{
+		DisplayName: "Declarative filter rules",
+		Description: "Compiles FilterRules carrying a CEL expression into the filter chain and unregisters them when they are deleted.",
+		Fn: func(m model.Model, ev events.Event) []events.Event {
+			if ev.ResourceType != events.FilterRuleResource {
+				return []events.Event{ev}
+			}
+			switch ev.Operation {
+			case events.CreateOperation, events.UpdateOperation:
+				if len(ev.Objects) > 0 {
+					if fr, ok := ev.Objects[0].(mdlfilterrule.FilterRule); ok {
+						c.Apply(m, fr)
+					}
+				}
+			case events.DeleteOperation:
+				c.Remove(m, ev.ResourceId)
+			}
+			return []events.Event{ev}
+		},
+	}
+}
+
+// SyncAll applies every declarative FilterRule already in the model.
+func (c *Controller) SyncAll(m model.Model) {
+	rules, err := m.GetFilterRules()
+	if err != nil {
+		log.Printf("celrule: SyncAll GetFilterRules: %v", err)
+		return
+	}
+	for _, fr := range rules {
+		c.Apply(m, fr)
+	}
+}
+
+// Apply compiles fr and registers it in the chain under the rule's id,
+// replacing a previous version. A rule that fails to compile stays registered
+// as a pass-through filter and gets a [finding.FilterRuleInvalid] finding.
+// Rules without expression are ignored unless they were declarative before,
+// in which case they become pass-through.
+func (c *Controller) Apply(m model.Model, fr mdlfilterrule.FilterRule) {
+	id := fr.GetRuleId()
+	if !mdlfilterrule.IsDeclarative(fr) {
+		if !c.isManaged(id) {
+			return // documents a compiled filter; nothing to do
+		}
+		c.chain.RegisterFilterWithID(eventfilter.FilterID(id), passThrough(fr))
+		deleteRuleFindings(m, id)
+		deleteFinding(m, uuid.NewSHA1(invalidNamespace, id[:]))
+		return
+	}
+
+	c.setManaged(id, true)
+	r, err := newRule(fr)
+	if err != nil {
+		log.Printf("celrule: rule %s (%s) is inactive: %v", id, fr.GetDisplayName(), err)
+		c.chain.RegisterFilterWithID(eventfilter.FilterID(id), passThrough(fr))
+		deleteRuleFindings(m, id)
+		raiseInvalid(m, fr, err)
+		return
+	}
+	deleteFinding(m, uuid.NewSHA1(invalidNamespace, id[:]))
+	c.chain.RegisterFilterWithID(eventfilter.FilterID(id), eventfilter.Filter{
+		DisplayName: fr.GetDisplayName(),
+		Description: fr.GetDescription(),
+		Fn:          r.filter,
+	})
+	r.reconcile(m)
+}
+
+// Remove unregisters the rule with id and deletes the findings it raised.
+func (c *Controller) Remove(m model.Model, id uuid.UUID) {
+	if !c.isManaged(id) {
+		return
+	}
+	c.setManaged(id, false)
+	c.chain.Unregister(eventfilter.FilterID(id))
+	deleteRuleFindings(m, id)
+	deleteFinding(m, uuid.NewSHA1(invalidNamespace, id[:]))
+}
+
+func (c *Controller) isManaged(id uuid.UUID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.managed[id]
+	return ok
+}
+
+func (c *Controller) setManaged(id uuid.UUID, on bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if on {
+		c.managed[id] = struct{}{}
+	} else {
+		delete(c.managed, id)
+	}
+}
+
+func passThrough(fr mdlfilterrule.FilterRule) eventfilter.Filter {
+	return eventfilter.Filter{
+		DisplayName: fr.GetDisplayName(),
+		Description: fr.GetDescription(),
+		Fn: func(_ model.Model, ev events.Event) []events.Event {
+			return []events.Event{ev}
+		},
+	}
+}
+
+// deleteRuleFindings removes every finding annotated with the rule's id.
+func deleteRuleFindings(m model.Model, ruleID uuid.UUID) {
+	findings, err := m.GetFindings()
+	if err != nil {
+		log.Printf("celrule: GetFindings: %v", err)
+		return
+	}
+	for _, f := range findings {
+		if f.GetAnnotations() == nil || f.GetAnnotations().GetValue(AnnotationFilterRuleID) != ruleID.String() {
+			continue
+		}
+		if err := m.DeleteFindingById(f.GetFindingId()); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+			log.Printf("celrule: DeleteFindingById id=%s: %v", f.GetFindingId(), err)
+		}
+	}
+}
+
+func raiseInvalid(m model.Model, fr mdlfilterrule.FilterRule, cause error) {
+	ruleID := fr.GetRuleId()
+	id := uuid.NewSHA1(invalidNamespace, ruleID[:])
+	description := fmt.Sprintf("FilterRuleInvalid: filter rule %s (%s): %v", fr.GetDisplayName(), ruleID, cause)
+	if cur := m.GetFindingById(id); cur != nil && cur.GetDescription() == description {
+		return
+	}
+
+	typeID, err := finding.EnsureType(m, finding.FilterRuleInvalid)
+	if err != nil {
+		log.Printf("celrule: %v", err)
+	}
+	f := finding.NewFinding(id)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName("Declarative filter rule check")
+	f.SetDescription(description)
+	f.SetResources([]*common.ResourceRef{
+		{ResourceId: ruleID, ResourceType: events.FilterRuleResource},
+	})
+	if err := m.AddFinding(f); err != nil {
+		log.Printf("celrule: AddFinding id=%s: %v", id, err)
+	}
+}
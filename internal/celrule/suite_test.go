@@ -0,0 +1,13 @@
+package celrule_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCelRule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "internal/celrule Suite")
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/client"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.uber.org/zap"
 )
@@ -35,6 +36,14 @@ func WithLogger(log *zap.SugaredLogger) Option {
 	}
 }
 
+// WithSubscriberToken authenticates event pushes to subscribers with token,
+// e.g. an API key of the receiving modelsrv. An empty token is ignored.
+func WithSubscriberToken(token string) Option {
+	return func(e *eventManager) {
+		e.subscriberToken = token
+	}
+}
+
 type eventManager struct {
 	mu             sync.RWMutex
 	sequenceNumber uint64
@@ -46,7 +55,8 @@ type eventManager struct {
 	historyLimit int
 	modelSink    events.EventSink
 
-	logger *zap.SugaredLogger
+	logger          *zap.SugaredLogger
+	subscriberToken string
 }
 
 func NewEventManager(opts ...Option) (events.EventManager, error) {
@@ -107,7 +117,11 @@ func (e *eventManager) AddSubscriber(subURL string) error {
 			return nil
 		}
 	}
-	newSub, err := NewSubscriber(subURL)
+	var clientOpts []client.Option
+	if e.subscriberToken != "" {
+		clientOpts = append(clientOpts, client.WithBearerToken(e.subscriberToken))
+	}
+	newSub, err := NewSubscriber(subURL, clientOpts...)
 	if err != nil {
 		e.mu.Unlock()
 		return err
@@ -139,6 +139,24 @@ var _ = Describe("EventManager", func() {
 			Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
 		})
 
+		It("sends the subscriber token as bearer token with every push", func() {
+			em, err := eventmgr.NewEventManager(eventmgr.WithSubscriberToken("emk_k1_secret"))
+			Expect(err).NotTo(HaveOccurred())
+			sink, err := em.GetSink()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(emitSystemCreate(sink, uuid.New())).To(Succeed())
+
+			var auth atomic.Value
+			srv := newPushServer(func(w http.ResponseWriter, r *http.Request) {
+				auth.Store(r.Header.Get("Authorization"))
+				w.WriteHeader(http.StatusOK)
+			})
+			defer srv.Close()
+
+			Expect(em.AddSubscriber(srv.URL + "/api")).To(Succeed())
+			Expect(auth.Load()).To(Equal("Bearer emk_k1_secret"))
+		})
+
 		It("delivers no replay when there were no prior events", func() {
 			srv, count := newPushCountingServer()
 			defer srv.Close()
@@ -17,13 +17,15 @@ type subscriber struct {
 
 var _ events.Subscriber = (*subscriber)(nil)
 
-func NewSubscriber(url string) (events.Subscriber, error) {
+// NewSubscriber returns a subscriber pushing events to the modelsrv at url;
+// opts configure its client, e.g. with a bearer token.
+func NewSubscriber(url string, opts ...client.Option) (events.Subscriber, error) {
 	sub := &subscriber{
 		url:    url,
 		status: "active",
 		id:     uuid.New(),
 	}
-	sc, err := client.NewModelSrvClient(url)
+	sc, err := client.NewModelSrvClient(url, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +90,20 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAuthzApiKeys request
+	GetAuthzApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthzApiKeysWithBody request with any body
+	PostAuthzApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthzApiKeys(ctx context.Context, body PostAuthzApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAuthzApiKeysKeyId request
+	DeleteAuthzApiKeysKeyId(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthzExplain request
+	GetAuthzExplain(ctx context.Context, params *GetAuthzExplainParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostEventsPushWithBody request with any body
 	PostEventsPushWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -189,6 +203,11 @@ type ClientInterface interface {
 	// GetLandscapeFilterRulesRuleId request
 	GetLandscapeFilterRulesRuleId(ctx context.Context, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PutLandscapeFilterRulesRuleIdEnabledWithBody request with any body
+	PutLandscapeFilterRulesRuleIdEnabledWithBody(ctx context.Context, ruleId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutLandscapeFilterRulesRuleIdEnabled(ctx context.Context, ruleId openapi_types.UUID, body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLandscapeFindingTypes request
 	GetLandscapeFindingTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -196,11 +215,19 @@ type ClientInterface interface {
 	GetLandscapeFindingTypesFindingTypeId(ctx context.Context, findingTypeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLandscapeFindings request
-	GetLandscapeFindings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLandscapeFindings(ctx context.Context, params *GetLandscapeFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLandscapeFindingsSummary request
+	GetLandscapeFindingsSummary(ctx context.Context, params *GetLandscapeFindingsSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLandscapeFindingsFindingId request
 	GetLandscapeFindingsFindingId(ctx context.Context, findingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostLandscapeFindingsFindingIdStatusWithBody request with any body
+	PostLandscapeFindingsFindingIdStatusWithBody(ctx context.Context, findingId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLandscapeFindingsFindingIdStatus(ctx context.Context, findingId openapi_types.UUID, body PostLandscapeFindingsFindingIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLandscapeGroups request
 	GetLandscapeGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -261,6 +288,9 @@ type ClientInterface interface {
 	// GetLandscapeProductsProductId request
 	GetLandscapeProductsProductId(ctx context.Context, productId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLandscapeResourcesResourceIdHealth request
+	GetLandscapeResourcesResourceIdHealth(ctx context.Context, resourceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLandscapeRoleSpecs request
 	GetLandscapeRoleSpecs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -285,10 +315,73 @@ type ClientInterface interface {
 	// GetLandscapeSystemsSystemId request
 	GetLandscapeSystemsSystemId(ctx context.Context, systemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSchemasKind request
+	GetSchemasKind(ctx context.Context, kind string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTest request
 	GetTest(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) GetAuthzApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthzApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthzApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthzApiKeysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthzApiKeys(ctx context.Context, body PostAuthzApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthzApiKeysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAuthzApiKeysKeyId(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAuthzApiKeysKeyIdRequest(c.Server, keyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthzExplain(ctx context.Context, params *GetAuthzExplainParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthzExplainRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostEventsPushWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostEventsPushRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -697,6 +790,30 @@ func (c *Client) GetLandscapeFilterRulesRuleId(ctx context.Context, ruleId opena
 	return c.Client.Do(req)
 }
 
+func (c *Client) PutLandscapeFilterRulesRuleIdEnabledWithBody(ctx context.Context, ruleId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLandscapeFilterRulesRuleIdEnabledRequestWithBody(c.Server, ruleId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutLandscapeFilterRulesRuleIdEnabled(ctx context.Context, ruleId openapi_types.UUID, body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLandscapeFilterRulesRuleIdEnabledRequest(c.Server, ruleId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetLandscapeFindingTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetLandscapeFindingTypesRequest(c.Server)
 	if err != nil {
@@ -721,8 +838,20 @@ func (c *Client) GetLandscapeFindingTypesFindingTypeId(ctx context.Context, find
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLandscapeFindings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLandscapeFindingsRequest(c.Server)
+func (c *Client) GetLandscapeFindings(ctx context.Context, params *GetLandscapeFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLandscapeFindingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLandscapeFindingsSummary(ctx context.Context, params *GetLandscapeFindingsSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLandscapeFindingsSummaryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -745,6 +874,30 @@ func (c *Client) GetLandscapeFindingsFindingId(ctx context.Context, findingId op
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostLandscapeFindingsFindingIdStatusWithBody(ctx context.Context, findingId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLandscapeFindingsFindingIdStatusRequestWithBody(c.Server, findingId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLandscapeFindingsFindingIdStatus(ctx context.Context, findingId openapi_types.UUID, body PostLandscapeFindingsFindingIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLandscapeFindingsFindingIdStatusRequest(c.Server, findingId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetLandscapeGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetLandscapeGroupsRequest(c.Server)
 	if err != nil {
@@ -985,6 +1138,18 @@ func (c *Client) GetLandscapeProductsProductId(ctx context.Context, productId op
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetLandscapeResourcesResourceIdHealth(ctx context.Context, resourceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLandscapeResourcesResourceIdHealthRequest(c.Server, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetLandscapeRoleSpecs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetLandscapeRoleSpecsRequest(c.Server)
 	if err != nil {
@@ -1081,6 +1246,18 @@ func (c *Client) GetLandscapeSystemsSystemId(ctx context.Context, systemId opena
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetSchemasKind(ctx context.Context, kind string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSchemasKindRequest(c.Server, kind)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTest(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTestRequest(c.Server)
 	if err != nil {
@@ -1093,6 +1270,184 @@ func (c *Client) GetTest(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
+// NewGetAuthzApiKeysRequest generates requests for GetAuthzApiKeys
+func NewGetAuthzApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authz/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthzApiKeysRequest calls the generic PostAuthzApiKeys builder with application/json body
+func NewPostAuthzApiKeysRequest(server string, body PostAuthzApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthzApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthzApiKeysRequestWithBody generates requests for PostAuthzApiKeys with any type of body
+func NewPostAuthzApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authz/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAuthzApiKeysKeyIdRequest generates requests for DeleteAuthzApiKeysKeyId
+func NewDeleteAuthzApiKeysKeyIdRequest(server string, keyId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authz/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthzExplainRequest generates requests for GetAuthzExplain
+func NewGetAuthzExplainRequest(server string, params *GetAuthzExplainParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authz/explain")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, params.ResourceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Subject != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Groups != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groups", runtime.ParamLocationQuery, *params.Groups); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewPostEventsPushRequest calls the generic PostEventsPush builder with application/json body
 func NewPostEventsPushRequest(server string, body PostEventsPushJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -2067,6 +2422,53 @@ func NewGetLandscapeFilterRulesRuleIdRequest(server string, ruleId openapi_types
 	return req, nil
 }
 
+// NewPutLandscapeFilterRulesRuleIdEnabledRequest calls the generic PutLandscapeFilterRulesRuleIdEnabled builder with application/json body
+func NewPutLandscapeFilterRulesRuleIdEnabledRequest(server string, ruleId openapi_types.UUID, body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutLandscapeFilterRulesRuleIdEnabledRequestWithBody(server, ruleId, "application/json", bodyReader)
+}
+
+// NewPutLandscapeFilterRulesRuleIdEnabledRequestWithBody generates requests for PutLandscapeFilterRulesRuleIdEnabled with any type of body
+func NewPutLandscapeFilterRulesRuleIdEnabledRequestWithBody(server string, ruleId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/landscape/filter-rules/%s/enabled", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetLandscapeFindingTypesRequest generates requests for GetLandscapeFindingTypes
 func NewGetLandscapeFindingTypesRequest(server string) (*http.Request, error) {
 	var err error
@@ -2110,7 +2512,99 @@ func NewGetLandscapeFindingTypesFindingTypeIdRequest(server string, findingTypeI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/landscape/findingTypes/%s", pathParam0)
+	operationPath := fmt.Sprintf("/landscape/findingTypes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLandscapeFindingsRequest generates requests for GetLandscapeFindings
+func NewGetLandscapeFindingsRequest(server string, params *GetLandscapeFindingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/landscape/findings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Severity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity", runtime.ParamLocationQuery, *params.Severity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLandscapeFindingsSummaryRequest generates requests for GetLandscapeFindingsSummary
+func NewGetLandscapeFindingsSummaryRequest(server string, params *GetLandscapeFindingsSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/landscape/findings/summary")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2120,6 +2614,44 @@ func NewGetLandscapeFindingTypesFindingTypeIdRequest(server string, findingTypeI
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Severity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity", runtime.ParamLocationQuery, *params.Severity); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2128,16 +2660,23 @@ func NewGetLandscapeFindingTypesFindingTypeIdRequest(server string, findingTypeI
 	return req, nil
 }
 
-// NewGetLandscapeFindingsRequest generates requests for GetLandscapeFindings
-func NewGetLandscapeFindingsRequest(server string) (*http.Request, error) {
+// NewGetLandscapeFindingsFindingIdRequest generates requests for GetLandscapeFindingsFindingId
+func NewGetLandscapeFindingsFindingIdRequest(server string, findingId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingId", runtime.ParamLocationPath, findingId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/landscape/findings")
+	operationPath := fmt.Sprintf("/landscape/findings/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2155,8 +2694,19 @@ func NewGetLandscapeFindingsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLandscapeFindingsFindingIdRequest generates requests for GetLandscapeFindingsFindingId
-func NewGetLandscapeFindingsFindingIdRequest(server string, findingId openapi_types.UUID) (*http.Request, error) {
+// NewPostLandscapeFindingsFindingIdStatusRequest calls the generic PostLandscapeFindingsFindingIdStatus builder with application/json body
+func NewPostLandscapeFindingsFindingIdStatusRequest(server string, findingId openapi_types.UUID, body PostLandscapeFindingsFindingIdStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLandscapeFindingsFindingIdStatusRequestWithBody(server, findingId, "application/json", bodyReader)
+}
+
+// NewPostLandscapeFindingsFindingIdStatusRequestWithBody generates requests for PostLandscapeFindingsFindingIdStatus with any type of body
+func NewPostLandscapeFindingsFindingIdStatusRequestWithBody(server string, findingId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2171,7 +2721,7 @@ func NewGetLandscapeFindingsFindingIdRequest(server string, findingId openapi_ty
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/landscape/findings/%s", pathParam0)
+	operationPath := fmt.Sprintf("/landscape/findings/%s/status", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2181,11 +2731,13 @@ func NewGetLandscapeFindingsFindingIdRequest(server string, findingId openapi_ty
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -2799,6 +3351,40 @@ func NewGetLandscapeProductsProductIdRequest(server string, productId openapi_ty
 	return req, nil
 }
 
+// NewGetLandscapeResourcesResourceIdHealthRequest generates requests for GetLandscapeResourcesResourceIdHealth
+func NewGetLandscapeResourcesResourceIdHealthRequest(server string, resourceId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/landscape/resources/%s/health", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetLandscapeRoleSpecsRequest generates requests for GetLandscapeRoleSpecs
 func NewGetLandscapeRoleSpecsRequest(server string) (*http.Request, error) {
 	var err error
@@ -3043,6 +3629,40 @@ func NewGetLandscapeSystemsSystemIdRequest(server string, systemId openapi_types
 	return req, nil
 }
 
+// NewGetSchemasKindRequest generates requests for GetSchemasKind
+func NewGetSchemasKindRequest(server string, kind string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schemas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetTestRequest generates requests for GetTest
 func NewGetTestRequest(server string) (*http.Request, error) {
 	var err error
@@ -3113,6 +3733,20 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetAuthzApiKeysWithResponse request
+	GetAuthzApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthzApiKeysResponse, error)
+
+	// PostAuthzApiKeysWithBodyWithResponse request with any body
+	PostAuthzApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthzApiKeysResponse, error)
+
+	PostAuthzApiKeysWithResponse(ctx context.Context, body PostAuthzApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthzApiKeysResponse, error)
+
+	// DeleteAuthzApiKeysKeyIdWithResponse request
+	DeleteAuthzApiKeysKeyIdWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*DeleteAuthzApiKeysKeyIdResponse, error)
+
+	// GetAuthzExplainWithResponse request
+	GetAuthzExplainWithResponse(ctx context.Context, params *GetAuthzExplainParams, reqEditors ...RequestEditorFn) (*GetAuthzExplainResponse, error)
+
 	// PostEventsPushWithBodyWithResponse request with any body
 	PostEventsPushWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEventsPushResponse, error)
 
@@ -3212,6 +3846,11 @@ type ClientWithResponsesInterface interface {
 	// GetLandscapeFilterRulesRuleIdWithResponse request
 	GetLandscapeFilterRulesRuleIdWithResponse(ctx context.Context, ruleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeFilterRulesRuleIdResponse, error)
 
+	// PutLandscapeFilterRulesRuleIdEnabledWithBodyWithResponse request with any body
+	PutLandscapeFilterRulesRuleIdEnabledWithBodyWithResponse(ctx context.Context, ruleId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLandscapeFilterRulesRuleIdEnabledResponse, error)
+
+	PutLandscapeFilterRulesRuleIdEnabledWithResponse(ctx context.Context, ruleId openapi_types.UUID, body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLandscapeFilterRulesRuleIdEnabledResponse, error)
+
 	// GetLandscapeFindingTypesWithResponse request
 	GetLandscapeFindingTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeFindingTypesResponse, error)
 
@@ -3219,11 +3858,19 @@ type ClientWithResponsesInterface interface {
 	GetLandscapeFindingTypesFindingTypeIdWithResponse(ctx context.Context, findingTypeId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeFindingTypesFindingTypeIdResponse, error)
 
 	// GetLandscapeFindingsWithResponse request
-	GetLandscapeFindingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsResponse, error)
+	GetLandscapeFindingsWithResponse(ctx context.Context, params *GetLandscapeFindingsParams, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsResponse, error)
+
+	// GetLandscapeFindingsSummaryWithResponse request
+	GetLandscapeFindingsSummaryWithResponse(ctx context.Context, params *GetLandscapeFindingsSummaryParams, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsSummaryResponse, error)
 
 	// GetLandscapeFindingsFindingIdWithResponse request
 	GetLandscapeFindingsFindingIdWithResponse(ctx context.Context, findingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsFindingIdResponse, error)
 
+	// PostLandscapeFindingsFindingIdStatusWithBodyWithResponse request with any body
+	PostLandscapeFindingsFindingIdStatusWithBodyWithResponse(ctx context.Context, findingId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLandscapeFindingsFindingIdStatusResponse, error)
+
+	PostLandscapeFindingsFindingIdStatusWithResponse(ctx context.Context, findingId openapi_types.UUID, body PostLandscapeFindingsFindingIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLandscapeFindingsFindingIdStatusResponse, error)
+
 	// GetLandscapeGroupsWithResponse request
 	GetLandscapeGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeGroupsResponse, error)
 
@@ -3284,6 +3931,9 @@ type ClientWithResponsesInterface interface {
 	// GetLandscapeProductsProductIdWithResponse request
 	GetLandscapeProductsProductIdWithResponse(ctx context.Context, productId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeProductsProductIdResponse, error)
 
+	// GetLandscapeResourcesResourceIdHealthWithResponse request
+	GetLandscapeResourcesResourceIdHealthWithResponse(ctx context.Context, resourceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeResourcesResourceIdHealthResponse, error)
+
 	// GetLandscapeRoleSpecsWithResponse request
 	GetLandscapeRoleSpecsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeRoleSpecsResponse, error)
 
@@ -3308,13 +3958,113 @@ type ClientWithResponsesInterface interface {
 	// GetLandscapeSystemsSystemIdWithResponse request
 	GetLandscapeSystemsSystemIdWithResponse(ctx context.Context, systemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeSystemsSystemIdResponse, error)
 
+	// GetSchemasKindWithResponse request
+	GetSchemasKindWithResponse(ctx context.Context, kind string, reqEditors ...RequestEditorFn) (*GetSchemasKindResponse, error)
+
 	// GetTestWithResponse request
 	GetTestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTestResponse, error)
 }
 
+type GetAuthzApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ApiKey
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthzApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthzApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthzApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ApiKeyCreated
+	JSON400      *ErrorString
+	JSON403      *ErrorString
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthzApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthzApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAuthzApiKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorString
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAuthzApiKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAuthzApiKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthzExplainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzDecision
+	JSON400      *ErrorString
+	JSON403      *ErrorString
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthzExplainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthzExplainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PostEventsPushResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON403      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
@@ -3358,6 +4108,7 @@ func (r GetEventsQuerySequenceIdResponse) StatusCode() int {
 type PostEventsRegisterResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON403      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
@@ -3937,15 +4688,85 @@ func (r GetLandscapeContextsResponse) StatusCode() int {
 	return 0
 }
 
-type GetLandscapeContextsContextIdResponse struct {
+type GetLandscapeContextsContextIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Context
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLandscapeContextsContextIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLandscapeContextsContextIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLandscapeFilterRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InstanceList
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLandscapeFilterRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLandscapeFilterRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLandscapeFilterRulesRuleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FilterRule
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLandscapeFilterRulesRuleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLandscapeFilterRulesRuleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutLandscapeFilterRulesRuleIdEnabledResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Context
+	JSON200      *FilterRule
+	JSON400      *ErrorString
+	JSON403      *ErrorString
 	JSON404      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeContextsContextIdResponse) Status() string {
+func (r PutLandscapeFilterRulesRuleIdEnabledResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3953,21 +4774,21 @@ func (r GetLandscapeContextsContextIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeContextsContextIdResponse) StatusCode() int {
+func (r PutLandscapeFilterRulesRuleIdEnabledResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFilterRulesResponse struct {
+type GetLandscapeFindingTypesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *InstanceList
+	JSON200      *[]FindingType
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFilterRulesResponse) Status() string {
+func (r GetLandscapeFindingTypesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3975,22 +4796,22 @@ func (r GetLandscapeFilterRulesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFilterRulesResponse) StatusCode() int {
+func (r GetLandscapeFindingTypesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFilterRulesRuleIdResponse struct {
+type GetLandscapeFindingTypesFindingTypeIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FilterRule
+	JSON200      *FindingType
 	JSON404      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFilterRulesRuleIdResponse) Status() string {
+func (r GetLandscapeFindingTypesFindingTypeIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3998,21 +4819,21 @@ func (r GetLandscapeFilterRulesRuleIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFilterRulesRuleIdResponse) StatusCode() int {
+func (r GetLandscapeFindingTypesFindingTypeIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFindingTypesResponse struct {
+type GetLandscapeFindingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FindingType
+	JSON200      *[]FindingView
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFindingTypesResponse) Status() string {
+func (r GetLandscapeFindingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4020,22 +4841,21 @@ func (r GetLandscapeFindingTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFindingTypesResponse) StatusCode() int {
+func (r GetLandscapeFindingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFindingTypesFindingTypeIdResponse struct {
+type GetLandscapeFindingsSummaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FindingType
-	JSON404      *ErrorString
+	JSON200      *FindingSummary
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFindingTypesFindingTypeIdResponse) Status() string {
+func (r GetLandscapeFindingsSummaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4043,21 +4863,22 @@ func (r GetLandscapeFindingTypesFindingTypeIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFindingTypesFindingTypeIdResponse) StatusCode() int {
+func (r GetLandscapeFindingsSummaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFindingsResponse struct {
+type GetLandscapeFindingsFindingIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FindingView
+	JSON200      *FindingView
+	JSON404      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFindingsResponse) Status() string {
+func (r GetLandscapeFindingsFindingIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4065,22 +4886,24 @@ func (r GetLandscapeFindingsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFindingsResponse) StatusCode() int {
+func (r GetLandscapeFindingsFindingIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLandscapeFindingsFindingIdResponse struct {
+type PostLandscapeFindingsFindingIdStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *FindingView
+	JSON400      *ErrorString
+	JSON403      *ErrorString
 	JSON404      *ErrorString
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLandscapeFindingsFindingIdResponse) Status() string {
+func (r PostLandscapeFindingsFindingIdStatusResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -4088,7 +4911,7 @@ func (r GetLandscapeFindingsFindingIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLandscapeFindingsFindingIdResponse) StatusCode() int {
+func (r PostLandscapeFindingsFindingIdStatusResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -4545,6 +5368,29 @@ func (r GetLandscapeProductsProductIdResponse) StatusCode() int {
 	return 0
 }
 
+type GetLandscapeResourcesResourceIdHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResourceHealth
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLandscapeResourcesResourceIdHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLandscapeResourcesResourceIdHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetLandscapeRoleSpecsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4725,6 +5571,29 @@ func (r GetLandscapeSystemsSystemIdResponse) StatusCode() int {
 	return 0
 }
 
+type GetSchemasKindResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON404      *ErrorString
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSchemasKindResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSchemasKindResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTestResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4746,6 +5615,50 @@ func (r GetTestResponse) StatusCode() int {
 	return 0
 }
 
+// GetAuthzApiKeysWithResponse request returning *GetAuthzApiKeysResponse
+func (c *ClientWithResponses) GetAuthzApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAuthzApiKeysResponse, error) {
+	rsp, err := c.GetAuthzApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthzApiKeysResponse(rsp)
+}
+
+// PostAuthzApiKeysWithBodyWithResponse request with arbitrary body returning *PostAuthzApiKeysResponse
+func (c *ClientWithResponses) PostAuthzApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthzApiKeysResponse, error) {
+	rsp, err := c.PostAuthzApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthzApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthzApiKeysWithResponse(ctx context.Context, body PostAuthzApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthzApiKeysResponse, error) {
+	rsp, err := c.PostAuthzApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthzApiKeysResponse(rsp)
+}
+
+// DeleteAuthzApiKeysKeyIdWithResponse request returning *DeleteAuthzApiKeysKeyIdResponse
+func (c *ClientWithResponses) DeleteAuthzApiKeysKeyIdWithResponse(ctx context.Context, keyId string, reqEditors ...RequestEditorFn) (*DeleteAuthzApiKeysKeyIdResponse, error) {
+	rsp, err := c.DeleteAuthzApiKeysKeyId(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAuthzApiKeysKeyIdResponse(rsp)
+}
+
+// GetAuthzExplainWithResponse request returning *GetAuthzExplainResponse
+func (c *ClientWithResponses) GetAuthzExplainWithResponse(ctx context.Context, params *GetAuthzExplainParams, reqEditors ...RequestEditorFn) (*GetAuthzExplainResponse, error) {
+	rsp, err := c.GetAuthzExplain(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthzExplainResponse(rsp)
+}
+
 // PostEventsPushWithBodyWithResponse request with arbitrary body returning *PostEventsPushResponse
 func (c *ClientWithResponses) PostEventsPushWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEventsPushResponse, error) {
 	rsp, err := c.PostEventsPushWithBody(ctx, contentType, body, reqEditors...)
@@ -5049,6 +5962,23 @@ func (c *ClientWithResponses) GetLandscapeFilterRulesRuleIdWithResponse(ctx cont
 	return ParseGetLandscapeFilterRulesRuleIdResponse(rsp)
 }
 
+// PutLandscapeFilterRulesRuleIdEnabledWithBodyWithResponse request with arbitrary body returning *PutLandscapeFilterRulesRuleIdEnabledResponse
+func (c *ClientWithResponses) PutLandscapeFilterRulesRuleIdEnabledWithBodyWithResponse(ctx context.Context, ruleId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLandscapeFilterRulesRuleIdEnabledResponse, error) {
+	rsp, err := c.PutLandscapeFilterRulesRuleIdEnabledWithBody(ctx, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLandscapeFilterRulesRuleIdEnabledResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutLandscapeFilterRulesRuleIdEnabledWithResponse(ctx context.Context, ruleId openapi_types.UUID, body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLandscapeFilterRulesRuleIdEnabledResponse, error) {
+	rsp, err := c.PutLandscapeFilterRulesRuleIdEnabled(ctx, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLandscapeFilterRulesRuleIdEnabledResponse(rsp)
+}
+
 // GetLandscapeFindingTypesWithResponse request returning *GetLandscapeFindingTypesResponse
 func (c *ClientWithResponses) GetLandscapeFindingTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeFindingTypesResponse, error) {
 	rsp, err := c.GetLandscapeFindingTypes(ctx, reqEditors...)
@@ -5068,14 +5998,23 @@ func (c *ClientWithResponses) GetLandscapeFindingTypesFindingTypeIdWithResponse(
 }
 
 // GetLandscapeFindingsWithResponse request returning *GetLandscapeFindingsResponse
-func (c *ClientWithResponses) GetLandscapeFindingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsResponse, error) {
-	rsp, err := c.GetLandscapeFindings(ctx, reqEditors...)
+func (c *ClientWithResponses) GetLandscapeFindingsWithResponse(ctx context.Context, params *GetLandscapeFindingsParams, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsResponse, error) {
+	rsp, err := c.GetLandscapeFindings(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseGetLandscapeFindingsResponse(rsp)
 }
 
+// GetLandscapeFindingsSummaryWithResponse request returning *GetLandscapeFindingsSummaryResponse
+func (c *ClientWithResponses) GetLandscapeFindingsSummaryWithResponse(ctx context.Context, params *GetLandscapeFindingsSummaryParams, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsSummaryResponse, error) {
+	rsp, err := c.GetLandscapeFindingsSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeFindingsSummaryResponse(rsp)
+}
+
 // GetLandscapeFindingsFindingIdWithResponse request returning *GetLandscapeFindingsFindingIdResponse
 func (c *ClientWithResponses) GetLandscapeFindingsFindingIdWithResponse(ctx context.Context, findingId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeFindingsFindingIdResponse, error) {
 	rsp, err := c.GetLandscapeFindingsFindingId(ctx, findingId, reqEditors...)
@@ -5085,6 +6024,23 @@ func (c *ClientWithResponses) GetLandscapeFindingsFindingIdWithResponse(ctx cont
 	return ParseGetLandscapeFindingsFindingIdResponse(rsp)
 }
 
+// PostLandscapeFindingsFindingIdStatusWithBodyWithResponse request with arbitrary body returning *PostLandscapeFindingsFindingIdStatusResponse
+func (c *ClientWithResponses) PostLandscapeFindingsFindingIdStatusWithBodyWithResponse(ctx context.Context, findingId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLandscapeFindingsFindingIdStatusResponse, error) {
+	rsp, err := c.PostLandscapeFindingsFindingIdStatusWithBody(ctx, findingId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLandscapeFindingsFindingIdStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLandscapeFindingsFindingIdStatusWithResponse(ctx context.Context, findingId openapi_types.UUID, body PostLandscapeFindingsFindingIdStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLandscapeFindingsFindingIdStatusResponse, error) {
+	rsp, err := c.PostLandscapeFindingsFindingIdStatus(ctx, findingId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLandscapeFindingsFindingIdStatusResponse(rsp)
+}
+
 // GetLandscapeGroupsWithResponse request returning *GetLandscapeGroupsResponse
 func (c *ClientWithResponses) GetLandscapeGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeGroupsResponse, error) {
 	rsp, err := c.GetLandscapeGroups(ctx, reqEditors...)
@@ -5265,6 +6221,15 @@ func (c *ClientWithResponses) GetLandscapeProductsProductIdWithResponse(ctx cont
 	return ParseGetLandscapeProductsProductIdResponse(rsp)
 }
 
+// GetLandscapeResourcesResourceIdHealthWithResponse request returning *GetLandscapeResourcesResourceIdHealthResponse
+func (c *ClientWithResponses) GetLandscapeResourcesResourceIdHealthWithResponse(ctx context.Context, resourceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeResourcesResourceIdHealthResponse, error) {
+	rsp, err := c.GetLandscapeResourcesResourceIdHealth(ctx, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeResourcesResourceIdHealthResponse(rsp)
+}
+
 // GetLandscapeRoleSpecsWithResponse request returning *GetLandscapeRoleSpecsResponse
 func (c *ClientWithResponses) GetLandscapeRoleSpecsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeRoleSpecsResponse, error) {
 	rsp, err := c.GetLandscapeRoleSpecs(ctx, reqEditors...)
@@ -5301,49 +6266,218 @@ func (c *ClientWithResponses) GetLandscapeRolesRoleIdWithResponse(ctx context.Co
 	return ParseGetLandscapeRolesRoleIdResponse(rsp)
 }
 
-// GetLandscapeSystemInstancesWithResponse request returning *GetLandscapeSystemInstancesResponse
-func (c *ClientWithResponses) GetLandscapeSystemInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeSystemInstancesResponse, error) {
-	rsp, err := c.GetLandscapeSystemInstances(ctx, reqEditors...)
+// GetLandscapeSystemInstancesWithResponse request returning *GetLandscapeSystemInstancesResponse
+func (c *ClientWithResponses) GetLandscapeSystemInstancesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeSystemInstancesResponse, error) {
+	rsp, err := c.GetLandscapeSystemInstances(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeSystemInstancesResponse(rsp)
+}
+
+// GetLandscapeSystemInstancesSystemInstanceIdWithResponse request returning *GetLandscapeSystemInstancesSystemInstanceIdResponse
+func (c *ClientWithResponses) GetLandscapeSystemInstancesSystemInstanceIdWithResponse(ctx context.Context, systemInstanceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeSystemInstancesSystemInstanceIdResponse, error) {
+	rsp, err := c.GetLandscapeSystemInstancesSystemInstanceId(ctx, systemInstanceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeSystemInstancesSystemInstanceIdResponse(rsp)
+}
+
+// GetLandscapeSystemsWithResponse request returning *GetLandscapeSystemsResponse
+func (c *ClientWithResponses) GetLandscapeSystemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeSystemsResponse, error) {
+	rsp, err := c.GetLandscapeSystems(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeSystemsResponse(rsp)
+}
+
+// GetLandscapeSystemsSystemIdWithResponse request returning *GetLandscapeSystemsSystemIdResponse
+func (c *ClientWithResponses) GetLandscapeSystemsSystemIdWithResponse(ctx context.Context, systemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeSystemsSystemIdResponse, error) {
+	rsp, err := c.GetLandscapeSystemsSystemId(ctx, systemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLandscapeSystemsSystemIdResponse(rsp)
+}
+
+// GetSchemasKindWithResponse request returning *GetSchemasKindResponse
+func (c *ClientWithResponses) GetSchemasKindWithResponse(ctx context.Context, kind string, reqEditors ...RequestEditorFn) (*GetSchemasKindResponse, error) {
+	rsp, err := c.GetSchemasKind(ctx, kind, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchemasKindResponse(rsp)
+}
+
+// GetTestWithResponse request returning *GetTestResponse
+func (c *ClientWithResponses) GetTestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTestResponse, error) {
+	rsp, err := c.GetTest(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTestResponse(rsp)
+}
+
+// ParseGetAuthzApiKeysResponse parses an HTTP response from a GetAuthzApiKeysWithResponse call
+func ParseGetAuthzApiKeysResponse(rsp *http.Response) (*GetAuthzApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthzApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthzApiKeysResponse parses an HTTP response from a PostAuthzApiKeysWithResponse call
+func ParsePostAuthzApiKeysResponse(rsp *http.Response) (*PostAuthzApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthzApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiKeyCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAuthzApiKeysKeyIdResponse parses an HTTP response from a DeleteAuthzApiKeysKeyIdWithResponse call
+func ParseDeleteAuthzApiKeysKeyIdResponse(rsp *http.Response) (*DeleteAuthzApiKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLandscapeSystemInstancesResponse(rsp)
-}
 
-// GetLandscapeSystemInstancesSystemInstanceIdWithResponse request returning *GetLandscapeSystemInstancesSystemInstanceIdResponse
-func (c *ClientWithResponses) GetLandscapeSystemInstancesSystemInstanceIdWithResponse(ctx context.Context, systemInstanceId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeSystemInstancesSystemInstanceIdResponse, error) {
-	rsp, err := c.GetLandscapeSystemInstancesSystemInstanceId(ctx, systemInstanceId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAuthzApiKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetLandscapeSystemInstancesSystemInstanceIdResponse(rsp)
-}
 
-// GetLandscapeSystemsWithResponse request returning *GetLandscapeSystemsResponse
-func (c *ClientWithResponses) GetLandscapeSystemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLandscapeSystemsResponse, error) {
-	rsp, err := c.GetLandscapeSystems(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseGetLandscapeSystemsResponse(rsp)
+
+	return response, nil
 }
 
-// GetLandscapeSystemsSystemIdWithResponse request returning *GetLandscapeSystemsSystemIdResponse
-func (c *ClientWithResponses) GetLandscapeSystemsSystemIdWithResponse(ctx context.Context, systemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLandscapeSystemsSystemIdResponse, error) {
-	rsp, err := c.GetLandscapeSystemsSystemId(ctx, systemId, reqEditors...)
+// ParseGetAuthzExplainResponse parses an HTTP response from a GetAuthzExplainWithResponse call
+func ParseGetAuthzExplainResponse(rsp *http.Response) (*GetAuthzExplainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLandscapeSystemsSystemIdResponse(rsp)
-}
 
-// GetTestWithResponse request returning *GetTestResponse
-func (c *ClientWithResponses) GetTestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTestResponse, error) {
-	rsp, err := c.GetTest(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAuthzExplainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetTestResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
 // ParsePostEventsPushResponse parses an HTTP response from a PostEventsPushWithResponse call
@@ -5359,6 +6493,16 @@ func ParsePostEventsPushResponse(rsp *http.Response) (*PostEventsPushResponse, e
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -5401,6 +6545,16 @@ func ParsePostEventsRegisterResponse(rsp *http.Response) (*PostEventsRegisterRes
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -6223,6 +7377,53 @@ func ParseGetLandscapeFilterRulesRuleIdResponse(rsp *http.Response) (*GetLandsca
 	return response, nil
 }
 
+// ParsePutLandscapeFilterRulesRuleIdEnabledResponse parses an HTTP response from a PutLandscapeFilterRulesRuleIdEnabledWithResponse call
+func ParsePutLandscapeFilterRulesRuleIdEnabledResponse(rsp *http.Response) (*PutLandscapeFilterRulesRuleIdEnabledResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutLandscapeFilterRulesRuleIdEnabledResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FilterRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetLandscapeFindingTypesResponse parses an HTTP response from a GetLandscapeFindingTypesWithResponse call
 func ParseGetLandscapeFindingTypesResponse(rsp *http.Response) (*GetLandscapeFindingTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -6308,6 +7509,32 @@ func ParseGetLandscapeFindingsResponse(rsp *http.Response) (*GetLandscapeFinding
 	return response, nil
 }
 
+// ParseGetLandscapeFindingsSummaryResponse parses an HTTP response from a GetLandscapeFindingsSummaryWithResponse call
+func ParseGetLandscapeFindingsSummaryResponse(rsp *http.Response) (*GetLandscapeFindingsSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLandscapeFindingsSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetLandscapeFindingsFindingIdResponse parses an HTTP response from a GetLandscapeFindingsFindingIdWithResponse call
 func ParseGetLandscapeFindingsFindingIdResponse(rsp *http.Response) (*GetLandscapeFindingsFindingIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -6341,6 +7568,53 @@ func ParseGetLandscapeFindingsFindingIdResponse(rsp *http.Response) (*GetLandsca
 	return response, nil
 }
 
+// ParsePostLandscapeFindingsFindingIdStatusResponse parses an HTTP response from a PostLandscapeFindingsFindingIdStatusWithResponse call
+func ParsePostLandscapeFindingsFindingIdStatusResponse(rsp *http.Response) (*PostLandscapeFindingsFindingIdStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLandscapeFindingsFindingIdStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetLandscapeGroupsResponse parses an HTTP response from a GetLandscapeGroupsWithResponse call
 func ParseGetLandscapeGroupsResponse(rsp *http.Response) (*GetLandscapeGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -6931,6 +8205,39 @@ func ParseGetLandscapeProductsProductIdResponse(rsp *http.Response) (*GetLandsca
 	return response, nil
 }
 
+// ParseGetLandscapeResourcesResourceIdHealthResponse parses an HTTP response from a GetLandscapeResourcesResourceIdHealthWithResponse call
+func ParseGetLandscapeResourcesResourceIdHealthResponse(rsp *http.Response) (*GetLandscapeResourcesResourceIdHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLandscapeResourcesResourceIdHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResourceHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetLandscapeRoleSpecsResponse parses an HTTP response from a GetLandscapeRoleSpecsWithResponse call
 func ParseGetLandscapeRoleSpecsResponse(rsp *http.Response) (*GetLandscapeRoleSpecsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -7167,6 +8474,39 @@ func ParseGetLandscapeSystemsSystemIdResponse(rsp *http.Response) (*GetLandscape
 	return response, nil
 }
 
+// ParseGetSchemasKindResponse parses an HTTP response from a GetSchemasKindWithResponse call
+func ParseGetSchemasKindResponse(rsp *http.Response) (*GetSchemasKindResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchemasKindResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorString
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTestResponse parses an HTTP response from a GetTestWithResponse call
 func ParseGetTestResponse(rsp *http.Response) (*GetTestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -8,12 +8,20 @@ import (
 	mdlprod "go.emeland.io/modelsrv/pkg/model/product"
 )
 
-func AnnotationsToDto(a annotations.Annotations) *[]Annotation {
+// AnnotationFilter reports whether the annotation with key may be encoded.
+// A nil filter keeps every annotation.
+type AnnotationFilter func(key string) bool
+
+// AnnotationsToDto encodes a, leaving out annotations rejected by any of keep.
+func AnnotationsToDto(a annotations.Annotations, keep ...AnnotationFilter) *[]Annotation {
 	if a == nil {
 		return nil
 	}
 	out := make([]Annotation, 0)
 	for key := range a.GetKeys() {
+		if !keepAnnotation(key, keep) {
+			continue
+		}
 		out = append(out, Annotation{Key: key, Value: a.GetValue(key)})
 	}
 	if len(out) == 0 {
@@ -22,6 +30,15 @@ func AnnotationsToDto(a annotations.Annotations) *[]Annotation {
 	return &out
 }
 
+func keepAnnotation(key string, keep []AnnotationFilter) bool {
+	for _, k := range keep {
+		if k != nil && !k(key) {
+			return false
+		}
+	}
+	return true
+}
+
 func resourceRefsToDto(refs []*common.ResourceRef) []ResourceRef {
 	out := make([]ResourceRef, 0, len(refs))
 	for _, r := range refs {
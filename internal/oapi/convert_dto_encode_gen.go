@@ -14,14 +14,14 @@ import (
 	node "go.emeland.io/modelsrv/pkg/model/node"
 )
 
-func ContextTypeToDto(v mdlctx.ContextType) ContextType {
+func ContextTypeToDto(v mdlctx.ContextType, keep ...AnnotationFilter) ContextType {
 	if v == nil {
 		return ContextType{}
 	}
 	out := ContextType{
 		ContextTypeId: uuidToOpenAPI(v.GetContextTypeId()),
 		DisplayName:   v.GetDisplayName(),
-		Annotations:   AnnotationsToDto(v.GetAnnotations()),
+		Annotations:   AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	if desc := v.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -29,14 +29,14 @@ func ContextTypeToDto(v mdlctx.ContextType) ContextType {
 	return out
 }
 
-func NodeTypeToDto(v node.NodeType) NodeType {
+func NodeTypeToDto(v node.NodeType, keep ...AnnotationFilter) NodeType {
 	if v == nil {
 		return NodeType{}
 	}
 	out := NodeType{
 		NodeTypeId:  uuidToOpenAPI(v.GetNodeTypeId()),
 		DisplayName: v.GetDisplayName(),
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	if desc := v.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -44,7 +44,7 @@ func NodeTypeToDto(v node.NodeType) NodeType {
 	return out
 }
 
-func OrgUnitToDto(v iam.OrgUnit) OrgUnit {
+func OrgUnitToDto(v iam.OrgUnit, keep ...AnnotationFilter) OrgUnit {
 	if v == nil {
 		return OrgUnit{}
 	}
@@ -53,12 +53,12 @@ func OrgUnitToDto(v iam.OrgUnit) OrgUnit {
 		OrgUnitId:   uuidToOpenAPI(v.GetOrgUnitId()),
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	return out
 }
 
-func GroupToDto(v iam.Group) Group {
+func GroupToDto(v iam.Group, keep ...AnnotationFilter) Group {
 	if v == nil {
 		return Group{}
 	}
@@ -67,12 +67,12 @@ func GroupToDto(v iam.Group) Group {
 		GroupId:     uuidToOpenAPI(v.GetGroupId()),
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	return out
 }
 
-func IdentityToDto(v iam.Identity) Identity {
+func IdentityToDto(v iam.Identity, keep ...AnnotationFilter) Identity {
 	if v == nil {
 		return Identity{}
 	}
@@ -81,12 +81,12 @@ func IdentityToDto(v iam.Identity) Identity {
 		IdentityId:  uuidToOpenAPI(v.GetIdentityId()),
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	return out
 }
 
-func ArtifactToDto(v artifact.Artifact) Artifact {
+func ArtifactToDto(v artifact.Artifact, keep ...AnnotationFilter) Artifact {
 	if v == nil {
 		return Artifact{}
 	}
@@ -97,19 +97,19 @@ func ArtifactToDto(v artifact.Artifact) Artifact {
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
 		Hash:        &hash,
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	return out
 }
 
-func CapacityResourceTypeToDto(v mdlcap.CapacityResourceType) CapacityResourceType {
+func CapacityResourceTypeToDto(v mdlcap.CapacityResourceType, keep ...AnnotationFilter) CapacityResourceType {
 	if v == nil {
 		return CapacityResourceType{}
 	}
 	out := CapacityResourceType{
 		CapacityResourceTypeId: uuidToOpenAPI(v.GetCapacityResourceTypeId()),
 		DisplayName:            v.GetDisplayName(),
-		Annotations:            AnnotationsToDto(v.GetAnnotations()),
+		Annotations:            AnnotationsToDto(v.GetAnnotations(), keep...),
 		Unit:                   v.GetUnit(),
 	}
 	if desc := v.GetDescription(); desc != "" {
@@ -2,14 +2,18 @@ package oapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/findingsummary"
 	"go.emeland.io/modelsrv/pkg/model"
 	"go.emeland.io/modelsrv/pkg/model/common"
 	"go.emeland.io/modelsrv/pkg/model/finding"
 )
 
-func findingToView(m model.Model, baseURL string, f finding.Finding) FindingView {
+// findingToView converts f for the API. References for which visible returns
+// false are redacted; a nil visible shows every reference.
+func findingToView(m model.Model, baseURL string, f finding.Finding, visible func(*common.ResourceRef) bool, keep ...AnnotationFilter) FindingView {
 	if f == nil {
 		return FindingView{}
 	}
@@ -18,7 +22,9 @@ func findingToView(m model.Model, baseURL string, f finding.Finding) FindingView
 		FindingId:   uuidToOpenAPI(id),
 		DisplayName: f.GetDisplayName(),
 		Reference:   fmt.Sprintf("%s/landscape/findings/%s", baseURL, id.String()),
-		Annotations: AnnotationsToDto(f.GetAnnotations()),
+		Status:      FindingStatus(finding.StatusOf(f, time.Now())),
+		Triage:      triageToDto(f.GetTriage()),
+		Annotations: AnnotationsToDto(f.GetAnnotations(), keep...),
 	}
 	if desc := f.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -32,6 +38,10 @@ func findingToView(m model.Model, baseURL string, f finding.Finding) FindingView
 			if desc := ft.GetDescription(); desc != "" {
 				typeView.Description = &desc
 			}
+			if sev := ft.GetSeverity(); sev != "" {
+				s := FindingSeverity(sev)
+				typeView.Severity = &s
+			}
 		}
 		out.FindingType = typeView
 	}
@@ -41,19 +51,51 @@ func findingToView(m model.Model, baseURL string, f finding.Finding) FindingView
 		if ref == nil {
 			continue
 		}
-		out.Resources = append(out.Resources, resourceViewFromRef(m, ref))
+		out.Resources = append(out.Resources, resourceViewFromRef(m, ref, visible))
 	}
 	return out
 }
 
-func resourceViewFromRef(m model.Model, ref *common.ResourceRef) ResourceView {
-	displayName := model.ResourceDisplayName(m, ref)
+// resourceViewFromRef converts ref for the API. A reference for which visible
+// returns false keeps its id and type but is marked redacted and has no name.
+func resourceViewFromRef(m model.Model, ref *common.ResourceRef, visible func(*common.ResourceRef) bool) ResourceView {
 	out := ResourceView{
 		Id:           uuidToOpenAPI(ref.ResourceId),
 		ResourceType: ref.ResourceType.String(),
 	}
+	if visible != nil && !visible(ref) {
+		redacted := true
+		out.Redacted = &redacted
+		return out
+	}
+	displayName := model.ResourceDisplayName(m, ref)
 	if displayName != "" {
 		out.DisplayName = &displayName
 	}
 	return out
 }
+
+func findingSummaryToDto(s findingsummary.Summary) FindingSummary {
+	return FindingSummary{
+		Total:          s.Total,
+		ByStatus:       summaryBucketsToDto(s.ByStatus),
+		ByFindingType:  summaryBucketsToDto(s.ByFindingType),
+		BySeverity:     summaryBucketsToDto(s.BySeverity),
+		ByOwnerGroup:   summaryBucketsToDto(s.ByOwnerGroup),
+		ByContext:      summaryBucketsToDto(s.ByContext),
+		ByResourceType: summaryBucketsToDto(s.ByResourceType),
+	}
+}
+
+func summaryBucketsToDto(buckets []findingsummary.Bucket) []FindingSummaryBucket {
+	out := make([]FindingSummaryBucket, 0, len(buckets))
+	for _, b := range buckets {
+		dto := FindingSummaryBucket{Key: b.Key, Count: b.Count}
+		if b.Name != "" {
+			name := b.Name
+			dto.DisplayName = &name
+		}
+		out = append(out, dto)
+	}
+	return out
+}
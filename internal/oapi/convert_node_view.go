@@ -21,7 +21,7 @@ func nodeTypeViewFromModel(m model.Model, typeID uuid.UUID) NodeTypeView {
 	return out
 }
 
-func nodeSummaryToView(m model.Model, baseURL string, n node.Node) NodeSummaryView {
+func nodeSummaryToView(m model.Model, baseURL string, n node.Node, keep ...AnnotationFilter) NodeSummaryView {
 	if n == nil {
 		return NodeSummaryView{}
 	}
@@ -37,12 +37,12 @@ func nodeSummaryToView(m model.Model, baseURL string, n node.Node) NodeSummaryVi
 	if typeID := n.GetNodeTypeId(); typeID != uuid.Nil {
 		out.NodeType = nodeTypeViewFromModel(m, typeID)
 	}
-	out.Annotations = AnnotationsToDto(n.GetAnnotations())
+	out.Annotations = AnnotationsToDto(n.GetAnnotations(), keep...)
 	return out
 }
 
-func nodeToView(m model.Model, baseURL string, n node.Node) NodeView {
-	summary := nodeSummaryToView(m, baseURL, n)
+func nodeToView(m model.Model, baseURL string, n node.Node, keep ...AnnotationFilter) NodeView {
+	summary := nodeSummaryToView(m, baseURL, n, keep...)
 
 	return NodeView(summary)
 }
@@ -43,14 +43,14 @@ func ContextFromDto(m model.Model, oc *Context) (mdlctx.Context, error) {
 	return c, nil
 }
 
-func ContextToDto(c mdlctx.Context) Context {
+func ContextToDto(c mdlctx.Context, keep ...AnnotationFilter) Context {
 	if c == nil {
 		return Context{}
 	}
 	out := Context{
 		ContextId:   uuidToOpenAPI(c.GetContextId()),
 		DisplayName: c.GetDisplayName(),
-		Annotations: AnnotationsToDto(c.GetAnnotations()),
+		Annotations: AnnotationsToDto(c.GetAnnotations(), keep...),
 	}
 	if desc := c.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -91,14 +91,14 @@ func nilsafeGetNodeType(m model.Model, ntid uuid.UUID) node.NodeType {
 	return m.GetNodeTypeById(ntid)
 }
 
-func NodeToDto(n node.Node) Node {
+func NodeToDto(n node.Node, keep ...AnnotationFilter) Node {
 	if n == nil {
 		return Node{}
 	}
 	out := Node{
 		NodeId:      uuidToOpenAPI(n.GetNodeId()),
 		DisplayName: n.GetDisplayName(),
-		Annotations: AnnotationsToDto(n.GetAnnotations()),
+		Annotations: AnnotationsToDto(n.GetAnnotations(), keep...),
 	}
 	if desc := n.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -148,7 +148,7 @@ func ProductFromDto(m model.Model, op *Product) (mdlprod.Product, error) {
 	return p, nil
 }
 
-func ProductToDto(p mdlprod.Product) Product {
+func ProductToDto(p mdlprod.Product, keep ...AnnotationFilter) Product {
 	if p == nil {
 		return Product{}
 	}
@@ -157,7 +157,7 @@ func ProductToDto(p mdlprod.Product) Product {
 		ProductId:   uuidToOpenAPI(p.GetProductId()),
 		DisplayName: p.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(p.GetAnnotations()),
+		Annotations: AnnotationsToDto(p.GetAnnotations(), keep...),
 	}
 	if v := p.GetVendor(); v != nil {
 		vid := v.OrgUnitId
@@ -196,11 +196,14 @@ func FindingFromDto(m model.Model, of *Finding) (finding.Finding, error) {
 	if of.Type != nil {
 		f.SetFindingTypeById(uuid.UUID(*of.Type))
 	}
+	if of.Triage != nil {
+		f.SetTriage(triageFromDto(of.Triage))
+	}
 	MergeAnnotationsFromDto(f.GetAnnotations(), of.Annotations)
 	return f, nil
 }
 
-func FindingToDto(f finding.Finding) Finding {
+func FindingToDto(f finding.Finding, keep ...AnnotationFilter) Finding {
 	if f == nil {
 		return Finding{}
 	}
@@ -210,11 +213,48 @@ func FindingToDto(f finding.Finding) Finding {
 		DisplayName: f.GetDisplayName(),
 		Description: &desc,
 		Resources:   resourceRefsToDto(f.GetResources()),
-		Annotations: AnnotationsToDto(f.GetAnnotations()),
+		Annotations: AnnotationsToDto(f.GetAnnotations(), keep...),
 	}
 	if typeID := f.GetFindingTypeId(); typeID != uuid.Nil {
 		out.Type = uuidPtr(typeID)
 	}
+	out.Triage = triageToDto(f.GetTriage())
+	return out
+}
+
+func triageFromDto(t *FindingTriage) *finding.Triage {
+	if t == nil {
+		return nil
+	}
+	out := &finding.Triage{
+		Status:          finding.Status(t.Status),
+		ChangedAt:       t.ChangedAt,
+		SuppressedUntil: t.SuppressedUntil,
+	}
+	if t.Reason != nil {
+		out.Reason = *t.Reason
+	}
+	if t.By != nil {
+		out.By = *t.By
+	}
+	return out
+}
+
+func triageToDto(t *finding.Triage) *FindingTriage {
+	if t == nil {
+		return nil
+	}
+	out := &FindingTriage{
+		Status:          FindingStatus(t.Status),
+		ChangedAt:       t.ChangedAt,
+		SuppressedUntil: t.SuppressedUntil,
+	}
+	if t.Reason != "" {
+		out.Reason = &t.Reason
+	}
+	if t.By != "" {
+		out.By = &t.By
+	}
 	return out
 }
 
@@ -238,23 +278,31 @@ func FindingTypeFromDto(m model.Model, oft *FindingType) (finding.FindingType, e
 	} else {
 		ft.SetDescription("")
 	}
+	if oft.Severity != nil {
+		ft.SetSeverity(finding.Severity(*oft.Severity))
+	}
 	MergeAnnotationsFromDto(ft.GetAnnotations(), oft.Annotations)
 	return ft, nil
 }
 
-func FindingTypeToDto(ft finding.FindingType) FindingType {
+func FindingTypeToDto(ft finding.FindingType, keep ...AnnotationFilter) FindingType {
 	if ft == nil {
 		return FindingType{}
 	}
 	id := ft.GetFindingTypeId()
 	name := ft.GetDisplayName()
 	desc := ft.GetDescription()
-	return FindingType{
+	out := FindingType{
 		FindingTypeId: uuidPtr(id),
 		DisplayName:   &name,
 		Description:   &desc,
-		Annotations:   AnnotationsToDto(ft.GetAnnotations()),
+		Annotations:   AnnotationsToDto(ft.GetAnnotations(), keep...),
 	}
+	if sev := ft.GetSeverity(); sev != "" {
+		s := FindingSeverity(sev)
+		out.Severity = &s
+	}
+	return out
 }
 
 // ArtifactInstanceFromDto builds an ArtifactInstance from a wire DTO.
@@ -286,7 +334,7 @@ func nilsafeGetArtifact(m model.Model, artID uuid.UUID) artifact.Artifact {
 	return m.GetArtifactById(artID)
 }
 
-func ArtifactInstanceToDto(ai artifact.ArtifactInstance) ArtifactInstance {
+func ArtifactInstanceToDto(ai artifact.ArtifactInstance, keep ...AnnotationFilter) ArtifactInstance {
 	if ai == nil {
 		return ArtifactInstance{}
 	}
@@ -295,7 +343,7 @@ func ArtifactInstanceToDto(ai artifact.ArtifactInstance) ArtifactInstance {
 		ArtifactInstanceId: uuidToOpenAPI(ai.GetArtifactInstanceId()),
 		DisplayName:        ai.GetDisplayName(),
 		Description:        &desc,
-		Annotations:        AnnotationsToDto(ai.GetAnnotations()),
+		Annotations:        AnnotationsToDto(ai.GetAnnotations(), keep...),
 	}
 	if ref := ai.GetArtifactRef(); ref != nil {
 		out.Artifact = uuidPtr(ref.ArtifactId)
@@ -328,7 +376,7 @@ func SystemFromDto(m model.Model, os *System) (system.System, error) {
 	return sys, nil
 }
 
-func SystemToDto(sys system.System) System {
+func SystemToDto(sys system.System, keep ...AnnotationFilter) System {
 	if sys == nil {
 		return System{}
 	}
@@ -339,7 +387,7 @@ func SystemToDto(sys system.System) System {
 		DisplayName: sys.GetDisplayName(),
 		Description: &desc,
 		Abstract:    sys.GetAbstract(),
-		Annotations: AnnotationsToDto(sys.GetAnnotations()),
+		Annotations: AnnotationsToDto(sys.GetAnnotations(), keep...),
 	}
 	if v := versionToDto(sys.GetVersion()); v != nil {
 		out.Version = v
@@ -366,14 +414,14 @@ func SystemInstanceFromDto(m model.Model, os *SystemInstance) (system.SystemInst
 	return si, nil
 }
 
-func SystemInstanceToDto(si system.SystemInstance) SystemInstance {
+func SystemInstanceToDto(si system.SystemInstance, keep ...AnnotationFilter) SystemInstance {
 	if si == nil {
 		return SystemInstance{}
 	}
 	out := SystemInstance{
 		SystemInstanceId: uuidToOpenAPI(si.GetInstanceId()),
 		DisplayName:      si.GetDisplayName(),
-		Annotations:      AnnotationsToDto(si.GetAnnotations()),
+		Annotations:      AnnotationsToDto(si.GetAnnotations(), keep...),
 	}
 	if ref := si.GetSystemRef(); ref != nil {
 		out.System = uuidToOpenAPI(ref.SystemId)
@@ -409,7 +457,7 @@ func APIFromDto(m model.Model, oa *API) (mdlapi.API, error) {
 	return dom, nil
 }
 
-func APIToDto(api mdlapi.API) API {
+func APIToDto(api mdlapi.API, keep ...AnnotationFilter) API {
 	if api == nil {
 		return API{}
 	}
@@ -420,7 +468,7 @@ func APIToDto(api mdlapi.API) API {
 		DisplayName: api.GetDisplayName(),
 		Description: &desc,
 		Type:        api.GetType().String(),
-		Annotations: AnnotationsToDto(api.GetAnnotations()),
+		Annotations: AnnotationsToDto(api.GetAnnotations(), keep...),
 	}
 	if v := versionToDto(api.GetVersion()); v != nil {
 		out.Version = v
@@ -457,14 +505,14 @@ func ApiInstanceFromDto(m model.Model, oa *ApiInstance) (mdlapi.ApiInstance, err
 	return ai, nil
 }
 
-func ApiInstanceToDto(ai mdlapi.ApiInstance) ApiInstance {
+func ApiInstanceToDto(ai mdlapi.ApiInstance, keep ...AnnotationFilter) ApiInstance {
 	if ai == nil {
 		return ApiInstance{}
 	}
 	out := ApiInstance{
 		ApiInstanceId: uuidToOpenAPI(ai.GetInstanceId()),
 		DisplayName:   ai.GetDisplayName(),
-		Annotations:   AnnotationsToDto(ai.GetAnnotations()),
+		Annotations:   AnnotationsToDto(ai.GetAnnotations(), keep...),
 	}
 	if ref := ai.GetApiRef(); ref != nil {
 		out.Api = uuidPtr(ref.ApiID)
@@ -503,7 +551,7 @@ func ComponentFromDto(m model.Model, oc *Component) (component.Component, error)
 	return c, nil
 }
 
-func ComponentToDto(c component.Component) Component {
+func ComponentToDto(c component.Component, keep ...AnnotationFilter) Component {
 	if c == nil {
 		return Component{}
 	}
@@ -513,7 +561,7 @@ func ComponentToDto(c component.Component) Component {
 		ComponentId: uuidPtr(id),
 		DisplayName: c.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(c.GetAnnotations()),
+		Annotations: AnnotationsToDto(c.GetAnnotations(), keep...),
 	}
 	if v := versionToDto(c.GetVersion()); v != nil {
 		out.Version = v
@@ -560,14 +608,14 @@ func ComponentInstanceFromDto(m model.Model, oc *ComponentInstance) (component.C
 	return ci, nil
 }
 
-func ComponentInstanceToDto(ci component.ComponentInstance) ComponentInstance {
+func ComponentInstanceToDto(ci component.ComponentInstance, keep ...AnnotationFilter) ComponentInstance {
 	if ci == nil {
 		return ComponentInstance{}
 	}
 	out := ComponentInstance{
 		ComponentInstanceId: uuidToOpenAPI(ci.GetInstanceId()),
 		DisplayName:         ci.GetDisplayName(),
-		Annotations:         AnnotationsToDto(ci.GetAnnotations()),
+		Annotations:         AnnotationsToDto(ci.GetAnnotations(), keep...),
 	}
 	if ref := ci.GetComponentRef(); ref != nil {
 		out.Component = uuidToOpenAPI(ref.ComponentId)
@@ -593,7 +641,7 @@ func PermissionSpecFromDto(m model.Model, o *PermissionSpec) (iam.PermissionSpec
 	return v, nil
 }
 
-func PermissionSpecToDto(v iam.PermissionSpec) PermissionSpec {
+func PermissionSpecToDto(v iam.PermissionSpec, keep ...AnnotationFilter) PermissionSpec {
 	if v == nil {
 		return PermissionSpec{}
 	}
@@ -602,7 +650,7 @@ func PermissionSpecToDto(v iam.PermissionSpec) PermissionSpec {
 		PermissionSpecId: uuidToOpenAPI(v.GetPermissionSpecId()),
 		DisplayName:      v.GetDisplayName(),
 		Description:      &desc,
-		Annotations:      AnnotationsToDto(v.GetAnnotations()),
+		Annotations:      AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 }
 
@@ -639,7 +687,7 @@ func nilsafeGetPermissionSpec(m model.Model, id uuid.UUID) iam.PermissionSpec {
 	return m.GetPermissionSpecById(id)
 }
 
-func RoleSpecToDto(v iam.RoleSpec) RoleSpec {
+func RoleSpecToDto(v iam.RoleSpec, keep ...AnnotationFilter) RoleSpec {
 	if v == nil {
 		return RoleSpec{}
 	}
@@ -649,7 +697,7 @@ func RoleSpecToDto(v iam.RoleSpec) RoleSpec {
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
 		Permissions: iamPermissionSpecRefsToOpenAPIUUIDs(v.GetPermissions()),
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 }
 
@@ -669,7 +717,7 @@ func PermissionFromDto(m model.Model, o *Permission) (iam.Permission, error) {
 	return v, nil
 }
 
-func PermissionToDto(v iam.Permission) Permission {
+func PermissionToDto(v iam.Permission, keep ...AnnotationFilter) Permission {
 	if v == nil {
 		return Permission{}
 	}
@@ -679,7 +727,7 @@ func PermissionToDto(v iam.Permission) Permission {
 		DisplayName:  v.GetDisplayName(),
 		Description:  &desc,
 		Spec:         openapi_types.UUID(v.GetPermissionSpecId()),
-		Annotations:  AnnotationsToDto(v.GetAnnotations()),
+		Annotations:  AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 }
 
@@ -739,7 +787,7 @@ func nilsafeGetContext(m model.Model, id uuid.UUID) mdlctx.Context {
 	return m.GetContextById(id)
 }
 
-func RoleToDto(v iam.Role) Role {
+func RoleToDto(v iam.Role, keep ...AnnotationFilter) Role {
 	if v == nil {
 		return Role{}
 	}
@@ -751,7 +799,7 @@ func RoleToDto(v iam.Role) Role {
 		Spec:        openapi_types.UUID(v.GetRoleSpecId()),
 		Permissions: iamPermissionRefsToOpenAPIUUIDs(v.GetPermissions()),
 		Resources:   iamResourceRefsOptional(v.GetResources()),
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	if cr := v.GetContextRef(); cr != nil {
 		out.Context = openapi_types.UUID(cr.EffectiveParentContextID())
@@ -810,7 +858,7 @@ func nilsafeGetIdentity(m model.Model, id uuid.UUID) iam.Identity {
 	return m.GetIdentityById(id)
 }
 
-func BindingToDto(v iam.Binding) Binding {
+func BindingToDto(v iam.Binding, keep ...AnnotationFilter) Binding {
 	if v == nil {
 		return Binding{}
 	}
@@ -819,7 +867,7 @@ func BindingToDto(v iam.Binding) Binding {
 		BindingId:   uuidToOpenAPI(v.GetBindingId()),
 		DisplayName: v.GetDisplayName(),
 		Description: &desc,
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	if rr := v.GetRole(); rr != nil {
 		out.Role = openapi_types.UUID(rr.EffectiveRoleID())
@@ -839,10 +887,30 @@ func FilterRuleFromDto(m model.Model, o *FilterRule) (mdlfilterrule.FilterRule,
 	if o.Description != nil {
 		fr.SetDescription(*o.Description)
 	}
+	if o.ResourceType != nil {
+		fr.SetResourceType(*o.ResourceType)
+	}
+	if o.Expression != nil {
+		fr.SetExpression(*o.Expression)
+	}
+	if o.Action != nil {
+		fr.SetAction(mdlfilterrule.Action(*o.Action))
+	}
+	if o.FindingType != nil {
+		fr.SetFindingType(*o.FindingType)
+	}
+	if o.Message != nil {
+		fr.SetMessage(*o.Message)
+	}
+	if o.Disabled != nil {
+		fr.SetDisabled(*o.Disabled)
+	}
 	return fr, nil
 }
 
-func FilterRuleToDto(v mdlfilterrule.FilterRule) FilterRule {
+// FilterRuleToDto encodes v. FilterRules carry no annotations on the wire, so
+// annotation filters are accepted only for symmetry with the other encoders.
+func FilterRuleToDto(v mdlfilterrule.FilterRule, _ ...AnnotationFilter) FilterRule {
 	if v == nil {
 		return FilterRule{}
 	}
@@ -853,6 +921,26 @@ func FilterRuleToDto(v mdlfilterrule.FilterRule) FilterRule {
 	if desc := v.GetDescription(); desc != "" {
 		out.Description = &desc
 	}
+	if rt := v.GetResourceType(); rt != "" {
+		out.ResourceType = &rt
+	}
+	if expr := v.GetExpression(); expr != "" {
+		out.Expression = &expr
+	}
+	if action := v.GetAction(); action != mdlfilterrule.ActionNone {
+		a := string(action)
+		out.Action = &a
+	}
+	if ft := v.GetFindingType(); ft != "" {
+		out.FindingType = &ft
+	}
+	if msg := v.GetMessage(); msg != "" {
+		out.Message = &msg
+	}
+	if v.GetDisabled() {
+		disabled := true
+		out.Disabled = &disabled
+	}
 	return out
 }
 
@@ -870,7 +958,8 @@ func MergeRuleFromDto(m model.Model, o *MergeRule) (mdlmergerule.MergeRule, erro
 	return mr, nil
 }
 
-func MergeRuleToDto(v mdlmergerule.MergeRule) MergeRule {
+// MergeRuleToDto encodes v; like [FilterRuleToDto] it has no annotations.
+func MergeRuleToDto(v mdlmergerule.MergeRule, _ ...AnnotationFilter) MergeRule {
 	if v == nil {
 		return MergeRule{}
 	}
@@ -908,7 +997,7 @@ func CapabilityFromDto(m model.Model, o *Capability) (mdlcapability.Capability,
 	return c, nil
 }
 
-func CapabilityToDto(v mdlcapability.Capability) Capability {
+func CapabilityToDto(v mdlcapability.Capability, keep ...AnnotationFilter) Capability {
 	if v == nil {
 		return Capability{}
 	}
@@ -926,7 +1015,7 @@ func CapabilityToDto(v mdlcapability.Capability) Capability {
 		}
 		out.Versions = &refs
 	}
-	out.Annotations = AnnotationsToDto(v.GetAnnotations())
+	out.Annotations = AnnotationsToDto(v.GetAnnotations(), keep...)
 	return out
 }
 
@@ -947,7 +1036,7 @@ func ParameterFromDto(m model.Model, o *Parameter) (mdlparameter.Parameter, erro
 	return param, nil
 }
 
-func ParameterToDto(v mdlparameter.Parameter) Parameter {
+func ParameterToDto(v mdlparameter.Parameter, keep ...AnnotationFilter) Parameter {
 	if v == nil {
 		return Parameter{}
 	}
@@ -958,7 +1047,7 @@ func ParameterToDto(v mdlparameter.Parameter) Parameter {
 	if vals := v.GetValues(); len(vals) > 0 {
 		out.Values = &vals
 	}
-	out.Annotations = AnnotationsToDto(v.GetAnnotations())
+	out.Annotations = AnnotationsToDto(v.GetAnnotations(), keep...)
 	return out
 }
 
@@ -1007,7 +1096,7 @@ func CapacityFromDto(m model.Model, o *Capacity) (mdlcap.Capacity, error) {
 	return v, nil
 }
 
-func CapacityToDto(v mdlcap.Capacity) Capacity {
+func CapacityToDto(v mdlcap.Capacity, keep ...AnnotationFilter) Capacity {
 	if v == nil {
 		return Capacity{}
 	}
@@ -1022,7 +1111,7 @@ func CapacityToDto(v mdlcap.Capacity) Capacity {
 		ContextRef: CapacityContextRef{
 			ContextId: uuidToOpenAPI(v.GetContextId()),
 		},
-		Annotations: AnnotationsToDto(v.GetAnnotations()),
+		Annotations: AnnotationsToDto(v.GetAnnotations(), keep...),
 	}
 	if desc := v.GetDescription(); desc != "" {
 		out.Description = &desc
@@ -44,6 +44,12 @@ func PushWireEventFromDomain(ev *events.Event) (Event, error) {
 	}, nil
 }
 
+// ResourceWireMap converts a domain resource into its OpenAPI-shaped JSON map,
+// the same representation used for replication payloads.
+func ResourceWireMap(rt events.ResourceType, obj any) (map[string]interface{}, error) {
+	return encodeReplicationResourceToWireMap(rt, obj)
+}
+
 func firstEventObject(ev *events.Event) (any, bool) {
 	if len(ev.Objects) == 0 {
 		return nil, false
@@ -25,183 +25,184 @@ import (
 
 // encodeReplicationResourceToWireMap converts a domain resource into an OpenAPI-shaped
 // JSON map for POST /events/push. DTO encoders include annotations and scalar ref fields
-// that encoding/json on domain structs omits or mis-shapes.
-func encodeReplicationResourceToWireMap(rt events.ResourceType, obj any) (map[string]interface{}, error) {
+// that encoding/json on domain structs omits or mis-shapes. Annotations rejected by
+// any of keep are left out.
+func encodeReplicationResourceToWireMap(rt events.ResourceType, obj any, keep ...AnnotationFilter) (map[string]interface{}, error) {
 	switch rt {
 	case events.ContextTypeResource:
 		v, ok := obj.(mdlctx.ContextType)
 		if !ok {
 			return nil, fmt.Errorf("expected ContextType, got %T", obj)
 		}
-		return jsonMap(ContextTypeToDto(v))
+		return jsonMap(ContextTypeToDto(v, keep...))
 	case events.ContextResource:
 		v, ok := obj.(mdlctx.Context)
 		if !ok {
 			return nil, fmt.Errorf("expected Context, got %T", obj)
 		}
-		return jsonMap(ContextToDto(v))
+		return jsonMap(ContextToDto(v, keep...))
 	case events.SystemResource:
 		v, ok := obj.(system.System)
 		if !ok {
 			return nil, fmt.Errorf("expected System, got %T", obj)
 		}
-		return jsonMap(SystemToDto(v))
+		return jsonMap(SystemToDto(v, keep...))
 	case events.NodeTypeResource:
 		v, ok := obj.(node.NodeType)
 		if !ok {
 			return nil, fmt.Errorf("expected NodeType, got %T", obj)
 		}
-		return jsonMap(NodeTypeToDto(v))
+		return jsonMap(NodeTypeToDto(v, keep...))
 	case events.FindingTypeResource:
 		v, ok := obj.(finding.FindingType)
 		if !ok {
 			return nil, fmt.Errorf("expected FindingType, got %T", obj)
 		}
-		return jsonMap(FindingTypeToDto(v))
+		return jsonMap(FindingTypeToDto(v, keep...))
 	case events.NodeResource:
 		v, ok := obj.(node.Node)
 		if !ok {
 			return nil, fmt.Errorf("expected Node, got %T", obj)
 		}
-		return jsonMap(NodeToDto(v))
+		return jsonMap(NodeToDto(v, keep...))
 	case events.APIInstanceResource:
 		v, ok := obj.(mdlapi.ApiInstance)
 		if !ok {
 			return nil, fmt.Errorf("expected ApiInstance, got %T", obj)
 		}
-		return jsonMap(ApiInstanceToDto(v))
+		return jsonMap(ApiInstanceToDto(v, keep...))
 	case events.APIResource:
 		v, ok := obj.(mdlapi.API)
 		if !ok {
 			return nil, fmt.Errorf("expected API, got %T", obj)
 		}
-		return jsonMap(APIToDto(v))
+		return jsonMap(APIToDto(v, keep...))
 	case events.ComponentResource:
 		v, ok := obj.(component.Component)
 		if !ok {
 			return nil, fmt.Errorf("expected Component, got %T", obj)
 		}
-		return jsonMap(ComponentToDto(v))
+		return jsonMap(ComponentToDto(v, keep...))
 	case events.SystemInstanceResource:
 		v, ok := obj.(system.SystemInstance)
 		if !ok {
 			return nil, fmt.Errorf("expected SystemInstance, got %T", obj)
 		}
-		return jsonMap(SystemInstanceToDto(v))
+		return jsonMap(SystemInstanceToDto(v, keep...))
 	case events.ComponentInstanceResource:
 		v, ok := obj.(component.ComponentInstance)
 		if !ok {
 			return nil, fmt.Errorf("expected ComponentInstance, got %T", obj)
 		}
-		return jsonMap(ComponentInstanceToDto(v))
+		return jsonMap(ComponentInstanceToDto(v, keep...))
 	case events.FindingResource:
 		v, ok := obj.(finding.Finding)
 		if !ok {
 			return nil, fmt.Errorf("expected Finding, got %T", obj)
 		}
-		return jsonMap(FindingToDto(v))
+		return jsonMap(FindingToDto(v, keep...))
 	case events.OrgUnitResource:
 		v, ok := obj.(iam.OrgUnit)
 		if !ok {
 			return nil, fmt.Errorf("expected OrgUnit, got %T", obj)
 		}
-		return jsonMap(OrgUnitToDto(v))
+		return jsonMap(OrgUnitToDto(v, keep...))
 	case events.GroupResource:
 		v, ok := obj.(iam.Group)
 		if !ok {
 			return nil, fmt.Errorf("expected Group, got %T", obj)
 		}
-		return jsonMap(GroupToDto(v))
+		return jsonMap(GroupToDto(v, keep...))
 	case events.IdentityResource:
 		v, ok := obj.(iam.Identity)
 		if !ok {
 			return nil, fmt.Errorf("expected Identity, got %T", obj)
 		}
-		return jsonMap(IdentityToDto(v))
+		return jsonMap(IdentityToDto(v, keep...))
 	case events.PermissionSpecResource:
 		v, ok := obj.(iam.PermissionSpec)
 		if !ok {
 			return nil, fmt.Errorf("expected PermissionSpec, got %T", obj)
 		}
-		return jsonMap(PermissionSpecToDto(v))
+		return jsonMap(PermissionSpecToDto(v, keep...))
 	case events.RoleSpecResource:
 		v, ok := obj.(iam.RoleSpec)
 		if !ok {
 			return nil, fmt.Errorf("expected RoleSpec, got %T", obj)
 		}
-		return jsonMap(RoleSpecToDto(v))
+		return jsonMap(RoleSpecToDto(v, keep...))
 	case events.PermissionResource:
 		v, ok := obj.(iam.Permission)
 		if !ok {
 			return nil, fmt.Errorf("expected Permission, got %T", obj)
 		}
-		return jsonMap(PermissionToDto(v))
+		return jsonMap(PermissionToDto(v, keep...))
 	case events.RoleResource:
 		v, ok := obj.(iam.Role)
 		if !ok {
 			return nil, fmt.Errorf("expected Role, got %T", obj)
 		}
-		return jsonMap(RoleToDto(v))
+		return jsonMap(RoleToDto(v, keep...))
 	case events.BindingResource:
 		v, ok := obj.(iam.Binding)
 		if !ok {
 			return nil, fmt.Errorf("expected Binding, got %T", obj)
 		}
-		return jsonMap(BindingToDto(v))
+		return jsonMap(BindingToDto(v, keep...))
 	case events.ArtifactResource:
 		v, ok := obj.(artifact.Artifact)
 		if !ok {
 			return nil, fmt.Errorf("expected Artifact, got %T", obj)
 		}
-		return jsonMap(ArtifactToDto(v))
+		return jsonMap(ArtifactToDto(v, keep...))
 	case events.ArtifactInstanceResource:
 		v, ok := obj.(artifact.ArtifactInstance)
 		if !ok {
 			return nil, fmt.Errorf("expected ArtifactInstance, got %T", obj)
 		}
-		return jsonMap(ArtifactInstanceToDto(v))
+		return jsonMap(ArtifactInstanceToDto(v, keep...))
 	case events.ProductResource:
 		v, ok := obj.(mdlprod.Product)
 		if !ok {
 			return nil, fmt.Errorf("expected Product, got %T", obj)
 		}
-		return jsonMap(ProductToDto(v))
+		return jsonMap(ProductToDto(v, keep...))
 	case events.FilterRuleResource:
 		v, ok := obj.(mdlfilterrule.FilterRule)
 		if !ok {
 			return nil, fmt.Errorf("expected FilterRule, got %T", obj)
 		}
-		return jsonMap(FilterRuleToDto(v))
+		return jsonMap(FilterRuleToDto(v, keep...))
 	case events.MergeRuleResource:
 		v, ok := obj.(mdlmergerule.MergeRule)
 		if !ok {
 			return nil, fmt.Errorf("expected MergeRule, got %T", obj)
 		}
-		return jsonMap(MergeRuleToDto(v))
+		return jsonMap(MergeRuleToDto(v, keep...))
 	case events.CapabilityResource:
 		v, ok := obj.(mdlcapability.Capability)
 		if !ok {
 			return nil, fmt.Errorf("expected Capability, got %T", obj)
 		}
-		return jsonMap(CapabilityToDto(v))
+		return jsonMap(CapabilityToDto(v, keep...))
 	case events.ParameterResource:
 		v, ok := obj.(mdlparameter.Parameter)
 		if !ok {
 			return nil, fmt.Errorf("expected Parameter, got %T", obj)
 		}
-		return jsonMap(ParameterToDto(v))
+		return jsonMap(ParameterToDto(v, keep...))
 	case events.CapacityResourceTypeResource:
 		v, ok := obj.(mdlcap.CapacityResourceType)
 		if !ok {
 			return nil, fmt.Errorf("expected CapacityResourceType, got %T", obj)
 		}
-		return jsonMap(CapacityResourceTypeToDto(v))
+		return jsonMap(CapacityResourceTypeToDto(v, keep...))
 	case events.CapacityResource:
 		v, ok := obj.(mdlcap.Capacity)
 		if !ok {
 			return nil, fmt.Errorf("expected Capacity, got %T", obj)
 		}
-		return jsonMap(CapacityToDto(v))
+		return jsonMap(CapacityToDto(v, keep...))
 	default:
 		return nil, fmt.Errorf("unsupported resource type for encode: %s", rt)
 	}
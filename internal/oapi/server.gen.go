@@ -30,6 +30,23 @@ const (
 	Requested CapacityCategory = "requested"
 )
 
+// Defines values for FindingSeverity.
+const (
+	Critical FindingSeverity = "critical"
+	High     FindingSeverity = "high"
+	Info     FindingSeverity = "info"
+	Low      FindingSeverity = "low"
+	Medium   FindingSeverity = "medium"
+)
+
+// Defines values for FindingStatus.
+const (
+	Acknowledged FindingStatus = "acknowledged"
+	Open         FindingStatus = "open"
+	Resolved     FindingStatus = "resolved"
+	Suppressed   FindingStatus = "suppressed"
+)
+
 // Defines values for NodeTypeViewResource.
 const (
 	NodeTypeViewResourceNodeType NodeTypeViewResource = "NodeType"
@@ -86,6 +103,39 @@ type ApiInstance struct {
 	SystemInstance *openapi_types.UUID `json:"systemInstance,omitempty"`
 }
 
+// ApiKey An API key bound to an Identity. Only a hash of the token is stored.
+type ApiKey struct {
+	// Actions Permitted actions, any of read, write, push or *.
+	Actions    []string           `json:"actions"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	IdentityId openapi_types.UUID `json:"identityId"`
+	KeyId      string             `json:"keyId"`
+	Name       *string            `json:"name,omitempty"`
+
+	// ResourceTypes Resource types the key may access; empty allows all.
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// ApiKeyCreated defines model for ApiKeyCreated.
+type ApiKeyCreated struct {
+	// Key An API key bound to an Identity. Only a hash of the token is stored.
+	Key ApiKey `json:"key"`
+
+	// Token The bearer token. It cannot be retrieved again.
+	Token string `json:"token"`
+}
+
+// ApiKeyRequest defines model for ApiKeyRequest.
+type ApiKeyRequest struct {
+	// Actions Defaults to read.
+	Actions       *[]string          `json:"actions,omitempty"`
+	ExpiresAt     *time.Time         `json:"expiresAt,omitempty"`
+	IdentityId    openapi_types.UUID `json:"identityId"`
+	Name          *string            `json:"name,omitempty"`
+	ResourceTypes *[]string          `json:"resourceTypes,omitempty"`
+}
+
 // Artifact Represents a binary artefact (e.g. software package, archive, binary executable) tracked in the landscape.
 type Artifact struct {
 	// Annotations A set of key-value pairs for storing additional metadata about the artifact.
@@ -122,6 +172,30 @@ type ArtifactInstance struct {
 	DisplayName string `json:"displayName"`
 }
 
+// AuthzDecision The trace of a visibility decision.
+type AuthzDecision struct {
+	// Annotations The resource's ownership annotations.
+	Annotations []Annotation `json:"annotations"`
+
+	// DecidedBy The check that granted visibility; absent when the resource is hidden.
+	DecidedBy *string  `json:"decidedBy,omitempty"`
+	Groups    []string `json:"groups"`
+
+	// Resource A resolved resource reference for read API responses.
+	Resource ResourceView `json:"resource"`
+	Steps    []AuthzStep  `json:"steps"`
+	Subject  string       `json:"subject"`
+	Visible  bool         `json:"visible"`
+}
+
+// AuthzStep One visibility check, in evaluation order.
+type AuthzStep struct {
+	// Check public-type, auditor, or the name of a visibility rule.
+	Check   string `json:"check"`
+	Detail  string `json:"detail"`
+	Granted bool   `json:"granted"`
+}
+
 // Binding Binds a subject (group or identity) to a role.
 type Binding struct {
 	Annotations *[]Annotation      `json:"annotations,omitempty"`
@@ -314,16 +388,94 @@ type Event struct {
 
 // FilterRule Documents a filter registered in a modelsrv instance. Filter rules describe how change events may be passed through, suppressed, or expanded by the event filter chain.
 type FilterRule struct {
+	// Action What to do with matching events, either drop (suppress the event) or finding (raise a Finding on the resource).
+	Action *string `json:"action,omitempty"`
+
 	// Description A brief description of what the filter rule does.
 	Description *string `json:"description,omitempty"`
 
+	// Disabled When true the filter is skipped and events pass it unchanged.
+	Disabled *bool `json:"disabled,omitempty"`
+
 	// DisplayName The human-readable name of the filter rule.
 	DisplayName string `json:"displayName"`
 
+	// Expression A CEL expression over event, resource and annotations that selects the events the action applies to. Empty for rules that only document a compiled filter.
+	Expression *string `json:"expression,omitempty"`
+
+	// FindingType The FindingType name raised by the finding action.
+	FindingType *string `json:"findingType,omitempty"`
+
+	// Message An optional CEL string expression rendering the Finding description.
+	Message *string `json:"message,omitempty"`
+
+	// ResourceType Only evaluate events of this resource type (e.g. ApiInstance). Empty matches every type.
+	ResourceType *string `json:"resourceType,omitempty"`
+
 	// RuleId An UUID that uniquely identifies the filter rule.
 	RuleId openapi_types.UUID `json:"ruleId"`
 }
 
+// FilterRuleEnabled Enables or disables a filter at runtime.
+type FilterRuleEnabled struct {
+	Enabled bool `json:"enabled"`
+}
+
+// FindingSeverity How urgently findings of a type need attention.
+type FindingSeverity string
+
+// FindingStatus The triage state of a finding. Findings without triage are open.
+type FindingStatus string
+
+// FindingStatusUpdate A request to change the triage status of a finding.
+type FindingStatusUpdate struct {
+	// By Who makes the change. Ignored when the request carries an authenticated principal.
+	By     *string `json:"by,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+
+	// Status The triage state of a finding. Findings without triage are open.
+	Status FindingStatus `json:"status"`
+
+	// SuppressedUntil Only valid with status suppressed. Omit to suppress indefinitely.
+	SuppressedUntil *time.Time `json:"suppressedUntil,omitempty"`
+}
+
+// FindingSummary Finding counts broken down by several dimensions. Context counts include all findings in the context's subtree.
+type FindingSummary struct {
+	ByContext      []FindingSummaryBucket `json:"byContext"`
+	ByFindingType  []FindingSummaryBucket `json:"byFindingType"`
+	ByOwnerGroup   []FindingSummaryBucket `json:"byOwnerGroup"`
+	ByResourceType []FindingSummaryBucket `json:"byResourceType"`
+	BySeverity     []FindingSummaryBucket `json:"bySeverity"`
+	ByStatus       []FindingSummaryBucket `json:"byStatus"`
+	Total          int                    `json:"total"`
+}
+
+// FindingSummaryBucket The number of findings sharing one value of a summary dimension.
+type FindingSummaryBucket struct {
+	Count int `json:"count"`
+
+	// DisplayName The display name of the resource the key refers to, when known.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Key The status, severity or resource kind, or the UUID of the finding type, group or context. Empty for findings without a value, e.g. unowned ones.
+	Key string `json:"key"`
+}
+
+// FindingTriage Records who moved a finding to its current status and why.
+type FindingTriage struct {
+	// By The principal that changed the status.
+	By        *string   `json:"by,omitempty"`
+	ChangedAt time.Time `json:"changedAt"`
+	Reason    *string   `json:"reason,omitempty"`
+
+	// Status The triage state of a finding. Findings without triage are open.
+	Status FindingStatus `json:"status"`
+
+	// SuppressedUntil When set on a suppressed finding, the suppression lapses at this time.
+	SuppressedUntil *time.Time `json:"suppressedUntil,omitempty"`
+}
+
 // FindingType Represents a type of findings in the EmELand model. A finding type defines the type of rule violation and provides metadata about the rule. They are defined by the original source of a finding, e.g., a data collector or a compliance standard. Use the FindingType of a Finding to ensure any further processing or filtering is only applied to findings that are actually understood by the filter.
 type FindingType struct {
 	// Annotations A set of key-value pairs for storing additional metadata about the finding class.
@@ -337,6 +489,9 @@ type FindingType struct {
 
 	// FindingTypeId An UUID that uniquely identifies the finding class. It should be generated and assigned when the finding class is created and must remain constant throughout the finding class's lifecycle. The field is optional in some contexts, such as when creating a new finding class where the ID may be generated by the system itself.
 	FindingTypeId *openapi_types.UUID `json:"findingTypeId,omitempty"`
+
+	// Severity How urgently findings of a type need attention.
+	Severity *FindingSeverity `json:"severity,omitempty"`
 }
 
 // FindingTypeView A resolved finding type reference for read API responses.
@@ -347,6 +502,9 @@ type FindingTypeView struct {
 	// DisplayName The human-readable name of the finding class.
 	DisplayName   string             `json:"displayName"`
 	FindingTypeId openapi_types.UUID `json:"findingTypeId"`
+
+	// Severity How urgently findings of a type need attention.
+	Severity *FindingSeverity `json:"severity,omitempty"`
 }
 
 // FindingView An enriched finding for read API responses with resolved type and resource references.
@@ -365,6 +523,12 @@ type FindingView struct {
 	// Reference A URI reference to this finding.
 	Reference string         `json:"reference"`
 	Resources []ResourceView `json:"resources"`
+
+	// Status The triage state of a finding. Findings without triage are open.
+	Status FindingStatus `json:"status"`
+
+	// Triage Records who moved a finding to its current status and why.
+	Triage *FindingTriage `json:"triage,omitempty"`
 }
 
 // Group Represents a group of identities in the EmELand model.
@@ -571,6 +735,20 @@ type ProductionVersion struct {
 	TerminatedFrom *time.Time `json:"terminatedFrom,omitempty"`
 }
 
+// ResourceHealth The findings citing a resource. A resource is healthy when none of them is open or acknowledged.
+type ResourceHealth struct {
+	// ActiveFindings The number of open and acknowledged findings.
+	ActiveFindings int           `json:"activeFindings"`
+	Findings       []FindingView `json:"findings"`
+	Healthy        bool          `json:"healthy"`
+
+	// Resource A resolved resource reference for read API responses.
+	Resource ResourceView `json:"resource"`
+
+	// WorstSeverity How urgently findings of a type need attention.
+	WorstSeverity *FindingSeverity `json:"worstSeverity,omitempty"`
+}
+
 // ResourceRef defines model for ResourceRef.
 type ResourceRef struct {
 	// Reference A URI reference to the resource.
@@ -591,6 +769,9 @@ type ResourceView struct {
 	// Id The UUID of the resource referenced.
 	Id openapi_types.UUID `json:"id"`
 
+	// Redacted True when the caller may not see the referenced resource; its name is then withheld.
+	Redacted *bool `json:"redacted,omitempty"`
+
 	// ResourceType The type of the resource referenced.
 	ResourceType interface{} `json:"resourceType"`
 }
@@ -681,6 +862,17 @@ type Version struct {
 	Version string `json:"version"`
 }
 
+// GetAuthzExplainParams defines parameters for GetAuthzExplain.
+type GetAuthzExplainParams struct {
+	ResourceId openapi_types.UUID `form:"resourceId" json:"resourceId"`
+
+	// Subject Subject of the principal to explain. Defaults to the caller.
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Groups Comma-separated groups of the principal given by subject.
+	Groups *string `form:"groups,omitempty" json:"groups,omitempty"`
+}
+
 // PostEventsRegisterJSONBody defines parameters for PostEventsRegister.
 type PostEventsRegisterJSONBody struct {
 	CallbackUrl string `json:"callbackUrl"`
@@ -691,6 +883,27 @@ type PostEventsUnregisterJSONBody struct {
 	CallbackUrl string `json:"callbackUrl"`
 }
 
+// GetLandscapeFindingsParams defines parameters for GetLandscapeFindings.
+type GetLandscapeFindingsParams struct {
+	// Status Only return findings whose effective status matches.
+	Status *FindingStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Severity Only return findings whose finding type has at least this severity.
+	Severity *FindingSeverity `form:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// GetLandscapeFindingsSummaryParams defines parameters for GetLandscapeFindingsSummary.
+type GetLandscapeFindingsSummaryParams struct {
+	// Status Only count findings whose effective status matches.
+	Status *FindingStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Severity Only count findings whose finding type has at least this severity.
+	Severity *FindingSeverity `form:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// PostAuthzApiKeysJSONRequestBody defines body for PostAuthzApiKeys for application/json ContentType.
+type PostAuthzApiKeysJSONRequestBody = ApiKeyRequest
+
 // PostEventsPushJSONRequestBody defines body for PostEventsPush for application/json ContentType.
 type PostEventsPushJSONRequestBody = Event
 
@@ -700,9 +913,27 @@ type PostEventsRegisterJSONRequestBody PostEventsRegisterJSONBody
 // PostEventsUnregisterJSONRequestBody defines body for PostEventsUnregister for application/json ContentType.
 type PostEventsUnregisterJSONRequestBody PostEventsUnregisterJSONBody
 
+// PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody defines body for PutLandscapeFilterRulesRuleIdEnabled for application/json ContentType.
+type PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody = FilterRuleEnabled
+
+// PostLandscapeFindingsFindingIdStatusJSONRequestBody defines body for PostLandscapeFindingsFindingIdStatus for application/json ContentType.
+type PostLandscapeFindingsFindingIdStatusJSONRequestBody = FindingStatusUpdate
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /authz/api-keys)
+	GetAuthzApiKeys(w http.ResponseWriter, r *http.Request)
+
+	// (POST /authz/api-keys)
+	PostAuthzApiKeys(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /authz/api-keys/{keyId})
+	DeleteAuthzApiKeysKeyId(w http.ResponseWriter, r *http.Request, keyId string)
+
+	// (GET /authz/explain)
+	GetAuthzExplain(w http.ResponseWriter, r *http.Request, params GetAuthzExplainParams)
+
 	// (POST /events/push)
 	PostEventsPush(w http.ResponseWriter, r *http.Request)
 
@@ -796,6 +1027,9 @@ type ServerInterface interface {
 	// (GET /landscape/filter-rules/{ruleId})
 	GetLandscapeFilterRulesRuleId(w http.ResponseWriter, r *http.Request, ruleId openapi_types.UUID)
 
+	// (PUT /landscape/filter-rules/{ruleId}/enabled)
+	PutLandscapeFilterRulesRuleIdEnabled(w http.ResponseWriter, r *http.Request, ruleId openapi_types.UUID)
+
 	// (GET /landscape/findingTypes)
 	GetLandscapeFindingTypes(w http.ResponseWriter, r *http.Request)
 
@@ -803,11 +1037,17 @@ type ServerInterface interface {
 	GetLandscapeFindingTypesFindingTypeId(w http.ResponseWriter, r *http.Request, findingTypeId openapi_types.UUID)
 
 	// (GET /landscape/findings)
-	GetLandscapeFindings(w http.ResponseWriter, r *http.Request)
+	GetLandscapeFindings(w http.ResponseWriter, r *http.Request, params GetLandscapeFindingsParams)
+
+	// (GET /landscape/findings/summary)
+	GetLandscapeFindingsSummary(w http.ResponseWriter, r *http.Request, params GetLandscapeFindingsSummaryParams)
 
 	// (GET /landscape/findings/{findingId})
 	GetLandscapeFindingsFindingId(w http.ResponseWriter, r *http.Request, findingId openapi_types.UUID)
 
+	// (POST /landscape/findings/{findingId}/status)
+	PostLandscapeFindingsFindingIdStatus(w http.ResponseWriter, r *http.Request, findingId openapi_types.UUID)
+
 	// (GET /landscape/groups)
 	GetLandscapeGroups(w http.ResponseWriter, r *http.Request)
 
@@ -868,6 +1108,9 @@ type ServerInterface interface {
 	// (GET /landscape/products/{productId})
 	GetLandscapeProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
 
+	// (GET /landscape/resources/{resourceId}/health)
+	GetLandscapeResourcesResourceIdHealth(w http.ResponseWriter, r *http.Request, resourceId openapi_types.UUID)
+
 	// (GET /landscape/roleSpecs)
 	GetLandscapeRoleSpecs(w http.ResponseWriter, r *http.Request)
 
@@ -892,6 +1135,9 @@ type ServerInterface interface {
 	// (GET /landscape/systems/{systemId})
 	GetLandscapeSystemsSystemId(w http.ResponseWriter, r *http.Request, systemId openapi_types.UUID)
 
+	// (GET /schemas/{kind})
+	GetSchemasKind(w http.ResponseWriter, r *http.Request, kind string)
+
 	// (GET /test)
 	GetTest(w http.ResponseWriter, r *http.Request)
 }
@@ -905,6 +1151,109 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetAuthzApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthzApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthzApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthzApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthzApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthzApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAuthzApiKeysKeyId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAuthzApiKeysKeyId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", mux.Vars(r)["keyId"], &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAuthzApiKeysKeyId(w, r, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthzExplain operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthzExplain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthzExplainParams
+
+	// ------------- Required query parameter "resourceId" -------------
+
+	if paramValue := r.URL.Query().Get("resourceId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resourceId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "resourceId", r.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "groups" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groups", r.URL.Query(), &params.Groups)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groups", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthzExplain(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostEventsPush operation middleware
 func (siw *ServerInterfaceWrapper) PostEventsPush(w http.ResponseWriter, r *http.Request) {
 
@@ -1493,6 +1842,31 @@ func (siw *ServerInterfaceWrapper) GetLandscapeFilterRulesRuleId(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
+// PutLandscapeFilterRulesRuleIdEnabled operation middleware
+func (siw *ServerInterfaceWrapper) PutLandscapeFilterRulesRuleIdEnabled(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", mux.Vars(r)["ruleId"], &ruleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLandscapeFilterRulesRuleIdEnabled(w, r, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetLandscapeFindingTypes operation middleware
 func (siw *ServerInterfaceWrapper) GetLandscapeFindingTypes(w http.ResponseWriter, r *http.Request) {
 
@@ -1535,8 +1909,64 @@ func (siw *ServerInterfaceWrapper) GetLandscapeFindingTypesFindingTypeId(w http.
 // GetLandscapeFindings operation middleware
 func (siw *ServerInterfaceWrapper) GetLandscapeFindings(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLandscapeFindingsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "severity", r.URL.Query(), &params.Severity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "severity", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLandscapeFindings(w, r)
+		siw.Handler.GetLandscapeFindings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLandscapeFindingsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetLandscapeFindingsSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLandscapeFindingsSummaryParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "severity", r.URL.Query(), &params.Severity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "severity", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLandscapeFindingsSummary(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1571,6 +2001,31 @@ func (siw *ServerInterfaceWrapper) GetLandscapeFindingsFindingId(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
+// PostLandscapeFindingsFindingIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PostLandscapeFindingsFindingIdStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "findingId" -------------
+	var findingId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "findingId", mux.Vars(r)["findingId"], &findingId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "findingId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLandscapeFindingsFindingIdStatus(w, r, findingId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetLandscapeGroups operation middleware
 func (siw *ServerInterfaceWrapper) GetLandscapeGroups(w http.ResponseWriter, r *http.Request) {
 
@@ -1961,6 +2416,31 @@ func (siw *ServerInterfaceWrapper) GetLandscapeProductsProductId(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
+// GetLandscapeResourcesResourceIdHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetLandscapeResourcesResourceIdHealth(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "resourceId" -------------
+	var resourceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "resourceId", mux.Vars(r)["resourceId"], &resourceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLandscapeResourcesResourceIdHealth(w, r, resourceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetLandscapeRoleSpecs operation middleware
 func (siw *ServerInterfaceWrapper) GetLandscapeRoleSpecs(w http.ResponseWriter, r *http.Request) {
 
@@ -2117,6 +2597,31 @@ func (siw *ServerInterfaceWrapper) GetLandscapeSystemsSystemId(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
+// GetSchemasKind operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemasKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "kind" -------------
+	var kind string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", mux.Vars(r)["kind"], &kind, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemasKind(w, r, kind)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetTest operation middleware
 func (siw *ServerInterfaceWrapper) GetTest(w http.ResponseWriter, r *http.Request) {
 
@@ -2244,6 +2749,14 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.HandleFunc(options.BaseURL+"/authz/api-keys", wrapper.GetAuthzApiKeys).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/authz/api-keys", wrapper.PostAuthzApiKeys).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/authz/api-keys/{keyId}", wrapper.DeleteAuthzApiKeysKeyId).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/authz/explain", wrapper.GetAuthzExplain).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/events/push", wrapper.PostEventsPush).Methods("POST")
 
 	r.HandleFunc(options.BaseURL+"/events/query/{sequenceId}", wrapper.GetEventsQuerySequenceId).Methods("GET")
@@ -2306,14 +2819,20 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/landscape/filter-rules/{ruleId}", wrapper.GetLandscapeFilterRulesRuleId).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/landscape/filter-rules/{ruleId}/enabled", wrapper.PutLandscapeFilterRulesRuleIdEnabled).Methods("PUT")
+
 	r.HandleFunc(options.BaseURL+"/landscape/findingTypes", wrapper.GetLandscapeFindingTypes).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/landscape/findingTypes/{findingTypeId}", wrapper.GetLandscapeFindingTypesFindingTypeId).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/landscape/findings", wrapper.GetLandscapeFindings).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/landscape/findings/summary", wrapper.GetLandscapeFindingsSummary).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/landscape/findings/{findingId}", wrapper.GetLandscapeFindingsFindingId).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/landscape/findings/{findingId}/status", wrapper.PostLandscapeFindingsFindingIdStatus).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/landscape/groups", wrapper.GetLandscapeGroups).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/landscape/groups/{groupId}", wrapper.GetLandscapeGroupsGroupId).Methods("GET")
@@ -2354,6 +2873,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/landscape/products/{productId}", wrapper.GetLandscapeProductsProductId).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/landscape/resources/{resourceId}/health", wrapper.GetLandscapeResourcesResourceIdHealth).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/landscape/roleSpecs", wrapper.GetLandscapeRoleSpecs).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/landscape/roleSpecs/{roleSpecId}", wrapper.GetLandscapeRoleSpecsRoleSpecId).Methods("GET")
@@ -2370,11 +2891,160 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/landscape/systems/{systemId}", wrapper.GetLandscapeSystemsSystemId).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/schemas/{kind}", wrapper.GetSchemasKind).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/test", wrapper.GetTest).Methods("GET")
 
 	return r
 }
 
+type GetAuthzApiKeysRequestObject struct {
+}
+
+type GetAuthzApiKeysResponseObject interface {
+	VisitGetAuthzApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAuthzApiKeys200JSONResponse []ApiKey
+
+func (response GetAuthzApiKeys200JSONResponse) VisitGetAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthzApiKeys404JSONResponse ErrorString
+
+func (response GetAuthzApiKeys404JSONResponse) VisitGetAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthzApiKeysRequestObject struct {
+	Body *PostAuthzApiKeysJSONRequestBody
+}
+
+type PostAuthzApiKeysResponseObject interface {
+	VisitPostAuthzApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostAuthzApiKeys201JSONResponse ApiKeyCreated
+
+func (response PostAuthzApiKeys201JSONResponse) VisitPostAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthzApiKeys400JSONResponse ErrorString
+
+func (response PostAuthzApiKeys400JSONResponse) VisitPostAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthzApiKeys403JSONResponse ErrorString
+
+func (response PostAuthzApiKeys403JSONResponse) VisitPostAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthzApiKeys404JSONResponse ErrorString
+
+func (response PostAuthzApiKeys404JSONResponse) VisitPostAuthzApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthzApiKeysKeyIdRequestObject struct {
+	KeyId string `json:"keyId"`
+}
+
+type DeleteAuthzApiKeysKeyIdResponseObject interface {
+	VisitDeleteAuthzApiKeysKeyIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuthzApiKeysKeyId204Response struct {
+}
+
+func (response DeleteAuthzApiKeysKeyId204Response) VisitDeleteAuthzApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAuthzApiKeysKeyId403JSONResponse ErrorString
+
+func (response DeleteAuthzApiKeysKeyId403JSONResponse) VisitDeleteAuthzApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuthzApiKeysKeyId404JSONResponse ErrorString
+
+func (response DeleteAuthzApiKeysKeyId404JSONResponse) VisitDeleteAuthzApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthzExplainRequestObject struct {
+	Params GetAuthzExplainParams
+}
+
+type GetAuthzExplainResponseObject interface {
+	VisitGetAuthzExplainResponse(w http.ResponseWriter) error
+}
+
+type GetAuthzExplain200JSONResponse AuthzDecision
+
+func (response GetAuthzExplain200JSONResponse) VisitGetAuthzExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthzExplain400JSONResponse ErrorString
+
+func (response GetAuthzExplain400JSONResponse) VisitGetAuthzExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthzExplain403JSONResponse ErrorString
+
+func (response GetAuthzExplain403JSONResponse) VisitGetAuthzExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthzExplain404JSONResponse ErrorString
+
+func (response GetAuthzExplain404JSONResponse) VisitGetAuthzExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostEventsPushRequestObject struct {
 	Body *PostEventsPushJSONRequestBody
 }
@@ -2391,6 +3061,15 @@ func (response PostEventsPush200Response) VisitPostEventsPushResponse(w http.Res
 	return nil
 }
 
+type PostEventsPush403JSONResponse ErrorString
+
+func (response PostEventsPush403JSONResponse) VisitPostEventsPushResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetEventsQuerySequenceIdRequestObject struct {
 	SequenceId string `json:"sequenceId"`
 }
@@ -2440,6 +3119,15 @@ func (response PostEventsRegister201Response) VisitPostEventsRegisterResponse(w
 	return nil
 }
 
+type PostEventsRegister403JSONResponse ErrorString
+
+func (response PostEventsRegister403JSONResponse) VisitPostEventsRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetEventsSubscribersRequestObject struct {
 }
 
@@ -3027,6 +3715,51 @@ func (response GetLandscapeFilterRulesRuleId404JSONResponse) VisitGetLandscapeFi
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutLandscapeFilterRulesRuleIdEnabledRequestObject struct {
+	RuleId openapi_types.UUID `json:"ruleId"`
+	Body   *PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody
+}
+
+type PutLandscapeFilterRulesRuleIdEnabledResponseObject interface {
+	VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w http.ResponseWriter) error
+}
+
+type PutLandscapeFilterRulesRuleIdEnabled200JSONResponse FilterRule
+
+func (response PutLandscapeFilterRulesRuleIdEnabled200JSONResponse) VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLandscapeFilterRulesRuleIdEnabled400JSONResponse ErrorString
+
+func (response PutLandscapeFilterRulesRuleIdEnabled400JSONResponse) VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLandscapeFilterRulesRuleIdEnabled403JSONResponse ErrorString
+
+func (response PutLandscapeFilterRulesRuleIdEnabled403JSONResponse) VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutLandscapeFilterRulesRuleIdEnabled404JSONResponse ErrorString
+
+func (response PutLandscapeFilterRulesRuleIdEnabled404JSONResponse) VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetLandscapeFindingTypesRequestObject struct {
 }
 
@@ -3051,60 +3784,123 @@ type GetLandscapeFindingTypesFindingTypeIdResponseObject interface {
 	VisitGetLandscapeFindingTypesFindingTypeIdResponse(w http.ResponseWriter) error
 }
 
-type GetLandscapeFindingTypesFindingTypeId200JSONResponse FindingType
+type GetLandscapeFindingTypesFindingTypeId200JSONResponse FindingType
+
+func (response GetLandscapeFindingTypesFindingTypeId200JSONResponse) VisitGetLandscapeFindingTypesFindingTypeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLandscapeFindingTypesFindingTypeId404JSONResponse ErrorString
+
+func (response GetLandscapeFindingTypesFindingTypeId404JSONResponse) VisitGetLandscapeFindingTypesFindingTypeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLandscapeFindingsRequestObject struct {
+	Params GetLandscapeFindingsParams
+}
+
+type GetLandscapeFindingsResponseObject interface {
+	VisitGetLandscapeFindingsResponse(w http.ResponseWriter) error
+}
+
+type GetLandscapeFindings200JSONResponse []FindingView
+
+func (response GetLandscapeFindings200JSONResponse) VisitGetLandscapeFindingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLandscapeFindingsSummaryRequestObject struct {
+	Params GetLandscapeFindingsSummaryParams
+}
+
+type GetLandscapeFindingsSummaryResponseObject interface {
+	VisitGetLandscapeFindingsSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetLandscapeFindingsSummary200JSONResponse FindingSummary
+
+func (response GetLandscapeFindingsSummary200JSONResponse) VisitGetLandscapeFindingsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLandscapeFindingsFindingIdRequestObject struct {
+	FindingId openapi_types.UUID `json:"findingId"`
+}
+
+type GetLandscapeFindingsFindingIdResponseObject interface {
+	VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error
+}
+
+type GetLandscapeFindingsFindingId200JSONResponse FindingView
 
-func (response GetLandscapeFindingTypesFindingTypeId200JSONResponse) VisitGetLandscapeFindingTypesFindingTypeIdResponse(w http.ResponseWriter) error {
+func (response GetLandscapeFindingsFindingId200JSONResponse) VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLandscapeFindingTypesFindingTypeId404JSONResponse ErrorString
+type GetLandscapeFindingsFindingId404JSONResponse ErrorString
 
-func (response GetLandscapeFindingTypesFindingTypeId404JSONResponse) VisitGetLandscapeFindingTypesFindingTypeIdResponse(w http.ResponseWriter) error {
+func (response GetLandscapeFindingsFindingId404JSONResponse) VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLandscapeFindingsRequestObject struct {
+type PostLandscapeFindingsFindingIdStatusRequestObject struct {
+	FindingId openapi_types.UUID `json:"findingId"`
+	Body      *PostLandscapeFindingsFindingIdStatusJSONRequestBody
 }
 
-type GetLandscapeFindingsResponseObject interface {
-	VisitGetLandscapeFindingsResponse(w http.ResponseWriter) error
+type PostLandscapeFindingsFindingIdStatusResponseObject interface {
+	VisitPostLandscapeFindingsFindingIdStatusResponse(w http.ResponseWriter) error
 }
 
-type GetLandscapeFindings200JSONResponse []FindingView
+type PostLandscapeFindingsFindingIdStatus200JSONResponse FindingView
 
-func (response GetLandscapeFindings200JSONResponse) VisitGetLandscapeFindingsResponse(w http.ResponseWriter) error {
+func (response PostLandscapeFindingsFindingIdStatus200JSONResponse) VisitPostLandscapeFindingsFindingIdStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLandscapeFindingsFindingIdRequestObject struct {
-	FindingId openapi_types.UUID `json:"findingId"`
-}
+type PostLandscapeFindingsFindingIdStatus400JSONResponse ErrorString
 
-type GetLandscapeFindingsFindingIdResponseObject interface {
-	VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error
+func (response PostLandscapeFindingsFindingIdStatus400JSONResponse) VisitPostLandscapeFindingsFindingIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLandscapeFindingsFindingId200JSONResponse FindingView
+type PostLandscapeFindingsFindingIdStatus403JSONResponse ErrorString
 
-func (response GetLandscapeFindingsFindingId200JSONResponse) VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error {
+func (response PostLandscapeFindingsFindingIdStatus403JSONResponse) VisitPostLandscapeFindingsFindingIdStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLandscapeFindingsFindingId404JSONResponse ErrorString
+type PostLandscapeFindingsFindingIdStatus404JSONResponse ErrorString
 
-func (response GetLandscapeFindingsFindingId404JSONResponse) VisitGetLandscapeFindingsFindingIdResponse(w http.ResponseWriter) error {
+func (response PostLandscapeFindingsFindingIdStatus404JSONResponse) VisitPostLandscapeFindingsFindingIdStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
@@ -3531,6 +4327,32 @@ func (response GetLandscapeProductsProductId404JSONResponse) VisitGetLandscapePr
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetLandscapeResourcesResourceIdHealthRequestObject struct {
+	ResourceId openapi_types.UUID `json:"resourceId"`
+}
+
+type GetLandscapeResourcesResourceIdHealthResponseObject interface {
+	VisitGetLandscapeResourcesResourceIdHealthResponse(w http.ResponseWriter) error
+}
+
+type GetLandscapeResourcesResourceIdHealth200JSONResponse ResourceHealth
+
+func (response GetLandscapeResourcesResourceIdHealth200JSONResponse) VisitGetLandscapeResourcesResourceIdHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLandscapeResourcesResourceIdHealth404JSONResponse ErrorString
+
+func (response GetLandscapeResourcesResourceIdHealth404JSONResponse) VisitGetLandscapeResourcesResourceIdHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetLandscapeRoleSpecsRequestObject struct {
 }
 
@@ -3699,6 +4521,32 @@ func (response GetLandscapeSystemsSystemId404JSONResponse) VisitGetLandscapeSyst
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSchemasKindRequestObject struct {
+	Kind string `json:"kind"`
+}
+
+type GetSchemasKindResponseObject interface {
+	VisitGetSchemasKindResponse(w http.ResponseWriter) error
+}
+
+type GetSchemasKind200JSONResponse map[string]interface{}
+
+func (response GetSchemasKind200JSONResponse) VisitGetSchemasKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemasKind404JSONResponse ErrorString
+
+func (response GetSchemasKind404JSONResponse) VisitGetSchemasKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTestRequestObject struct {
 }
 
@@ -3717,6 +4565,18 @@ func (response GetTest200Response) VisitGetTestResponse(w http.ResponseWriter) e
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /authz/api-keys)
+	GetAuthzApiKeys(ctx context.Context, request GetAuthzApiKeysRequestObject) (GetAuthzApiKeysResponseObject, error)
+
+	// (POST /authz/api-keys)
+	PostAuthzApiKeys(ctx context.Context, request PostAuthzApiKeysRequestObject) (PostAuthzApiKeysResponseObject, error)
+
+	// (DELETE /authz/api-keys/{keyId})
+	DeleteAuthzApiKeysKeyId(ctx context.Context, request DeleteAuthzApiKeysKeyIdRequestObject) (DeleteAuthzApiKeysKeyIdResponseObject, error)
+
+	// (GET /authz/explain)
+	GetAuthzExplain(ctx context.Context, request GetAuthzExplainRequestObject) (GetAuthzExplainResponseObject, error)
+
 	// (POST /events/push)
 	PostEventsPush(ctx context.Context, request PostEventsPushRequestObject) (PostEventsPushResponseObject, error)
 
@@ -3810,6 +4670,9 @@ type StrictServerInterface interface {
 	// (GET /landscape/filter-rules/{ruleId})
 	GetLandscapeFilterRulesRuleId(ctx context.Context, request GetLandscapeFilterRulesRuleIdRequestObject) (GetLandscapeFilterRulesRuleIdResponseObject, error)
 
+	// (PUT /landscape/filter-rules/{ruleId}/enabled)
+	PutLandscapeFilterRulesRuleIdEnabled(ctx context.Context, request PutLandscapeFilterRulesRuleIdEnabledRequestObject) (PutLandscapeFilterRulesRuleIdEnabledResponseObject, error)
+
 	// (GET /landscape/findingTypes)
 	GetLandscapeFindingTypes(ctx context.Context, request GetLandscapeFindingTypesRequestObject) (GetLandscapeFindingTypesResponseObject, error)
 
@@ -3819,9 +4682,15 @@ type StrictServerInterface interface {
 	// (GET /landscape/findings)
 	GetLandscapeFindings(ctx context.Context, request GetLandscapeFindingsRequestObject) (GetLandscapeFindingsResponseObject, error)
 
+	// (GET /landscape/findings/summary)
+	GetLandscapeFindingsSummary(ctx context.Context, request GetLandscapeFindingsSummaryRequestObject) (GetLandscapeFindingsSummaryResponseObject, error)
+
 	// (GET /landscape/findings/{findingId})
 	GetLandscapeFindingsFindingId(ctx context.Context, request GetLandscapeFindingsFindingIdRequestObject) (GetLandscapeFindingsFindingIdResponseObject, error)
 
+	// (POST /landscape/findings/{findingId}/status)
+	PostLandscapeFindingsFindingIdStatus(ctx context.Context, request PostLandscapeFindingsFindingIdStatusRequestObject) (PostLandscapeFindingsFindingIdStatusResponseObject, error)
+
 	// (GET /landscape/groups)
 	GetLandscapeGroups(ctx context.Context, request GetLandscapeGroupsRequestObject) (GetLandscapeGroupsResponseObject, error)
 
@@ -3882,6 +4751,9 @@ type StrictServerInterface interface {
 	// (GET /landscape/products/{productId})
 	GetLandscapeProductsProductId(ctx context.Context, request GetLandscapeProductsProductIdRequestObject) (GetLandscapeProductsProductIdResponseObject, error)
 
+	// (GET /landscape/resources/{resourceId}/health)
+	GetLandscapeResourcesResourceIdHealth(ctx context.Context, request GetLandscapeResourcesResourceIdHealthRequestObject) (GetLandscapeResourcesResourceIdHealthResponseObject, error)
+
 	// (GET /landscape/roleSpecs)
 	GetLandscapeRoleSpecs(ctx context.Context, request GetLandscapeRoleSpecsRequestObject) (GetLandscapeRoleSpecsResponseObject, error)
 
@@ -3906,6 +4778,9 @@ type StrictServerInterface interface {
 	// (GET /landscape/systems/{systemId})
 	GetLandscapeSystemsSystemId(ctx context.Context, request GetLandscapeSystemsSystemIdRequestObject) (GetLandscapeSystemsSystemIdResponseObject, error)
 
+	// (GET /schemas/{kind})
+	GetSchemasKind(ctx context.Context, request GetSchemasKindRequestObject) (GetSchemasKindResponseObject, error)
+
 	// (GET /test)
 	GetTest(ctx context.Context, request GetTestRequestObject) (GetTestResponseObject, error)
 }
@@ -3939,6 +4814,113 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// GetAuthzApiKeys operation middleware
+func (sh *strictHandler) GetAuthzApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetAuthzApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthzApiKeys(ctx, request.(GetAuthzApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthzApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthzApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetAuthzApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthzApiKeys operation middleware
+func (sh *strictHandler) PostAuthzApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthzApiKeysRequestObject
+
+	var body PostAuthzApiKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthzApiKeys(ctx, request.(PostAuthzApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthzApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthzApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostAuthzApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAuthzApiKeysKeyId operation middleware
+func (sh *strictHandler) DeleteAuthzApiKeysKeyId(w http.ResponseWriter, r *http.Request, keyId string) {
+	var request DeleteAuthzApiKeysKeyIdRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuthzApiKeysKeyId(ctx, request.(DeleteAuthzApiKeysKeyIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuthzApiKeysKeyId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAuthzApiKeysKeyIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAuthzApiKeysKeyIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthzExplain operation middleware
+func (sh *strictHandler) GetAuthzExplain(w http.ResponseWriter, r *http.Request, params GetAuthzExplainParams) {
+	var request GetAuthzExplainRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthzExplain(ctx, request.(GetAuthzExplainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthzExplain")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthzExplainResponseObject); ok {
+		if err := validResponse.VisitGetAuthzExplainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostEventsPush operation middleware
 func (sh *strictHandler) PostEventsPush(w http.ResponseWriter, r *http.Request) {
 	var request PostEventsPushRequestObject
@@ -4732,6 +5714,39 @@ func (sh *strictHandler) GetLandscapeFilterRulesRuleId(w http.ResponseWriter, r
 	}
 }
 
+// PutLandscapeFilterRulesRuleIdEnabled operation middleware
+func (sh *strictHandler) PutLandscapeFilterRulesRuleIdEnabled(w http.ResponseWriter, r *http.Request, ruleId openapi_types.UUID) {
+	var request PutLandscapeFilterRulesRuleIdEnabledRequestObject
+
+	request.RuleId = ruleId
+
+	var body PutLandscapeFilterRulesRuleIdEnabledJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutLandscapeFilterRulesRuleIdEnabled(ctx, request.(PutLandscapeFilterRulesRuleIdEnabledRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutLandscapeFilterRulesRuleIdEnabled")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutLandscapeFilterRulesRuleIdEnabledResponseObject); ok {
+		if err := validResponse.VisitPutLandscapeFilterRulesRuleIdEnabledResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLandscapeFindingTypes operation middleware
 func (sh *strictHandler) GetLandscapeFindingTypes(w http.ResponseWriter, r *http.Request) {
 	var request GetLandscapeFindingTypesRequestObject
@@ -4783,9 +5798,11 @@ func (sh *strictHandler) GetLandscapeFindingTypesFindingTypeId(w http.ResponseWr
 }
 
 // GetLandscapeFindings operation middleware
-func (sh *strictHandler) GetLandscapeFindings(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) GetLandscapeFindings(w http.ResponseWriter, r *http.Request, params GetLandscapeFindingsParams) {
 	var request GetLandscapeFindingsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetLandscapeFindings(ctx, request.(GetLandscapeFindingsRequestObject))
 	}
@@ -4806,6 +5823,32 @@ func (sh *strictHandler) GetLandscapeFindings(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// GetLandscapeFindingsSummary operation middleware
+func (sh *strictHandler) GetLandscapeFindingsSummary(w http.ResponseWriter, r *http.Request, params GetLandscapeFindingsSummaryParams) {
+	var request GetLandscapeFindingsSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLandscapeFindingsSummary(ctx, request.(GetLandscapeFindingsSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLandscapeFindingsSummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLandscapeFindingsSummaryResponseObject); ok {
+		if err := validResponse.VisitGetLandscapeFindingsSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLandscapeFindingsFindingId operation middleware
 func (sh *strictHandler) GetLandscapeFindingsFindingId(w http.ResponseWriter, r *http.Request, findingId openapi_types.UUID) {
 	var request GetLandscapeFindingsFindingIdRequestObject
@@ -4832,6 +5875,39 @@ func (sh *strictHandler) GetLandscapeFindingsFindingId(w http.ResponseWriter, r
 	}
 }
 
+// PostLandscapeFindingsFindingIdStatus operation middleware
+func (sh *strictHandler) PostLandscapeFindingsFindingIdStatus(w http.ResponseWriter, r *http.Request, findingId openapi_types.UUID) {
+	var request PostLandscapeFindingsFindingIdStatusRequestObject
+
+	request.FindingId = findingId
+
+	var body PostLandscapeFindingsFindingIdStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLandscapeFindingsFindingIdStatus(ctx, request.(PostLandscapeFindingsFindingIdStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLandscapeFindingsFindingIdStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLandscapeFindingsFindingIdStatusResponseObject); ok {
+		if err := validResponse.VisitPostLandscapeFindingsFindingIdStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLandscapeGroups operation middleware
 func (sh *strictHandler) GetLandscapeGroups(w http.ResponseWriter, r *http.Request) {
 	var request GetLandscapeGroupsRequestObject
@@ -5332,6 +6408,32 @@ func (sh *strictHandler) GetLandscapeProductsProductId(w http.ResponseWriter, r
 	}
 }
 
+// GetLandscapeResourcesResourceIdHealth operation middleware
+func (sh *strictHandler) GetLandscapeResourcesResourceIdHealth(w http.ResponseWriter, r *http.Request, resourceId openapi_types.UUID) {
+	var request GetLandscapeResourcesResourceIdHealthRequestObject
+
+	request.ResourceId = resourceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLandscapeResourcesResourceIdHealth(ctx, request.(GetLandscapeResourcesResourceIdHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLandscapeResourcesResourceIdHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLandscapeResourcesResourceIdHealthResponseObject); ok {
+		if err := validResponse.VisitGetLandscapeResourcesResourceIdHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLandscapeRoleSpecs operation middleware
 func (sh *strictHandler) GetLandscapeRoleSpecs(w http.ResponseWriter, r *http.Request) {
 	var request GetLandscapeRoleSpecsRequestObject
@@ -5532,6 +6634,32 @@ func (sh *strictHandler) GetLandscapeSystemsSystemId(w http.ResponseWriter, r *h
 	}
 }
 
+// GetSchemasKind operation middleware
+func (sh *strictHandler) GetSchemasKind(w http.ResponseWriter, r *http.Request, kind string) {
+	var request GetSchemasKindRequestObject
+
+	request.Kind = kind
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSchemasKind(ctx, request.(GetSchemasKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSchemasKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSchemasKindResponseObject); ok {
+		if err := validResponse.VisitGetSchemasKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetTest operation middleware
 func (sh *strictHandler) GetTest(w http.ResponseWriter, r *http.Request) {
 	var request GetTestRequestObject
@@ -5559,131 +6687,177 @@ func (sh *strictHandler) GetTest(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x935LbNrL3q6D4bdXGtbI09iRO4ruJY2fnO4k964n3VJ21jxciIQk7FMEA4Iy1U67a",
-	"h9gn3Cc5hf8gCYkgxZmRXLlKPCKARqN/jUaj0X2bpGRdkgIVnCXPbxOWrtAayv89uzgX/ykpKRHlGMk/",
-	"wqIgHHJMCvnPDLGU4lL8O3menAGGOCALcIU2j69hXiFQQkwZWBAKGCcUF0sAswyL72EO1ojDDHII4JxU",
-	"HPAVAmcX5wAXjMMiRdNkkmCO1nKkP1C0SJ4n/2/m6J1pYmdnlqjk8yThmxIlzxNIKdyIf8MSn2cBYgvw",
-	"7t35j4CvIAdVgX+rUL4BOEMFxwuMmCFnCs45YCtS5RmYI7BEBaKQowzAIgOQMbwsUAZuVqhwE2Agpch+",
-	"tK4YBxStIS5ASuTkxGQpqZYrb95/ZCDHC5Ru0hxNwa8rBBYY5ZnojZSaY7gAjKyR6IajT5xNAKvSFYBM",
-	"ESBHlUwGBbqRtNysEEVyiPMfwRpu6nOYb+RPbMM4WgPMGcoXgu0LQteQJ8+TqsJZYnnKuFhCwdQaL9ty",
-	"MKcYLYD3VyEVbp5lRUvCkOTOoipSNTvMN9PgWJiVOdy8hmvUHkvwaVWtYfGYIpjBeY5AAdfIGy/Yp5px",
-	"uDspFrq95owUkpKSa5xJycDM9NzJKfWH0DjiF49O8BWaLqcT8KZExdnF+QQs3168mICfKCxXf/n50RSc",
-	"q09lM8xAVVwV5KaYAOzLp0AgJ+B98k79/D6pN8wIYqAgHCwwB7DYgJKiDC2wEOIUcrQkFCO2rdM3fIWo",
-	"7LJQkpdCJr72QJ0hDnHOGpiWY7sONSszIdDiE19SpNQL1qKiWifP/2ZmkkwSzZpkkmiuJJNEcEn8JChL",
-	"PnyeJNeIMi2Wu5TGX/Vnnz9PEop+qzBFmRjNlza9eh/sspL5P1DKxbJ6Oqe1uG9RSRETowHY0IWgYiiT",
-	"+tBo0mKphYxJOBAxDyCUkFC5hj8v1y9/lqqEZCgXvKlr5Su0CYvYFdoYCXOaW2k0RK8RE5pD/GjVHpW0",
-	"1b8X1NAwNOXEwkOrOUPGSIqlrrnBfNXs+QptJDUWWp4g4UJhS2oP6jYLinLZHyeyN9FFm7TGogoGGWqD",
-	"q1nic73rfCl7Xrduk7gUik3qM0MBoAjm+J+IiT1I7GSwEIjN0YIDtC75BmDXnCJGKpoisIJKq8yR2YZQ",
-	"BjaIT8C84uAG5zngFC+XSAi+Eigj2wtcZIJJOWY8SqNCt1j7bOyO5/12eMuoAdu8aTv+Xm+puqsNf79N",
-	"uCbgW3ZjH4FRu7Kds1xqwSeIC7c9ewu8W5Iva8M/hFA31FVdwuvMD6ovyvECprxjK5rjAtINgJQj8bUy",
-	"OAAjC34DqdBb6RVcogmANF3hazQxDdAnlFZcLOsjwClMr9zGncMiYyksUXtTumu1CfWkx1KZuruQSunW",
-	"Jz4x+xrPAbPZ774fNM92AXNntyvIVu3+/gzZyjTX4qHk0Eq+nD2AubAk+Wr9fIU+aUl7n1z++ezpN8+e",
-	"f4++n0+n0/fJI2nnfYLrMheDez9/+8032bPT+ekzmC5OT9InT+H38HSRnn77/dOvT7998nX6JH2WnWbZ",
-	"t0+/e/Ykg89Ov12cnj5D8/npN3MhipBzRAXB//u3s8f/Ax//8+Tx9x/+9PxvJ4+/h48XH/70h24QOoGI",
-	"R+B2HVZDIitRihc4dSrsq5SUm1lOUimgjwSLYQHOvAV6GHSNbplsVVW+gqdogSgqUpTVWNBtGjSWYT8w",
-	"1+Z+h6jevTnuD+9d/W8T+j7bzw9qs2tTJ36Q4l4pFfHVkpKqFNa84jjfPBJmPASU5N07yAjSN1eUKrHo",
-	"u6JdK9P6XcwqaiDNn665XarP3qJFa93cxCaNE6wkwg0RWr8XsIRznGO+CQlYan8F1NdhHKWrAqcwB/MK",
-	"59LameckvQJkIcArbU0oTnZZlXKx5spqu39V5iYwkg5zHQ4+f9RpulPruz5U+wiv/CAstGNpJcwESD0x",
-	"ME2i2ekETHtdpAg3GdsQ6RqXu5VQcIyAONmtRWme9rTaApo2uw4te/OM4m1h4TE6F31PR1aI6m2MS4PY",
-	"90yWFbkBa3H8FHaJnFCqFII+Kkn/HvqEGZc+K2hOrRPrVPynOCYzIChEjKNsYp2AE6EcxIm5WqMsoB7W",
-	"pCoC9sJrUjwu0BJyfI1AhlK8hjn4rYJyX/GFP0CptFXFkVBRKc/vmtAwSnbqqJefOCoylDnlY5xodnjr",
-	"QhtJ/aRW+XSKkZlXDEhFty/M96Kt4o+GUlRr12KPa4I230ZXiR3dG3n5dVOiHtN/22gWAmUaUmntEWvc",
-	"95ZxYhCxC8wvvEWvs+ZFDhkTZw9oGG6x7djSBrLvkLcYVliVIFbkSgh7hDl+BuRj+4ajVPPFCjIETnwK",
-	"GnpZ/RCFg+Y62Ka7uOivZpvcyxUUZs41SeG8ysVJWPr1wRpBVlEpdJajOV5jrlzgUBgF/D6s3TQwjXsy",
-	"fcUU2xz7ES1glXPNIbRGhbSQuGKclGqmMIqZUtTKcZASitgE/IR/eNR9hNky6ybcJIWxix8jrlu2Gk88",
-	"rLZpmxeDlilu4sE5GlG6/9sOK8Vj7YamwXBb3FLU7yLAthtyE2Abj38V4Oi6o7sAreiD8pFjJgXk7OJc",
-	"sp55V0yOMNNDTQYir9Ld0g+2Ljze318oQk3wWz2bG9DhPDU97MfTIYER9ZuX2jzv+pBT1+ma+J0a7+Hu",
-	"eT19Ma5PNfW1+e5lczTsuvmNUwINhu6vf3+/kb0LLWzp2K467Ccj6eUxtORup3isuuwz+TEV6Kh32WGe",
-	"9D3wtOHaNImdHmlNwxM3j/lhRSvB0XEBZhwvwfgmcOZ+Fx+v8HL1OEfXKHfeJBsXlBGJb8k2eb3AdsVT",
-	"zSFDGSAFYOrwlq4ghSlHFDOOUyY61BYBewBHtTvojrIreKfjQWpZUdPXKLYL198klk3vwiBWNB1YLKyb",
-	"r2+EspSU6A6MTytabV0KaZTtoL5znl28ALAsc5yK0XSMTXup5OorXpM15oLTuEaTDrPR3UtxQ4zDeY7Z",
-	"Cin4IypDUlKYq+g/gcAVLsEc8RsZm6PFYM+A3LqhpIiTZ3iJExUlq7HRVBuLmj95iHo2YJ3ERqBqRRv2",
-	"TdWUrQk0jlW6KlB4x3Q9d7nVxIvaKjyI4pSEj6s9nS9muAZVZA1ToybYe6Aule3vTqFK6g5Tq5qZ35tq",
-	"tcIXBfSgUzKE8peUEnqp+go5Wl9eB3V33dhawWKJWiF7CvgSZkj0AiiS2lxy06hWGSdOA5i+wkUAFz9i",
-	"8a81LiAnLpbcdIwy6xydgvOCI1pSpEPB15CnQt9re846UQWrmfYDvyYZmoAXZgNScZsT4EVvT8AbunxX",
-	"YP5oCi6ruSBmjigDVbGGlK1gDv5uev67Mwal7paqZ/q+CMmHmPiWYP/mSw7JyvDzhRcSxMkkeVdm6n9+",
-	"RDniSD5bMGRJfWm13YXHc04r1MBF8v8v37w2AXiC20pPzCo5gKKFTcHlSiw3zPGyYC4OPyWUIlYSHalq",
-	"xeKnl78qg1m6sktSMKRXEnIgll1DnhTmyrfGNSe7ZkohBXqJFMGZ5ICmVCmbgrjVn5NsIxQVi/MnNcDm",
-	"lm2i5DWEsFc454i+rfIA9H8kabXWKFrI7wBFS8y4DHCR92MSRIxee86LV/rLKhfyjJQQyms2jUQ9W60s",
-	"S8iEHGoNLjRvKcDL9M04+lRCebusVanCqqYmXUEciFgYojxv1NkY2YlWuXouNLrG9AYI371W+fBNt9F5",
-	"P4HRI3er5Vcq3qqH8aUjwtlW68uEjLesL9ODXJBrTJQBLHc0+24mYBRJDojtfgMgNT1aKSIUL7HY+TXQ",
-	"ZFyHJmEC1Es0CGSXKclzlAp9Lq83hSmVY+mrEAKfQZpNwTumTACPL6rLV2ZWBKCCVRTJR2eLispzeUlJ",
-	"ihgTH4jtQi6d+IcwTYp8o84X6q2P5Z9cfTEjmPIK5vkGVEWGKOOE2Nmpnu7fEDVrmOaQsZEs0aGGUI2W",
-	"e7GEWrNv9b5w4rEHwP1R+pnVtbZD7OomU0c2rOv03Yll/Xm3NvsrRjfhsDlG8muU1dWUC6YTGBFCYZ6F",
-	"SbOBjbMzHY0099ts6s2j95wtK1QAVFCcrrwlCq+JMgDtesp1FFy0NpddVPZgCvRuVOddiMcuwYiMtVnU",
-	"bYld020CVUqUXq3Qerx7e14PeOXKSWdJd8RRvCsMLj4kyUSfGPp2xvgutoat+0zxyfAnHALJT5RUZYdJ",
-	"pl8hLMwrhPiH1nct/pKyB7YbJA0NDdtwAI6ua+28W73KXwbbCqrffjaCko4BtoFh3Mg2gaLnrmwBH42G",
-	"191b0bl+vrMbaIV95rPt0ON9kcLCvS8BEJSIMlKIQwhD9Bqnwt5PSVVweSgWhwh1tWdWXMqU7kvdoarn",
-	"vs0MBDAVJw5A8XLFlSe7RHSNmXzRID95+KethikPrAkMGfesDPzZtzo2Pw5WCbb3njEmVpIHxJg4Po4d",
-	"Y2Koug/14LE+QkNob9jPmPFo28FvdM7ROiTMrW9acVxdscm4FrDUaZ7VDKzd9lLobPULokvU7V1ci8+U",
-	"iyfCw/iL/TrGwSj7Fp1xohy2QoCsSTWy99CbyJ04D13/4/sO633fkevwNcnQZbVeQ7rpPsoVJEOAqa/r",
-	"5zkZ5bTtUCeb1U/o97+LCSLuZwdriEtgKzO0jCqKWzsVP0RqF/FpzMnvtf5uj2OfIbdTh/lirafSfqxk",
-	"hvemsE3ae3jJpeBu85A7qe4OTtBvHwWydbfaczxHOVH+aPvK5GGgMWa0wlALz9JxzyZebf5bMTFYj7vu",
-	"+xl5TsAGWHk+L0c28xxd92HneeyP282i/MaBLSneaXxPohZhC7pL+t1hAG1vai2n4eu2zoxZDTt+3MJE",
-	"2he1ZVD5G3+3LH63LA7cstCBPh2GBaFLWOggRZir16Zh+8LPESezdHLiOaQyVELK5WltAjiCa9bwQzUG",
-	"YpxWKa+owEmOruV+QR7arxRgxgMbIAGK7tkU2cKTdgiYErfBNklonH7WSVCU+9spYZaPbLGEaL0P28Wt",
-	"UvcOeQEpXCOOaEhiS/OjPWtYd7ZMkyuXyD41yjBLKeJoAha4wFxnv7XvjZDXndAwHF49APotDWNhfg/U",
-	"1WgJvUhQvw5GW63/7keocrXCkzBL65YUsy3LaXna8UisIbP+bCOk1t5WhHY++YDTv9JwD8tyXFypXQ36",
-	"v7MSpcOuPe7/gr4tKHYekUaRmO3u1IOOv5eCM2q5Hbt6vJFtLrNPa9PqkXTtXu7LIOlv6opWKitsdtja",
-	"Qn8lV3oGNE3ClHl0XGssODAk0KbVQwTKVNq64M6gM9qVlKQV9eJyVTw7Zug///q3Taprku0yaQdCZVcu",
-	"kTQZF5Ss1aYnNGOOljAXNmUBeUVhri8zJ+8LswfnG8B1NCQsmlvsNSoyovYl/aYeZW5XBze4yMiNjtS+",
-	"531HMeyBLU2PilHtx1396t+G72Ja1EKqOSKzghCI7pduIVMNpsqWY0asxGJXMhjXvOoQatFNPTbPQ0DC",
-	"fm7KqBwglzaX0GE7ACQDf1u0RAmZBjgmhU0t0bVN28WMVh9e7yFgGR2hJybnLHPhVVxrb7P+RiyksnGg",
-	"ziBH2uEtjH3MKxk7YTgWBLv5sU3RubVuG4wP+c1rNJsF2e/lPLyGOBf4ekVJIAPJf6tzUHPsOUrJWnDB",
-	"tJbiU7E6SASnHnPsXlTWn5qVFMnnSTtG1u/fKXMntiUuwBovqT743MCNUekNIuNJ4WKrKraTcrbgRuJF",
-	"F9rV6LFDngBV0npXa0ScyG4IvcoJzFgsMaHLbBPcp/Nh1UWrr+PIOUn7RCLG5d4MOl+j3bu/RtXs6XDx",
-	"mkQI9Ze6yjGbeBdjk0S9Y7P/4yVdUMVu/Ookfu6uUFYbLzbLuchMWKRL1TxJ3pIcSZOyZWOq32p/T5xF",
-	"ZOOQk/orGK8KQSAdeu2ZlR8UUcs+7J/JvcSkWxLyfWhdwTsRaazlhx2y3Hlz0V7ne7m42CJegYio3wHx",
-	"xQGil9zjOHknOdrhMqBEWmB8pTwCdgknYElhwWtOBTZRby5cENHEWCHhTJ13kNtyW5oZX+xfNBJ/iG1Z",
-	"TFM9K2ND3qHvcXytT723eTQ8FD+YbVtlhh/NbWLQ43F5uKtEUxZ0kril3ybkgxwlmmIl9MKca7jI2HG5",
-	"SvaVNc3GId4Wr233QckradB+Iq6rRsj10QUOwH/+9W+AsDz92YcctBZq/tW60u9C0ac0rxi+RgE/l/eu",
-	"oJM79ZjjAe/rLrckVazXhDGJwMLBT+bnRkIsP+JdM5uZR7roE5ijFbzGhKq0OkUKS1bl8tR4DSkmlSyU",
-	"pY6Q9jjqxDYg83PGabB8y3mRyRQP8j5Iro9/ncOAaQm+4rRCj3TOd+Va/2oBc4YeTcGZ+cim8KIIlBSv",
-	"IcX5JnAtiz5xROV9q27gV+pUczNpqVU9Cjumpsw8i97mAddrOSckR7DoTAU/grvMlcl4QG+ZImLf/Kj7",
-	"pppiOsHHvpmm9Frvn2hKS1mU4aAz6A31/Wkx6HdFfGnh1vdW+NIs+MgXwZr1d5QkaNTssVa3fdiqwh8u",
-	"eWwjTeS4Wa56pENrZ6dsUtZRbNHY5A9SOnSf83hgBUYpK71HDt56ks49Vc3v2XcHR5201mESn5h66wWB",
-	"/qFWB1nWSchznfEp+tXybsf6r3gt9sF1CbCy4QQXb6y3XevYtqN9Cl6pWo+YgacnJ88enzx5fPJ0Cn5R",
-	"TDYYrXhF0Wi++EhiVS5GhjP5jMp1OhX6p+7Hh9cEZ6CSSWhqfUDnaxck95nuFPwipF8oQKg6gQWoLcN4",
-	"VwKDOOI69TliLw8qhobxoj3r+oLGT/t6Gy4uTWSlvegGqpUpoP8+eTI9mZ68Tx4pVSImQxaAoTUsOE5N",
-	"K51niAqxXsv6Td3Z+wxRbSB/lg8LFySggi/OxZnltwrRTSOhEtOV4AFaI7oUBLkggsYxBHNZIfWl+fCl",
-	"+/Bnm7lNeUct55KT6ZPpic5fV8ia4Mnp9GR6qgqkrqRymKm3grOyUuVeS8IC9sBFxVZas6r8Y6pso3up",
-	"aK7HZCooddYqQFUyThFc6yyColFBOF5sAPNy85GFzkSoHi/Krc9mbhObWnJBGJeJDpmgI5mYCkQ/kGxj",
-	"CwGpc4U+J4ims38wJT/KFuqylFQmxc/1JRdnVeV7Uy5+0cnTk5OAe+m/1NEfLpkQFMVU5ao1HJYSMLtl",
-	"gna5TXyWjggUDGHmFKNrZFgCGFa5wRFY4muZllF1As5/bLPrJ6S59Rcx4qUdL/Ei6wSVtwkWgwlRSCZJ",
-	"IY2ihPmf1xkx8TjZxMmHWCZNktOT79o/vSJVkWnb0ZucfEMqVZJ8uCoNC8GFiooTGq+oObtrW5RDXglF",
-	"l0lzRIbSC2PTvnolBQLvC62rzTjT99I4/vrk6/GEyUvbKUWqWbuOAznjP3ZKjcHYdmy+1V/U8GnxuANM",
-	"puEegGpWMsrzOUyv3tE86rF0vXqRaxtWr12ofBIodqbs0U4me+qoG5PSR6QDfz0NWOc72wFLLzFpEoZN",
-	"NP8DLt7w9XkjtKUlkRH6qyq6ZfGd/UZuawZ28TLpOvhCpHKrGrxHdfPu7c8yQbeejNSnC6F+tq+5tT5m",
-	"sMSPzWkqAh3ieOJX3mDB6LUWMqwR493t7o2O2FwTEYCw1ItN9MlH+7xnN7tmt9BNJ2rDV8/vVACWKxNf",
-	"K2Uy34iDqjzXx7PyzKcjyhCAjRbbbYGuw/KHO1xGPxRgyyo+zM4+WHriMTYAWscKKY2k/RDUBzgSMPFA",
-	"OWCAXJx/EcBoBNBEosQ0G74dtcY9UAB99zGDHH5MIYc5WXbzb3bb/NMe6GqxuQfWmoSdtciKQ2Go2YFC",
-	"shkMdiT4jBSxntAcishjR6KPwBGQ1xtwDmg9AXb4wPpCAKVjniLxNG8UGIiE0w9mkENE0zaOzG7nJnPw",
-	"YOSYiLJo4BhO/eAlLe6Gzdz7+jBRY0KgjwE0TYHwE33FwaSWGqyearEHal744x4Dcvxpz27tvzZRAIKO",
-	"a5t4vPg8euENGAWbtN7gMJHjPV05VvCkPaGTChFAhfgrG4CZ9HARM0nKbz+aKe7glUJPGoud8OZTY2VP",
-	"TKUWUWk/PKWHj6b0WLAUJS21dzt9QWYD5MQaDMRag4CjhV19Hg6B/p/HQGON571RWafyRZDGXmBtNT1s",
-	"4NZeqX0BIDbj9b3+atdr7w3f5jPGo/TbBxg4uw0Uoh+O23YR/3jMtlj8IlgiPwKtwXYHCtXW+9gv4G7A",
-	"DdgTn4NhedxwrKFwDPT1B50Htr4gOwJwfRmgsm/0o2HlCmH3R5Y32oFi6ySSWwJdXpHvPfDlVVnvATFH",
-	"yYtGsfEYmNVbHCrQXP6II4FahPD0g9lAhB0zuhyyRkBVX0AZMPUD0uGD6EsAkCr3/FjWQ4oD0cIv0d68",
-	"BcAsUHNpp4y4XC7HcRvgM2x2q0oXRd0D+LXhoxHkceetqZLUjSBbUOkw4eOl7zlG379XXzUSMsqs8yvZ",
-	"XxXkpgimtd8lC964YwW/R5bMHRAHX1NB33ykmF3tZOXstlbVOQpTmo0t9vbAlyPgVaOodDfOmmWoDxVu",
-	"3jIex461W1yiN6pBUR2vRorq6AOxLRWfR4SYg9ceBuCib9yH4eWrXnEfi4OP+6gt21FDSiZzigSU+rYn",
-	"nH5SAxyDaafmN7vVCa4Gw0Tl1ooGieLQT7aCdDdAXLXpw4SHyhB5jLadK2sfB4l2GfxIWJy7gY4BGm6e",
-	"s1uX020wQmzGuWiQOH6d+7WUu7FSK718mHCxWVaPETGy8G8f/8HaK8C8v/vAZn89Dhx53OrlPPBKQ0dD",
-	"xrHmy3EduGS/x4gVU5QvEim2YGTfzeW1HecYMGG5Mrt1FTsHby2uymY0UCy7XvsFQ7ux0qgveoh4sZnB",
-	"jxUuPaDSRomsQEau5f7CCFhA2i0I93Pub1ay3+fsH2SbwtK+OOoFIQmfHtA5cNgczeG+uf66kGMkcghd",
-	"ysJFffeYN2aUY9hiDEtmt7bK5WBgGIbFg8Ow6o1XYbMbIn49zsNEiSkJcYwg8fkfAxP3/fCXTxduzGMA",
-	"jZvy7NYrtBl1XnFVPqNR4rhzUavq2Y2UehXQw8SKq85zlGipVXWJhUy9DIRQnirLc1/YNAY/CuzUaZ7d",
-	"NgtpDt5+tnG1B9DqtF20S3xGQK7d6EBxV69HdNzgiwQebdcyHo65Y8NbDWvj4GwAsjxU9UbUUaDpOJGk",
-	"6o5FwsgUSMk3rmCx6QFwCtOr/hagIeAoMKWJnd3a+q3D0aTLscZDSQ9+4ZWOjQCR9/WBIkiXvjtG+Jjy",
-	"VLHbEMnRfpbfWzvgMeDFsmd26wp5DUZMm3vx4LF8e+sXFIu4/PE/P0z82Cp1xwqgvjacbDMAN8eDGY2X",
-	"fbHSCx0SGT1QceCIOEo0qMoufV+INyru9IVGvRjVUT5GbfJtdtssojMYSA3mxmOqwdbLdlWfiPIJ7UaH",
-	"ibhGQbMv4MWqLs7XB4HDgHfEgHM42xtfPWFl4NQLRocOn+OFDUeqfIMWgNbq/Sp+jy58I6lSRX7UklY0",
-	"T54nK85L9nw2Q2skyJnmJIX57PpJIpZGE9rsT9VJ8oueNR8YqdIFUycsbqqfJ83uUJGVBBdclVpCOVrL",
-	"vA+6Dp+qO1QiyiGWBaE4AeUKMgROzCe12mqyfCyi8lPzKNUjpPZIcTxannTSoiFpB58AXHBEF1DYFrK4",
-	"b8Pg8Il+cidEP+0k2oXjTvQBQRYYTFPEGFjDAi7lWD6pTz9iuB6RyNNOIslioepb+WkjxZeEZogy0ZUu",
-	"ayL6Yaj2oU/66cdarsrx5vB1hKQyLvhKqkJVSCwyMMd5joulT+HXH/UfRyTum07i5EKL/6GYXSlZRWlF",
-	"dYVhQ5t++DEeYc+6V34uFJrO7zgBOVkuDfPWpMCqaqtP47OPtSYjEvtt9xKbDGZlDgtLZhBDXsar8Sj8",
-	"rpNCqGvfqVzHPkGNLMhtorySQpmuoyepu1aV02WNXFMoLWTP6YF0fZvPHz7/XwAAAP//uViSUfbyAAA=",
+	"H4sIAAAAAAAC/+x9+5LbNpPvq6B0tir2WVoa24nzxflr4ksym3z2rMferTpffLIQ2ZKwQwEMAM5YmXLV",
+	"PsQ+4T7JFq4ESVAiJc2M5PJfcyEujUb/Go0G0H0zStmyYBSoFKPnNyORLmCJ9a+n52fqR8FZAVwS0P/E",
+	"lDKJJWFU/5mBSDkp1N+j56NTJEAiNkOXsHp0hfMSUIEJF2jGOBKScULnCGcZUeVxjpYgcYYlRnjKSonk",
+	"AtDp+RkiVEhMUxiPkhGRsNQ9/ROH2ej56P9MKnonltjJqSdq9DkZyVUBo+cjzDleqb9xQc6yCLEUffhw",
+	"9hLJBZaopOTPEvIVIhlQSWYEhCNnjM4kEgtW5hmaApoDBY4lZAjTDGEhyJxChq4XQKsBCJRy8IWWpZCI",
+	"wxITilKmB6cGy1k5XwTj/kagnMwgXaU5jNH7BaAZgTxTrbHCcoxQJNgSVDMSPkmRIFGmC4SFIUD3qpmM",
+	"KFxrWq4XwEF3cfYSLfGqPobpSn8SKyFhiYgUkM8U22eML7EcPR+VJclGnqdCqilUTK3xsi0HU05ghoL/",
+	"KqmoxlmUvGACNHdmJU3N6IhcjaN9EVHkePUGL6Hdl+LTolxi+ogDzvA0B0TxEoL+om2aEceb02Jh61vO",
+	"aCEpOLsimZYMIlzLGzll/hHrR30J6EQPYDwfJ+htAfT0/CxB83fnLxL0M8fF4l9/ezhGZ6aorkYEKukl",
+	"Zdc0QSSUT4VAydDvow/m8++jesWMgUCUSTQjEmG6QgWHDGZECXGKJcwZJyC6Gn0rF8B1k9RIXoqFKh2A",
+	"OgOJSS4amNZ9Vw1aVmZKoFWRUFK01CvWAi2Xo+f/cCMZJSPLmlEyslwZJSPFpVFiKBt9/JyMroALK5br",
+	"lMa/2WKfPycjDn+WhEOmegulzc7eRz+tbPqfkEo1rYHOaU3uOyg4CNUbwg1diEoBmdaHTpPSuRUyoeHA",
+	"1DgQUEkkAeH482r56jf1dckyyBVv6lr5ElZxEbuElZOwSnMbjQb8CgTCRs95tcc1bfXyihoeh6YeWLxr",
+	"M2YsBEuJ1jXXRC6aLV/CSlPjoRUIEqEGW6oc49ViwSHX7UmmW7uEGGmNSVUMctRGZ7MgZ3bV+VLWvM26",
+	"TeNSKTatzxwFiAPOyV8g1BqkVjJMFWJzmEkEy0KuEKmqcxCs5CmgBTZaZQpuGYIMrUAmaFpKdE3yHElO",
+	"5nNQgm8Eysn2jNBMMSknQvbSqLiarF0W9ornw1Z4z6gtlnlXd/9rvafqthb83RbhmoB3rMYhAnutyn7M",
+	"eqoVnzCh1fIcTPB6Sb6odX8fQt1QV3UJrzO/Q339GlsCTqnmg1oFpqykWmdiis40FuRqjN7SfIWwGujC",
+	"8VayS6BKEJXygqy92OC0QxWeA18SqeFgiiTawGAzpKQiQdecSEhQUarOOPq/NV3XYTdVKs3y/1Sq0p6d",
+	"GZbwSJJqrQ6bgE8F4SCGVCGWNWdZrU4XKi7Blmx9oRYorQ9Out6vChAx28F81haTcCucBjJOUxDiRyu7",
+	"OM/ZtVA/hvCxvS5qAQuG3aQw8RMezkG3FL4wZdrLqDVS1i5qugVNtZLCuCKYAubAjZxq1Z3qBVpBm4Pk",
+	"BK6UBM4xoX3tAtNZ95DewZ8lCNkeUicUXsIMl7kUCnBK+IeJ+u3LbX/p3FKwAnqifOWSzHAqN9jOU0Ix",
+	"XyHMJajSZoeEBJvJa8wBFTi9xHNIEObpglxB4irAJ0hLqdahh0hynF5WO40c00ykuICIYrtlOw/bQe/L",
+	"xrPNxWygzQZQSMyuu/3IPj9sfpgtcbrOkljbrFrF2u39EqxtVjyMHPqlWo8e4VxtfeVi+XwBn6yk/T66",
+	"+OX0yXfPnv8AP0zH4/Hvo4eqZ/iEl0WuOg8+f//dd9mzp9Onz3A6e3qSPn6Cf8BPZ+nT73948u3T7x9/",
+	"mz5On2VPs+z7J3979jjDz55+P3v69BlMp0+/mypRxFICVwT//3+cPvp/+NFfJ49++PjPz/9x8ugH/Gj2",
+	"8Z//abPVUAlED5PBFe40umpIFAWkZEbSyuZ6kLJiNclZqgX0oWIxpug0mKD7Qdfet1Kdqiq0SDnMgANN",
+	"IauxYPNepjENu4G5NvZbRPV6a353eK9rv0voB9nLpVz89RJSIgjrsDLUsqHpwuiKCDIlyj2JMltnoHi/",
+	"X1S7im8EYtcUuFiQInCFiD2Jq6Iwg+ynDodQuoD00gjTnGMqIQuG9yPCUwX4aq/riEZEoAXJMqDRGZ9z",
+	"VhaDzIXK0tg0XGcQ/xuBa1VPSGh0tZZZaqYvJBQxEkRpJCJGruZKHhpJU8ZywG2HoR9I1aDnSNVOUpMQ",
+	"N4xO4dQkt2bwLYVQGvVkJohQBEpnOldZBrwtn7psu8WinOYkfaSISBAuMyIZT5B1ATpo1iDAy7wD9trx",
+	"G2WmFbUezDRkVjV8szFO/WQ22e1RqQ961TLzgR7o6VDDcnbpQ70VRpzlmw3BPaByaijtaZ03FPMmBdv6",
+	"rkbVq6MAAOvGdmGKvYNZa76qgSUNz7kmouoiNn8vcIGNVMXWidR/RTw0RSSkC0pSnKNpSXLVO5rmLL1E",
+	"bKbWYO3jwqjgLCtTqebceIvu3iKpBrAn3V41uLXfs07TrXr96l21Faw5f4m6QKwtpbfPVTPIVenNzkrA",
+	"7GmPFuENW9calzfbEtE+IuLkLUSjedrDiujsZtNnWXwWOizReB8bJ33HA7QY1V2MS6PYD3YeC3aNlsrt",
+	"rZeh1Fap7BLVKoJPREh9Voadtzzxh5l/QYawQNz4cCBL/OGjXudSRkW5jPo5l6ykEbP/DaOPKMyxJFeg",
+	"DcIlztGfJdbrSij8EUr1lpMIR6U+N7CExlGyVke9+iSBZpBVyscd3vnu/dHdntRP2t/H5MbVB6Sq2Reu",
+	"vKpr+GOh1Kt2VWOH6wltvu1dJW5oPnTADRj+u0a1GCjTmEpr91jjfjCNiUPEOjC/CCa9zpoXORZCuRCw",
+	"Y7jHdsWWNpDDiwAewwarGsSGXA3h0ccIPyPy0b3gGNV8vsAC0ElIQUMvmw+9cNCcB191HRfD2WyTe7HA",
+	"XO3bWIqnZY75SsMeoyVgUXItdJ6jOVkSaY7esTIK5F1Yu2lkGHdk+qohdrrjLYdgCVRbSNIwTku1MBgl",
+	"wihq4/9LGQeRoJ/JTw83eyI6Rt2Em6aw7+T3EdeOpSYQD69t2ubFVtPUb+DRMTpRuvtbFl6K97Uaugrb",
+	"2+KeomEXEHy9bW4g+Mr7v4JQ0XVLdxCsoo/KR06EFhB13q1YL4KrLRVhroWaDPS8whf62La0LgLe390V",
+	"yJrgt1p2N6+256lrYTeebnMhs37jozbO297k1HW6JX6txru/+2WBvtjv0UgaavP101bRsO7GWT8l0GDo",
+	"7vr3602w29DCno5u1eGL7Ekv70NLrj/b6qsuhwx+nwp0r3fo4jwZuuFpw7VpEvsyo9YwAnELmB9XtBoc",
+	"G86xneMleq8anVbfVeEFmS8e5XAFeeVN8veRM6bxbY/S1GnPunvcUywgQ4wiYTZv6QJznErgREiSCtWg",
+	"tQjEPTiqq43uXlaFYHe8lVo21Aw1iv3EDTeJddXbMIgNTQf2Bqcab2iEipQVcAvGpxetti7FvJftYMpV",
+	"nl0yQ7gocpKq3uzd3vZU6dk3vGb2Wiqp0WSv99rmtbiBUJfGiFiAgT9wfbNMnTXpVwcKgerYfgryWt8J",
+	"tmKw40OguqFkiFOlDU7M6xyLjabamNX8yduoZwfWpO/LF6to476pmrJ1D5z6Kl1dft1wA3e518Sz2izc",
+	"i+LUhO9Xe1a+mO01qCFrOzXqHpltqUt1/dtTqJq6w9SqbuR3plq98PUCetQpGUP5K84ZvzBtxRytr66i",
+	"urtubC0wnUPr5q0BvoYZqFYQB63NNTedatXv03gE05eERnDxkqi/loRiyao3bK5hyLxzVD1dlMALDgaD",
+	"aIllqvS9tedcOc1qYf3Ab1gGCXrhFiDzXiRBwauxBL3l8w+UyIdjdFFOFTFT4AKVdIm5WOAc/Ydr+T8q",
+	"Y1Drbq16xr/TmHyogXc8Mmy+INWsjD+bNFfyR8noQ5GZX15CDhL0c8nwHlal7c4DnkteQgMXo3+5ePvG",
+	"3aNV3DZ6YlLqDgwtYowuFmq6cU7mVFTv/1LGOYiC2RcyXix+fvXeGMzalV0wKsDOJJZITbuFPKPuyLfG",
+	"tUp23ZBiCvQCDMGZ5oCl1CgbyqrZn7JspR/B9PMnNcBWTVti5DWGsNckl8DflXkE+i9ZWi4tima6HOIw",
+	"J0LqCy76fEyDSPCrwHnx2pYscyXPYIRQH7NZJNrRWmVZYKHk0GpwpXkLBV5hT8bhU4FpVqlSXdlRky7s",
+	"+4rYg4j2eP5d738ZypgRAw06NfuGogQB0fuljLMCPXCEVN0+VAS5N1UPOCYCEEav7T9Y/bLiw/G+9Pq1",
+	"2baDn4MyNy+ou5S5UtJZbPhAkYJR2JiSrktSFHZ1tXOjJgURtbabOcuCrvylud0WjmAw445nU4r7HYx6",
+	"8eo3VJVA7AqsGk8q9KjxBLaXQbCAHFIZTKr51YiMsej1jZ8xeqUfOM2YodFWZ+qpWmZxoXfyy4LkkNnh",
+	"REdiJeZ9p+X9uipgWKRFy4u8kzhDY7SLJQiB5xC11LyVo3hmqoSs40Az0P+UFSmhCG68IBC7IZqv3G1Q",
+	"z2a3S6gfEJqlLVjDHjrOuyURroCvuuyLZKQmZ2sbtSGEw/Sr7XmzFVPp2Fe0A5vmg/bAWAAHShdLxEsq",
+	"yTJySxSqFjdcanUl4xTqab9QvI5ehvqFXaOSz4HKfOUEUpjrUHoaKSgFIiVQJzLOACB0xkbJKGfXIyWn",
+	"GSmXo2SknFr6KR+RamMbvTThiJJYlqLrfjzBc0BCKjnT1Fjaxk6SzYqvNwamLFZrdwE1GtXf+omhslVy",
+	"yOb6Rke1Etn7KflVx/WOGqXWvIlevtM3R/R9QrMUyvoYSlEfRGu2p6uYWmdoiS+rzSqdK/tyThkP91Su",
+	"8xRzrsQfU4RLuVATZmzTghOakgLnHYDHouM2hPATtG7DWZ/NzyF7P1BJ8vbAtBa5wjmxURssh6p6Y/R2",
+	"STQ73f8QoXrnTiTk9bula94tNnBih7MOJuVyiWO3i+x3lJq7HFOuXzFn7JoqXS4UunCOMrIEaq6QOmve",
+	"1SA0zctMGat5hTJCGw4zUU4lB4iJR+B/7uUGqI/opzK9BBm9Or56XV/F9tz6W/UY5WflwL6FxpsXmfbc",
+	"fKg19920h9ZeG5ZM4vCNBKES5sBbUDDlAkKaglAbfmMek0AcW7OwGV2W+qjap+VyClzpSg8SscDcWOEu",
+	"6IvWpMI0VmEudo/OXrBtMqOHfWsL1Azbyr6xr+b1XWiBJEuMNtYb4nHHQ/54P0YnJUaF6Au+vOpH7e38",
+	"a5nQi+sMR/Ouxr8+8UcblYU7ay6W2DAxQdo8KxXF2lkQ3XREn7Onnbc0nQDpdS/mvEkZz9RumKEl08/o",
+	"q5EwRKRAacm1M96uCFhvylf9lkvFTr/U2fNGs8fRPDNNRmfHFhvyIP4eVk29y9M+Zarl3xV3PEzMMMvC",
+	"7wByXAgQyJ0NOztz+6UzZNU6Aejvu2+uhS3nfSjqNee9a0Fvmq8IM+cnWmR8uKeIT52X1lu80gaji01m",
+	"d2SMkzlRWyqLwNBoM5BJEEa6yZTlasPJONK3Y9UU50QfdQuJaYZ5NkYfBISbr/eW5Mq1IBkCKkoOOpTJ",
+	"rOTaTVFwlqopVFqP272C+oMIs001m1kdbsXzT8u7GhFOZYnzfIVKmgEXkrFgv+m2snd7juHmMM2x2N9D",
+	"1O386DVa7sSR3hr9Oj/CDhvesJdhpzK1utscyzSZuudzmTp9t3QwIwJjr4/idsU/f16vCPXD4uie0ew7",
+	"6xquesal/VOAMxcITTusIwcWXzQQbnXWwgWu3nMfz48u3zG5FAHlJF0EsxufTrP/9aKgRUBNgDcBvTyI",
+	"e1Pbt6Owb0Oy1slUT3lquHR7iJTHuJYoO1ux+fjw7qz+SlOamyWe9Io4Tta5ZvtvG5vBDZpzsqWpKr2B",
+	"34c/pnAH4CI3Q2a1bai3PKvRh3yOYdN7G9bYn3bPNHMv9vsHQ71t1GnK7tlI0jQ01oTGZZm9rw5+3PHw",
+	"H1sbRqbdYQaRrrONIeQYt2cDyNBzK4ZPA5aO15tXQBflcT3QqA+J0bXDC0qkmFaxGBBGBXDBqNpxqesa",
+	"JAWEU+190E4RHfxR75fcjGuZsm2Z/b8JydmMEmwiHSJO5gtpnAwF8CXRm2azJN9/NDfHlHvWBI6MO1YG",
+	"4eg3BCPcQiX41ge+x/CSvMV7jIqP+36P4ai6C/VQC+S5UUPYg9/fiOh/bhFWOpOwjAlzq0zrzdOmd7yk",
+	"9rhno1VYs+vWm2mx3eDfgc9h802cpSpm/Fk9buP83ZfucxlHt60ak8xcblIC5E2q/ewq/XWWYCDrbrNs",
+	"rR2q9vd/caDe9i3dG1AX/uxpyOYdJGUZ+OOO2jZSvwjq2kvqanWfwt2vYoqIu1nBGuISWcocLXsVxc5G",
+	"1Yee2kUV7bPhfGPL7bDbdORu1GGhWNuhtAN7uO6DIXRJ+4AjAS24XccBlVRvvshv4wQpZNtmrZt8Cjmj",
+	"c88UdwPp7qGxz5v921p4no47NvFq4+/ExNZ6vGp+mJHn621j5YW83LOZV9F1F3ZewP5+q1kvT3dkServ",
+	"5r4jUethC1YX2tdfmW87cWt5h960dWaf2QjigfaZmJ72RW0aTEzMr5bFV8viwC0L+yhmg2HB+BxT+6AP",
+	"5yYyU9y+CPO46ExaOr+CbQplUGAul+ZFgQS8FA0/VKMjIXmZypIrnORwpdcLdt9+pQgz7tkAiVB0x6ZI",
+	"B09afTAjblvbJLF+hlknUVEebqfEWb5niyVG613YLtUsbV4hzzHHS5DAYxJbuI9+r4FpmMpOT5EPy5ER",
+	"kXKQkCBzTdncs/OxOSBoTmkYiS/vAf2ehn1hfgfU1WiJvd43X7dGW639zQGb9Gx1XgbVU1tNKREd07ll",
+	"PptwtD2k1p9WxFY+HewoPNKogrDkhF6aVQ2H30UB6XbHHnd/L6AtKH4cfa+MFJCuz7ZR8fdCccZMt//f",
+	"kHhSzWkOaW1aPZqu9dN9ESX9bV3R2ncSdoWtTfQDPdMTZGlSpszD45pjxYFtgki2WuiBMhPiPboymE/q",
+	"Z1ryauUC8/abCPif//pvn0fK5ZcS2g7Exq6cgzYZZ5wtdVWhNGMOc5wjxhHFsuQ4t4eZye/UrcH5Ckl7",
+	"9RPT5hJ7BTRjZl2y8ecgq1Z1dE1oxq7tq+Y7XncMw+7Z0gyo2Kv9uK5d+237VcyKWkw1b17UtEBsjgoT",
+	"M9Vwamw54cRKTXapbx67CAhEOPKGxESMSNhvTRnVHeTa5mKztQDSt5xbtPQSMgtwwqgPw7hpmfaT2Vt9",
+	"BK3HgOV0hB2YHrPidFpKq73d/Dux0MqmAnWGJViHN6NCElnquxOOY1Gwu49tis5cL7zB+JjfvEazm5Dd",
+	"oszhK0xyha/XnC27Hrq3+p5CypaKC662Fp9S9H7toFRKwUE/l1zTs40Vx0W1Y5sTipZkzu3G5xqvnEpv",
+	"ENmfFKmWKtpNyulMOolXTVhXY8AOvQO0KSt9PnC1I7tm/DJnOBMDXoG05NrdKfwFcC4Xcd3iXyWkxG4I",
+	"q/gop/WsT7qVlYuQQZ1OXZqNJ1DtYQme78aDQlyBexW86V2ZblPv3IJGPcGBCg9ei82Ctoc81+u6dmkH",
+	"HXvavX3iqmvGhbzY03XowMnsiE2ajA748nGNnNgY4/U5G+pgrJzpQy7K9stnEnXS9z4G6A7+MOAooHpN",
+	"GUY/Mw78UXCAmoxMbCD/SxDI8vT8bFTPNB/GQ49FCg7u8FWuVHd9tkp/lYzesRz01qO1FzHfav8fVZaz",
+	"vyY/qj8NCxK0RjJF1kLXhJdnahmdQt9NkOylI8nBxy4Bb2UkXivLG0+42vN8JwdcHeIVuTl3m4DIcCpj",
+	"ITDe8xKCEHA4z4Fr559aowRAM6+Qo+BH/SRUj5NoY5hqJ8UC8o6oNV8xeU+YHAQ90g9yLIc13i3OcrDB",
+	"IwpIqylMfN7J4EpvYh40+RkXiTOY4wlYbiFlSVf04BB5LxrxXIkww7Sxi7YJL7iDp6U+9MGW/PaPVaJJ",
+	"1EzCv715+Bx6Ai5v79WzlEX9edXUdwn5Vj49S7ERemVhN7y54ri8ervKmmXjNo7BoO7mPX2QqbId+c98",
+	"M/Nj81ai//mv/3ax53AVpyF8FfFgWdr32vApzUtBriDikg2ewGzkzqBc/bEd3kVHrox6xm4X3z1+T899",
+	"bsQ5Dx9nWGYL93gePqEpLPAVYdxES6YpLkSZawfHFeaElUIZUcbb4T0nldhGZH4qJI8m1z6jmY6OpI8u",
+	"9fyEJ48CuZrogeQlPLTRNcwp0IMZzoUKZ3bqCvnI7FyHoFhiTvKV2XvXbhDAJwlc4dlV0Iyw4QrM2Fy2",
+	"MZNm1PdpKXPhCroOa9o20W17dqvsp/fo2DVE7Jr2ZtcI4sLGbd01gLid693jh1sp6/f83diu27qprRgM",
+	"u81w4eE29ALDhZvwPd9ZsKy/pRADe00K5HXbx04Vfn85gRrZP/YbvHxAlPt20pEmZbU7XznMJAIdPInU",
+	"bXK/WTSoVL5VJz2QoRXIBE1Lia5JniPJyXyu74eY1HhukXShAHIi5K2nR47MQAfmhyXF2iG1Uj33yo6q",
+	"5mtSpa0vSLXmIemfb6zzLMt+QIQaYtTvSl/gPLeBvHs/sF9/BvSeLEFIvCwQMTac4uK1PxiyOrZ9JjRG",
+	"rzVhapaenJw8e3Ty+NHJkzH6u2Gyw2gpSw57OzbqSaxJsSFIpl/8VY3qMJL1Iyd8xUiGSmHi+gZt4OpY",
+	"SJE8ZLhj9Hcl/UoBYtMIpqg2Dfs7vdqKI1WjIUf8OVcpYDtetEddn9D+w77qwsWFuwTs72S4UM0PTDix",
+	"30ePxyfjk99HD40qUYNhMyRgiakkqatl439xJdZLnZZ7c7w+R1QbyJ/1G9gZi6jg8zMkGfqzBL5qBDoz",
+	"BgCmCNQjSUVQdd+lsQ0hMlfdvXIFX1UFf3MFkfGOes6NTsaPxyc2LQHFBRk9Hz0dn4yfKg2B5UIrhwku",
+	"5eKvCS7Io0tY6X/NY8Ek1dNg1YGyYcQYnZYZ0SMQADbu9CWsfrT3to1LWnhftKqj5uCsig0iF7DS+via",
+	"E2mVvgoBKSDlIM3Oj6qGEQdZcmqmxwfqV4vd6GeQp4r604L8qmhPRv44QA3gycmJz/5sdh12F6EamPyn",
+	"DTdoLKX+HhzdV+RiQ3O3NXr7qyr17cm3g4hY13eY4yPS4Rsm0WtW0sx4IfBcKJnV86szRxRMRCbWJJtQ",
+	"UmhnF01VG/ZC1Jl/2f8+OGqwMMd5zq7NllzPollmbQ3rEtaGscZg0IPJLCCYaVXq2L9E+Jk2QfmIv5lg",
+	"5rQ9/+dMtAVAx27+iWWrvbHdtP7OtGwYXykFyUv43BK8x3vu3ExSFpt1/0nJ2sldydpPOEOeI6rnp3fV",
+	"82vGpyTLgB4Uuj4nTU06ubmE1Vn22SBOp2+JOACv2GWIjP4wuwQV+mIQ2Fr4MVllQgT9CiYShb8+rQZ5",
+	"MyKKWLVkjJIR1Zun0aUtWUdCEvC6uZR+bKHk2zZH3jCzQ6RfxaouVvCpyDGhnevzK/Pde19xELpXLbMC",
+	"oHZr6J3WtS5nxBURxNwDQOkC0ktzEkikQKyUyu7X8hUe8n4jkIp0zLV/LPB+jJEORo+dfaD6VvKMiPzR",
+	"LPp2JOoPwlUjLnwQ44npV1jPv+rTpOpMbE6RGcKU2ZCuQQz+uFlgWdIhztogq+S5cX+hS6g3bgzXnGLI",
+	"ejxl5jgxRi9hhstcCnc3xygANbIYqZY9o3Vga9Hxgi2X+JGAApudr+Frm6w5uQITg9/00kWEqT8aBvj9",
+	"LU96gl9CSqxjr9P6+roi3qPqMiFzJkUp9L3GuAF6XoqF9dro8uY1TRCwx90S1eGfzTkORWUhJAe8tInn",
+	"VCXKJJlpwfXp3NjMJq8zMXzEGP27UjCKxiolpU33gmTPpbdKmySQeS5oPGNB1q0c8BWor4nZEV0TAcFn",
+	"bdXO9HGWVnjfnjyNW7c6a59QHLol21Z30M+mPYkcqv96f6IeiJwRs7rMaXU1uRGKZ9op97lz6XwHkhO4",
+	"chlhBBKE2qQERhu6RtDZy+hqY2bpX1WPF76/XlaUCIvvYkp1Ts7Tk7+1P2nUWk99MDgdXEo7gHREK7/m",
+	"lzyv9mY2LaCqaXIKpDq8jJF+Cp9sZZdh4ndqPWOuH3WB/p701DcbpcZpnW5t9c6WqGksr6HWgNhV3AHI",
+	"jWwcOM+nOL38wPNeUdRq2UWDunFnVq8d7pqd6AGqhGBh2KwL9E0A+xI5WIvq863WkwXQ0HwmAoHy2Kc6",
+	"K2PNFBZQNdv0lHFIgVyBcQaq4zJ3ptWhbIKcpXtze226Bd7H4bVhCkq6GWEffBnNDadM+iOtauALwdqa",
+	"lffOlOiHd78pKLjB6FVi1jL+anPuPdjaJ+JO5HpgT20XlffCV4k+1mshwzvCg/vBO6Ojb2jNHoDw1I+S",
+	"UfH4Dx/NZD27Jje4Gk4vM8ZEG7IJ8kQBKZmRtMZQZUMTKfTZcH9WnoZ09DJvcKPG9vvqW91LVlQesB9/",
+	"iPT0x9gW0DpWSFkk7YagIcDRgOkPlAMGyPnZFwGMxiOMnihx1bZfjlr9HiiA/vZHhiX+I8US52y+mX+T",
+	"m+a/dkBXi80DsNYk7LRFVj8UxqodKCQbpB4LPnuK2EBobovIY0diiMA9IG8w4CqgDQTY4QPrCwHUNHhp",
+	"vxlP00byyJ5w+ql6wX54aOriyORm6jImbY0c20J/4DhO/RQka9oMm2lQ+jBRY8dzFKBpCkQY17wfTMIa",
+	"jcwSA1DzIuz3GJATDnty4/9a9QIQrri26o+XkEcvgg57wSatVzhM5FSjOlrwpAOhkyoRAKr+K7bATHq4",
+	"iElGxfd/uCGu4dXkxhXaYfGpsXIgplKPqHQYntLDR1N6LFjqJS212A9DQVYl2F8V22KtQcDRwq4+jslN",
+	"7N/7QGON54NRWafyRZTGQWBtVT1s4Ibkfgkgdv0NPf7yFbf2OrZC4Ryl3z7CwMlN2hzaLrhtMXoAZlss",
+	"ftGmrB9ao/UOFKpNWr+Es4Gqw4H43BqWxw3HGgr3gb7hoAvANhRkRwCuLwNUPs5bb1jpGtvZq2FvB4qt",
+	"k57cmtwEf+2Er4qfQyBWUfIipKMnzOo1DhVonspjgVoP4RkGsy0RdszoqpC1B1QNBZQD0zAgHT6IvgQA",
+	"zXQ4zkc6/XM/EJkaNmF08xSAiEiK6bUyUsUDPY7TgJBhkxuuMzX3OgcI+NYfQQF33rmk0JsR5PNHHyZ8",
+	"qkEdpe8/KgET+5pK0VKUsYeq+jtiHGVE6F9NKCctFFNYEJ0ELBAS+ybaJGG3ETt4Vh23qTLfCNdahmY5",
+	"npunK/gSBILZDFJpE2y4FyrqmnLkCnm5RuBe2XHdpdzt/71XNSw3nv430O9Q5r++2jwglPuY1j0XRrN5",
+	"Y7Mqa4VKDEGjuTrXafyg37uI7BJ0uMVrl5qh8d0fnIjLtayc3AR/9Vw5LRtb7B2wilYEvA6776XVZo0a",
+	"h7qoBtN4HHbpenHpbY5udXcryD7SkIAG39RTNvMItOrqesEE2BVWvV6zL0KXWKYLE8MwGr9AlxolPfnr",
+	"UquYWpHQBmtIs38a/8cCC4T122xhwy4Km66lk1L7fTCtVRqYj3eouuLpcfaputQjyuUS81WnTL5gJZU1",
+	"1WSmO6nNReJZn5gwIiYeReLDg4pyKjmYsCMuHEjtZLWfVF9YavsId1qn/IBkO0rZ8Yt2n97s/O1ZiN3a",
+	"u4MPaDb06qcTydeDrn7ODv7qZ033fBHrbSgeEwvoztfKL8y+VK24khM894pCi4uTkgdBcrREZ33kIETi",
+	"8hwhndJIZ1Ij8mFbjtTb5m5BunA6507F6Tb2poEy/FBkWMLd7057CfPX7ekBwtdGpOplLJuyA03ln13I",
+	"q8N3zprxTW5smpOtVzldv/8aZzj0s+m0l0Ka+7KHubrpsRyld5b4WLv9IFGVHwiLKqjvUUCjGufkpsrs",
+	"szVCXBP9QVLx68x33wsrJCx+mHBxIzpKxCyBz2HICaCusLcDQJ8D8DhwFHBr0PFfxbT+kKlY8+Uc/vkx",
+	"HSVWqM3W2RMpqvhWl7re+H6OAROeK5Mb9+sOS4tnWn+geHa98d33wgoNix8mXtyIjhYuA6DSRgnKiEjZ",
+	"lV5fBEMzzDcLwt0cm6merJtuZ/9zlG0GS7viaBCENHwGQOfAYXM0vrnm/DOTr7knchifo1IVH7jGvHW9",
+	"HMMS41gyubG/7QAMx7D+4HCseuv67gURFpQ+TJTY8RwlSEL+94FJVX772AXnVZ/HAJpqyJMb/3vP/Yov",
+	"3x8lFXfOq856IaWolT9MrPgxHSdaarn9+0KmngyczOxQhq40543OjwI7dZonN/V/7LD8dHF1ANDqtJ03",
+	"KOsHuXalA8VdjdAjB19P4Nl0qFkgK9tj7tjwVsPafnC2BbICVA1G1FGg6TiRxFlW9o6T6NLk5ytUcJbq",
+	"5JmuBSQ5Ti+HW4COgKPAlCV2cmN/2wVNpoUBULKdn7uu+4EoKH2gCDIUHiV83FVCdXThE399niwA53Kx",
+	"Pgdq7a5tSmzO6epuIpubBGw6TwqmSDntVB3Ttso+m5FUrhcZFwVFvPPE/WJI63XwsZ9UZrcpO25cdlTH",
+	"fW+MsxwGbCZU8d22Ee98h8egfD17Jjfu1x3Ub5t7/TWx59s7T0c/QIXFDxRQlsTj1MYsh6EbAl1nC9wc",
+	"D2YsXnbFyiB0aGQMQMWBI+Io0SBWQsJyaMAwU2vraGEXuvpRhwpr8m1yI2qD2gFIDeb2x1SDrRcNgnrh",
+	"TLQrHSbi6qP7EgIYGdYPQuB2wDtiwFU42xlfA2Hl4DQIRocOn+OFjWv75pLQNaLwLxdv36ALXRY9yDie",
+	"SfTk5MnJo8dPHhqpCO68sLRcAvXJs002VtV8grBAYPMqYpqhK5yTDOs/SwGI6CzCJpX5jChLcQozxsFk",
+	"IMcckOYdZFE5M9SJXwnNNr0cfOlINFSlWIBagYAKol8NPoDxfOxeNSYqhZRD40P/Mq+RWJ/Q9TK6b5nE",
+	"WUbUJ5yfBzkPTa+RZIVHYU1JEDKQwNYMv1ff++ZZ/KzJMHmmjQyUPB89Hy2kLMTzyQSWoPof5yzF+eTq",
+	"8ejzR09Zsz39ChMRahQNYe2oCCar4rgSCP9l1H4eCjQrGKFS6PgmkIOSRZ/V3aS+VlOKCdVvRRkqFlgA",
+	"OnFFXi1fKa1qi6aMpsB1URcvKyCkFj9pf7Q83kiLXR585wkiVAKfYWXnqhpN4zck+vGtEP1kI9HVO4PE",
+	"blZVMZymIARaYornuq+Q1Cd/ELzcI5FPNxLJZjOTYj3MaKFKMp4BF1qJmoyrqh0BtYIh6U//CL/scQzf",
+	"9pBUIRVfWUmNM5ZmaErynNB5SOG3f9h/7pG47zYSpyda/aI8h0ZWIS3dw2xHm3Us7o+wZ5tnfqoUmk09",
+	"kaCczeeOeUtG1cLa4N+zP2pV9kjs95un2AVXL3JMPZlRDAXBuPdH4d82UohRhiVGNg1TSFAjQVObqCDb",
+	"cYbM+qCp06l0EZ6yUvrM9LG9he3IlI+0T6iysyXCpVwwTv4yy04GKdHHu0ETqsRfo88fP//vAL53eRdm",
+	"LAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
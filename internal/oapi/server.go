@@ -27,6 +27,7 @@ import (
 
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	"github.com/oapi-codegen/runtime/types"
+	"go.emeland.io/modelsrv/pkg/audit"
 	"go.emeland.io/modelsrv/pkg/authz"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/model"
@@ -52,6 +53,8 @@ type ApiServer struct {
 	Events  events.EventManager
 	BaseURL string
 	Authz   *authz.Evaluator
+	// APIKeys, when set, enables the API key endpoints under /authz/api-keys.
+	APIKeys *authz.APIKeyStore
 }
 
 var _ StrictServerInterface = (*ApiServer)(nil)
@@ -84,10 +87,51 @@ func ProcessAuthHeaders(f StrictHandlerFunc, _ string) StrictHandlerFunc {
 			Groups:        groups,
 			AuditorHeader: auditor,
 		}
+		audit.Update(ctx, func(e *audit.Entry) { e.SetPrincipal(p) })
 		return f(authz.WithPrincipal(ctx, p), w, r, request)
 	}
 }
 
+// ProcessBearerToken returns a middleware that verifies an "Authorization: Bearer" token with auth and
+// stores the resulting Principal in context. Requests with an invalid token are rejected with 401 and a fixed
+// body; the cause is recorded as the audit entry's Reason. Requests
+// without a token are passed to headerFallback when set (trusted BFF headers), otherwise they proceed
+// without a Principal.
+func ProcessBearerToken(auth authz.Authenticator, headerFallback StrictMiddlewareFunc) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		fallback := f
+		if headerFallback != nil {
+			fallback = headerFallback(f, operationID)
+		}
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (response any, err error) {
+			token, ok := bearerToken(r)
+			if !ok {
+				return fallback(ctx, w, r, request)
+			}
+			p, err := auth.Authenticate(ctx, token)
+			if err != nil {
+				// The cause (expired, unknown key, bad signature) is audited
+				// only; callers learn no more than that the token failed.
+				audit.Update(ctx, func(e *audit.Entry) { e.Reason = err.Error() })
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				http.Error(w, "invalid token", http.StatusUnauthorized)
+				return nil, nil
+			}
+			audit.Update(ctx, func(e *audit.Entry) { e.SetPrincipal(p) })
+			return f(authz.WithPrincipal(ctx, p), w, r, request)
+		}
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
 /*
 ProcessAcceptHeader is a middleware to process the header indicating the content type(s) accepted by the client
 and place them into a parameter of the context for the call to the Strict Server Interface.
@@ -146,17 +190,48 @@ func NewApiServer(backend model.Model, eventMgr events.EventManager, baseUrl str
 // ApiHandlerOptions configures strict-handler middleware for the API.
 type ApiHandlerOptions struct {
 	TrustAuthHeaders bool
+	// Authenticator, when set, verifies bearer tokens. A valid token takes precedence over trusted headers.
+	Authenticator authz.Authenticator
 }
 
 func NewApiHandler(server *ApiServer, opts ApiHandlerOptions) ServerInterface {
 	middlewares := []strictnethttp.StrictHTTPMiddlewareFunc{ProcessContentTypeRequest}
-	if opts.TrustAuthHeaders {
-		middlewares = append([]strictnethttp.StrictHTTPMiddlewareFunc{ProcessAuthHeaders}, middlewares...)
+	if auth := authMiddleware(opts); auth != nil {
+		middlewares = append([]strictnethttp.StrictHTTPMiddlewareFunc{auth}, middlewares...)
 	}
 	handler := NewStrictHandler(server, middlewares)
 	return handler
 }
 
+// WithAuthentication applies the principal handling of [NewApiHandler] to a
+// plain handler outside the strict server, such as the event history.
+func WithAuthentication(next http.HandlerFunc, opts ApiHandlerOptions) http.HandlerFunc {
+	auth := authMiddleware(opts)
+	if auth == nil {
+		return next
+	}
+	h := auth(func(ctx context.Context, w http.ResponseWriter, r *http.Request, _ any) (any, error) {
+		next(w, r.WithContext(ctx))
+		return nil, nil
+	}, "")
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = h(r.Context(), w, r, nil)
+	}
+}
+
+// authMiddleware returns the middleware storing the caller's Principal in
+// context, or nil when neither headers nor tokens are trusted.
+func authMiddleware(opts ApiHandlerOptions) StrictMiddlewareFunc {
+	var headers StrictMiddlewareFunc
+	if opts.TrustAuthHeaders {
+		headers = ProcessAuthHeaders
+	}
+	if opts.Authenticator != nil {
+		return ProcessBearerToken(opts.Authenticator, headers)
+	}
+	return headers
+}
+
 // GetTest implements StrictServerInterface.
 func (a *ApiServer) GetTest(ctx context.Context, request GetTestRequestObject) (GetTestResponseObject, error) {
 	return GetTest200Response{}, nil
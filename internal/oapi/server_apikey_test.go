@@ -0,0 +1,185 @@
+//nolint:errcheck
+package oapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("API keys", func() {
+	var (
+		m       model.Model
+		handler http.Handler
+		bot     iam.Identity
+
+		botSystemId = uuid.New()
+	)
+
+	// bindWrite grants identity every action on resources of types.
+	bindWrite := func(identity iam.Identity, types string) {
+		spec := iam.NewPermissionSpec(uuid.New())
+		spec.GetAnnotations().Add(authz.PermissionActionsKey, "*")
+		spec.GetAnnotations().Add(authz.PermissionResourceTypesKey, types)
+		Expect(m.AddPermissionSpec(spec)).To(Succeed())
+		perm := iam.NewPermission(uuid.New())
+		perm.SetPermissionSpecById(spec.GetPermissionSpecId())
+		Expect(m.AddPermission(perm)).To(Succeed())
+		role := iam.NewRole(uuid.New())
+		role.SetPermissions([]*iam.PermissionRef{{PermissionId: perm.GetPermissionId()}})
+		Expect(m.AddRole(role)).To(Succeed())
+		binding := iam.NewBinding(uuid.New())
+		binding.SetRole(&iam.RoleRef{RoleId: role.GetRoleId()})
+		binding.SetSubject(&iam.SubjectRef{Identity: &iam.IdentityRef{IdentityId: identity.GetIdentityId()}})
+		Expect(m.AddBinding(binding)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+
+		// "admin" may write Identities through a Binding.
+		admin := iam.NewIdentity(uuid.New())
+		admin.GetAnnotations().Add(authz.PrincipalKey, "admin")
+		Expect(m.AddIdentity(admin)).To(Succeed())
+		bindWrite(admin, "Identity")
+
+		bot = iam.NewIdentity(uuid.New())
+		bot.GetAnnotations().Add(authz.PrincipalKey, "ci-bot")
+		Expect(m.AddIdentity(bot)).To(Succeed())
+		s := system.NewSystem(botSystemId)
+		s.GetAnnotations().Add(authz.OwnerIdentitiesKey, authz.APIKeySubject(bot.GetIdentityId()))
+		Expect(m.AddSystem(s)).To(Succeed())
+
+		em, err := eventmgr.NewEventManager()
+		Expect(err).NotTo(HaveOccurred())
+		keys, err := authz.NewAPIKeyStore(filepath.Join(GinkgoT().TempDir(), "keys.json"), m)
+		Expect(err).NotTo(HaveOccurred())
+
+		server := oapi.NewApiServer(m, em, "http://localhost", authz.NewEvaluator(authz.Config{AuditorIdentity: "auditor"}, authz.NewBindingRule(m)))
+		server.APIKeys = keys
+		strict := oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: true, Authenticator: authz.WithAPIKeys(keys, nil)})
+		handler = oapi.HandlerFromMuxWithBaseURL(strict, mux.NewRouter(), "")
+	})
+
+	do := func(method, path, subject, token string, body any) *httptest.ResponseRecorder {
+		var payload []byte
+		if body != nil {
+			var err error
+			payload, err = json.Marshal(body)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		if subject != "" {
+			req.Header.Set(authz.HeaderAuthSubject, subject)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	create := func(body map[string]any) oapi.ApiKeyCreated {
+		rec := do(http.MethodPost, "/authz/api-keys", "admin", "", body)
+		Expect(rec.Code).To(Equal(http.StatusCreated), rec.Body.String())
+		var created oapi.ApiKeyCreated
+		Expect(json.Unmarshal(rec.Body.Bytes(), &created)).To(Succeed())
+		return created
+	}
+
+	It("lets a key act as its Identity until it is revoked", func() {
+		created := create(map[string]any{"identityId": bot.GetIdentityId(), "name": "ci"})
+		Expect(created.Token).To(HavePrefix(authz.APIKeyPrefix))
+		Expect(created.Key.Actions).To(Equal([]string{"read"}))
+
+		Expect(do(http.MethodGet, "/landscape/systems/"+botSystemId.String(), "", created.Token, nil).Code).To(Equal(http.StatusOK))
+		Expect(do(http.MethodGet, "/landscape/systems/"+botSystemId.String(), "", "emk_bogus_key", nil).Code).To(Equal(http.StatusUnauthorized))
+
+		rec := do(http.MethodGet, "/authz/api-keys", "admin", "", nil)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(ContainSubstring(created.Key.KeyId))
+		Expect(rec.Body.String()).NotTo(ContainSubstring(created.Token))
+
+		Expect(do(http.MethodDelete, "/authz/api-keys/"+created.Key.KeyId, "admin", "", nil).Code).To(Equal(http.StatusNoContent))
+		Expect(do(http.MethodGet, "/landscape/systems/"+botSystemId.String(), "", created.Token, nil).Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("limits a key to its resource types", func() {
+		created := create(map[string]any{"identityId": bot.GetIdentityId(), "resourceTypes": []string{"ApiInstance"}})
+		Expect(do(http.MethodGet, "/landscape/systems/"+botSystemId.String(), "", created.Token, nil).Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("refuses event pushes without the push action", func() {
+		created := create(map[string]any{"identityId": bot.GetIdentityId(), "actions": []string{"read", "write"}})
+		sys := system.NewSystem(uuid.New())
+		wire, err := oapi.PushWireEventFromDomain(&events.Event{
+			ResourceType: events.SystemResource,
+			Operation:    events.CreateOperation,
+			ResourceId:   sys.GetSystemId(),
+			Objects:      []any{sys},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(do(http.MethodPost, "/events/push", "", created.Token, wire).Code).To(Equal(http.StatusForbidden))
+		Expect(m.GetSystemById(sys.GetSystemId())).To(BeNil())
+
+		pusher := create(map[string]any{"identityId": bot.GetIdentityId(), "actions": []string{"push"}, "resourceTypes": []string{"System"}})
+		Expect(do(http.MethodPost, "/events/push", "", pusher.Token, wire).Code).To(Equal(http.StatusForbidden), "the Identity may not write Systems")
+
+		bindWrite(bot, "System")
+		Expect(do(http.MethodPost, "/events/push", "", pusher.Token, wire).Code).To(Equal(http.StatusOK))
+		Expect(m.GetSystemById(sys.GetSystemId())).NotTo(BeNil())
+	})
+
+	It("requires write access on the Identity to manage its keys", func() {
+		body := map[string]any{"identityId": bot.GetIdentityId()}
+		Expect(do(http.MethodPost, "/authz/api-keys", "ci-bot", "", body).Code).To(Equal(http.StatusForbidden))
+
+		created := create(body)
+		Expect(do(http.MethodPost, "/authz/api-keys", "", created.Token, body).Code).To(Equal(http.StatusForbidden))
+		Expect(do(http.MethodDelete, "/authz/api-keys/"+created.Key.KeyId, "ci-bot", "", nil).Code).To(Equal(http.StatusForbidden))
+
+		rec := do(http.MethodGet, "/authz/api-keys", "ci-bot", "", nil)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(Equal("[]\n"))
+	})
+
+	It("leaves the keys of an auditor Identity to auditors", func() {
+		auditor := iam.NewIdentity(uuid.New())
+		auditor.GetAnnotations().Add(authz.PrincipalKey, "auditor")
+		Expect(m.AddIdentity(auditor)).To(Succeed())
+		body := map[string]any{"identityId": auditor.GetIdentityId()}
+
+		Expect(do(http.MethodPost, "/authz/api-keys", "admin", "", body).Code).To(Equal(http.StatusForbidden))
+		rec := do(http.MethodPost, "/authz/api-keys", "auditor", "", body)
+		Expect(rec.Code).To(Equal(http.StatusCreated), rec.Body.String())
+		var created oapi.ApiKeyCreated
+		Expect(json.Unmarshal(rec.Body.Bytes(), &created)).To(Succeed())
+		Expect(do(http.MethodDelete, "/authz/api-keys/"+created.Key.KeyId, "admin", "", nil).Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("rejects unknown identities and resource types", func() {
+		Expect(do(http.MethodPost, "/authz/api-keys", "admin", "", map[string]any{"identityId": uuid.New()}).Code).To(Equal(http.StatusNotFound))
+		Expect(do(http.MethodPost, "/authz/api-keys", "admin", "", map[string]any{
+			"identityId":    bot.GetIdentityId(),
+			"resourceTypes": []string{"Spaceship"},
+		}).Code).To(Equal(http.StatusBadRequest))
+	})
+})
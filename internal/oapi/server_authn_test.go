@@ -0,0 +1,153 @@
+//nolint:errcheck
+package oapi_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("bearer token authentication on the API", func() {
+	var (
+		key  *rsa.PrivateKey
+		auth *authz.JWTAuthenticator
+		m    model.Model
+		em   events.EventManager
+
+		ownedSystemId = uuid.New()
+		groupSystemId = uuid.New()
+	)
+
+	BeforeEach(func() {
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		jwks, err := json.Marshal(map[string]any{"keys": []map[string]any{{
+			"kty": "RSA", "kid": "k1",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+		Expect(err).NotTo(HaveOccurred())
+		file := filepath.Join(GinkgoT().TempDir(), "jwks.json")
+		Expect(os.WriteFile(file, jwks, 0o600)).To(Succeed())
+		auth, err = authz.NewJWTAuthenticator(authz.JWTConfig{Issuer: "https://idp.example", JWKSFile: file})
+		Expect(err).NotTo(HaveOccurred())
+
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		s := system.NewSystem(ownedSystemId)
+		s.GetAnnotations().Add(authz.OwnerIdentitiesKey, "user-1")
+		Expect(m.AddSystem(s)).To(Succeed())
+		s = system.NewSystem(groupSystemId)
+		s.GetAnnotations().Add(authz.OwnerGroupsKey, "team-a")
+		Expect(m.AddSystem(s)).To(Succeed())
+
+		em, err = eventmgr.NewEventManager()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	newHandler := func(trustHeaders bool) http.Handler {
+		server := oapi.NewApiServer(m, em, "http://localhost", authz.NewEvaluator(authz.Config{}))
+		strict := oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: trustHeaders, Authenticator: auth})
+		return oapi.HandlerFromMuxWithBaseURL(strict, mux.NewRouter(), "")
+	}
+
+	token := func(sub string, groups ...string) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":    "https://idp.example",
+			"sub":    sub,
+			"groups": groups,
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		})
+		t.Header["kid"] = "k1"
+		s, err := t.SignedString(key)
+		Expect(err).NotTo(HaveOccurred())
+		return s
+	}
+
+	listSystems := func(h http.Handler, header map[string]string) (*http.Response, []string) {
+		req := httptest.NewRequest("GET", "http://localhost/landscape/systems", nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			return resp, nil
+		}
+		var items []struct {
+			InstanceId string `json:"instanceId"`
+		}
+		Expect(json.NewDecoder(resp.Body).Decode(&items)).To(Succeed())
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.InstanceId)
+		}
+		return resp, ids
+	}
+
+	It("scopes visibility to the principal from a valid token", func() {
+		_, ids := listSystems(newHandler(false), map[string]string{"Authorization": "Bearer " + token("user-1")})
+		Expect(ids).To(ConsistOf(ownedSystemId.String()))
+
+		_, ids = listSystems(newHandler(false), map[string]string{"Authorization": "Bearer " + token("user-2", "team-a")})
+		Expect(ids).To(ConsistOf(groupSystemId.String()))
+	})
+
+	It("rejects an invalid token with 401", func() {
+		resp, _ := listSystems(newHandler(true), map[string]string{"Authorization": "Bearer not-a-jwt"})
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+		Expect(resp.Header.Get("WWW-Authenticate")).To(ContainSubstring("invalid_token"))
+	})
+
+	It("audits why a token was rejected without telling the caller", func() {
+		e := &audit.Entry{}
+		req := httptest.NewRequest("GET", "http://localhost/landscape/systems", nil)
+		req.Header.Set("Authorization", "Bearer not-a-jwt")
+		req = req.WithContext(audit.WithEntry(req.Context(), e))
+		w := httptest.NewRecorder()
+		newHandler(false).ServeHTTP(w, req)
+
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		Expect(w.Body.String()).To(Equal("invalid token\n"))
+		Expect(e.Reason).NotTo(BeEmpty())
+	})
+
+	It("ignores X-Auth headers unless header trust is enabled", func() {
+		_, ids := listSystems(newHandler(false), map[string]string{"X-Auth-Subject": "user-1", "X-Auth-Auditor": "true"})
+		Expect(ids).To(BeEmpty())
+
+		_, ids = listSystems(newHandler(true), map[string]string{"X-Auth-Subject": "user-1"})
+		Expect(ids).To(ConsistOf(ownedSystemId.String()))
+	})
+
+	It("prefers a valid token over trusted headers", func() {
+		_, ids := listSystems(newHandler(true), map[string]string{
+			"Authorization":  "Bearer " + token("user-2", "team-a"),
+			"X-Auth-Subject": "user-1",
+			"X-Auth-Auditor": "true",
+		})
+		Expect(ids).To(ConsistOf(groupSystemId.String()))
+	})
+})
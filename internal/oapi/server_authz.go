@@ -0,0 +1,133 @@
+package oapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+)
+
+// refVisibility returns a predicate telling whether the caller may see a
+// referenced resource, or nil when visibility is not enforced. References
+// to resources that are gone or carry no ownership annotations are visible.
+func (a *ApiServer) refVisibility(ctx context.Context) func(*common.ResourceRef) bool {
+	if a.Authz == nil {
+		return nil
+	}
+	p := authz.PrincipalFromCtx(ctx)
+	eval := a.Authz.For(p)
+	return func(ref *common.ResourceRef) bool {
+		obj, ok := model.ResourceObject(a.Backend, ref).(authz.Ownable)
+		return !ok || eval.CanSee(p, ref.ResourceType, obj)
+	}
+}
+
+// eventVisible reports whether p may see a stored event by eval, an
+// Evaluator for p or nil when visibility is not enforced. The event
+// payload is evaluated when it is an ownable resource, so deleted resources
+// keep the owners they had; otherwise the current model object is used.
+func (a *ApiServer) eventVisible(eval *authz.Evaluator, p authz.Principal, ev events.StoredEvent) bool {
+	if eval == nil {
+		return true
+	}
+	rt := events.ParseWireKind(ev.ResourceType)
+	if len(ev.Objects) > 0 {
+		if obj, ok := ev.Objects[0].(authz.Ownable); ok {
+			return eval.CanSee(p, rt, obj)
+		}
+	}
+	if obj, ok := model.ResourceObject(a.Backend, &common.ResourceRef{ResourceId: ev.ResourceId, ResourceType: rt}).(authz.Ownable); ok {
+		return eval.CanSee(p, rt, obj)
+	}
+	// Neither payload nor live object to judge by: only auditors may see it.
+	return eval.IsAuditor(p)
+}
+
+// visibleEvents runs q against the event history and drops events the caller
+// may not see. Pages of at least 100 events are read until q.Limit visible
+// events are collected or the history is exhausted, so paginating by the last
+// returned SequenceId never stops early on a page of hidden events, and a
+// small limit does not cost a query per hidden event. Visible events past
+// q.Limit are not returned; the next page starts with them.
+func (a *ApiServer) visibleEvents(ctx context.Context, q events.EventQuery) ([]events.StoredEvent, error) {
+	if a.Authz == nil {
+		return a.Events.QueryEvents(ctx, q)
+	}
+	p := authz.PrincipalFromCtx(ctx)
+	eval := a.Authz.For(p)
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	includePayload := q.IncludePayload
+	page := q
+	page.Limit = max(limit, 100)
+	page.IncludePayload = true
+
+	var out []events.StoredEvent
+	for {
+		results, err := a.Events.QueryEvents(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range results {
+			if !a.eventVisible(eval, p, ev) {
+				continue
+			}
+			if !includePayload {
+				ev.Objects = nil
+			}
+			out = append(out, ev)
+			if len(out) == limit {
+				return out, nil
+			}
+		}
+		if len(results) < page.Limit {
+			return out, nil // the history is exhausted
+		}
+		last := results[len(results)-1].SequenceId
+		if last <= page.SinceSeq {
+			return out, nil
+		}
+		page.SinceSeq = last
+	}
+}
+
+// canApply reports whether p may apply the pushed event ev: write access is
+// needed to the resource as it is in the model and as the event leaves it.
+func (a *ApiServer) canApply(p authz.Principal, ev events.Event) bool {
+	current := model.ResourceObject(a.Backend, &common.ResourceRef{ResourceId: ev.ResourceId, ResourceType: ev.ResourceType})
+	var next any
+	if ev.Operation != events.DeleteOperation && len(ev.Objects) > 0 {
+		next = ev.Objects[0]
+	}
+	return a.Authz.CanChange(p, authz.ActionPush, ev.ResourceType, authz.AsOwnable(current, ev.ResourceId), authz.AsOwnable(next, ev.ResourceId))
+}
+
+// redactBucketNames drops the display names of summary buckets keyed by the
+// id of a resource of type rt that the caller may not see. Counts stay: they
+// only reflect findings the caller can already see.
+func redactBucketNames(buckets []FindingSummaryBucket, rt events.ResourceType, visible func(*common.ResourceRef) bool) {
+	for i := range buckets {
+		id, err := uuid.Parse(buckets[i].Key)
+		if err != nil || buckets[i].DisplayName == nil {
+			continue
+		}
+		if !visible(&common.ResourceRef{ResourceId: id, ResourceType: rt}) {
+			buckets[i].DisplayName = nil
+		}
+	}
+}
+
+// annotationFilter returns the filter withholding annotations of r the caller
+// may not read, or nil when every annotation may be shown.
+func (a *ApiServer) annotationFilter(ctx context.Context, rt events.ResourceType, r authz.Ownable) AnnotationFilter {
+	if a.Authz == nil {
+		return nil
+	}
+	return a.Authz.AnnotationFilter(authz.PrincipalFromCtx(ctx), rt, r)
+}
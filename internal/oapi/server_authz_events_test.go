@@ -0,0 +1,220 @@
+//nolint:errcheck
+package oapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("visibility of events, subscribers and finding references", func() {
+	var (
+		m       model.Model
+		em      events.EventManager
+		handler http.Handler
+		history http.HandlerFunc
+
+		ownedSystemId   uuid.UUID
+		foreignSystemId uuid.UUID
+		hiddenSystemId  uuid.UUID
+		findingId       uuid.UUID
+	)
+
+	addSystem := func(name, owner string) uuid.UUID {
+		s := system.NewSystem(uuid.New())
+		s.SetDisplayName(name)
+		s.GetAnnotations().Add(authz.OwnerIdentitiesKey, owner)
+		Expect(m.AddSystem(s)).To(Succeed())
+		return s.GetSystemId()
+	}
+
+	BeforeEach(func() {
+		var err error
+		em, err = eventmgr.NewEventManager()
+		Expect(err).NotTo(HaveOccurred())
+		sink, err := em.GetSink()
+		Expect(err).NotTo(HaveOccurred())
+		m, err = model.NewModel(sink)
+		Expect(err).NotTo(HaveOccurred())
+
+		ownedSystemId = addSystem("owned", "user-1")
+		foreignSystemId = addSystem("foreign", "user-2")
+		hiddenSystemId = addSystem("hidden", "user-2")
+
+		findingId = uuid.New()
+		f := finding.NewFinding(findingId)
+		f.SetDisplayName("cross-owner finding")
+		f.SetResources([]*common.ResourceRef{
+			{ResourceId: ownedSystemId, ResourceType: events.SystemResource},
+			{ResourceId: foreignSystemId, ResourceType: events.SystemResource},
+		})
+		f.GetAnnotations().Add(authz.OwnerIdentitiesKey, "user-1")
+		Expect(m.AddFinding(f)).To(Succeed())
+
+		Expect(em.AddSubscriber("http://127.0.0.1:1/hook")).To(Succeed())
+
+		eval := authz.NewEvaluator(authz.Config{AuditorGroup: "audit-group"})
+		server := oapi.NewApiServer(m, em, "http://localhost", eval)
+		opts := oapi.ApiHandlerOptions{TrustAuthHeaders: true}
+		handler = oapi.HandlerFromMuxWithBaseURL(oapi.NewApiHandler(server, opts), mux.NewRouter(), "")
+		history = oapi.WithAuthentication(server.HandleGetEventsHistory, opts)
+	})
+
+	AfterEach(func() {
+		_ = em.RemoveSubscriber("http://127.0.0.1:1/hook")
+	})
+
+	request := func(h http.Handler, url, subject, groups string) *http.Response {
+		req := httptest.NewRequest("GET", url, nil)
+		req.Header.Set("X-Auth-Subject", subject)
+		if groups != "" {
+			req.Header.Set("X-Auth-Groups", groups)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	decode := func(resp *http.Response, v any) {
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(body, v)).To(Succeed())
+	}
+
+	historyIds := func(url, subject string) []uuid.UUID {
+		var out []events.StoredEvent
+		decode(request(history, url, subject, ""), &out)
+		ids := make([]uuid.UUID, 0, len(out))
+		for _, ev := range out {
+			Expect(ev.Objects).To(BeEmpty())
+			ids = append(ids, ev.ResourceId)
+		}
+		return ids
+	}
+
+	It("returns only visible events from the history", func() {
+		Expect(historyIds("http://localhost/api/events/history", "user-1")).To(ConsistOf(ownedSystemId, findingId))
+		Expect(historyIds("http://localhost/api/events/history", "user-3")).To(BeEmpty())
+	})
+
+	It("fills a page past hidden events", func() {
+		Expect(historyIds("http://localhost/api/events/history?limit=1&resourceType=System", "user-1")).To(Equal([]uuid.UUID{ownedSystemId}))
+
+		var all []events.StoredEvent
+		decode(request(history, "http://localhost/api/events/history?resourceType=System", "user-1", ""), &all)
+		Expect(all).To(HaveLen(1))
+		next := fmt.Sprintf("http://localhost/api/events/history?limit=1&resourceType=System&sinceSeq=%d", all[0].SequenceId)
+		Expect(historyIds(next, "user-1")).To(BeEmpty())
+	})
+
+	It("pages through a history where filtering hides most of each page", func() {
+		var visible []uuid.UUID
+		// More hidden events than fit one page of the history query.
+		for i := 0; i < 240; i++ {
+			addSystem(fmt.Sprintf("hidden-%d", i), "user-2")
+			if i%40 == 39 {
+				visible = append(visible, addSystem(fmt.Sprintf("visible-%d", i), "user-1"))
+			}
+		}
+
+		var got []uuid.UUID
+		url := "http://localhost/api/events/history?limit=2&resourceType=System"
+		for {
+			var page []events.StoredEvent
+			decode(request(history, url, "user-1", ""), &page)
+			Expect(len(page)).To(BeNumerically("<=", 2))
+			if len(page) == 0 {
+				break
+			}
+			for _, ev := range page {
+				got = append(got, ev.ResourceId)
+			}
+			url = fmt.Sprintf("http://localhost/api/events/history?limit=2&resourceType=System&sinceSeq=%d", page[len(page)-1].SequenceId)
+		}
+		Expect(got).To(Equal(append([]uuid.UUID{ownedSystemId}, visible...)))
+	})
+
+	It("does not report hidden events as news", func() {
+		seq, err := em.GetCurrentSequenceId(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		addSystem("later", "user-2")
+
+		url := fmt.Sprintf("http://localhost/events/query/%d", seq)
+		Expect(request(handler, url, "user-1", "").StatusCode).To(Equal(http.StatusOK))
+		Expect(request(handler, url, "user-2", "").StatusCode).To(Equal(http.StatusPermanentRedirect))
+	})
+
+	It("stops looking for news at the first visible event", func() {
+		seq, err := em.GetCurrentSequenceId(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		for i := 0; i < 150; i++ {
+			addSystem(fmt.Sprintf("hidden-%d", i), "user-2")
+		}
+		for i := 0; i < 150; i++ {
+			addSystem(fmt.Sprintf("visible-%d", i), "user-1")
+		}
+
+		counting := &countingQueries{EventManager: em}
+		server := oapi.NewApiServer(m, counting, "http://localhost", authz.NewEvaluator(authz.Config{}))
+		h := oapi.HandlerFromMuxWithBaseURL(oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: true}), mux.NewRouter(), "")
+		url := fmt.Sprintf("http://localhost/events/query/%d", seq)
+		Expect(request(h, url, "user-1", "").StatusCode).To(Equal(http.StatusPermanentRedirect))
+		Expect(counting.queries).To(Equal(2), "one page of hidden events, then the first visible one")
+	})
+
+	It("lists subscribers to auditors only", func() {
+		var subs []string
+		decode(request(handler, "http://localhost/events/subscribers", "user-1", ""), &subs)
+		Expect(subs).To(BeEmpty())
+		decode(request(handler, "http://localhost/events/subscribers", "auditor", "audit-group"), &subs)
+		Expect(subs).To(ConsistOf("http://127.0.0.1:1/hook"))
+	})
+
+	It("redacts finding references the caller may not see", func() {
+		var view oapi.FindingView
+		decode(request(handler, fmt.Sprintf("http://localhost/landscape/findings/%s", findingId), "user-1", ""), &view)
+		Expect(view.Resources).To(HaveLen(2))
+		Expect(view.Resources[0].Redacted).To(BeNil())
+		Expect(*view.Resources[0].DisplayName).To(Equal("owned"))
+		Expect(*view.Resources[1].Redacted).To(BeTrue())
+		Expect(view.Resources[1].DisplayName).To(BeNil())
+	})
+
+	It("reports the health of a hidden resource only when a visible finding cites it", func() {
+		var h oapi.ResourceHealth
+		decode(request(handler, fmt.Sprintf("http://localhost/landscape/resources/%s/health", foreignSystemId), "user-1", ""), &h)
+		Expect(*h.Resource.Redacted).To(BeTrue())
+		Expect(h.Findings).To(HaveLen(1))
+
+		resp := request(handler, fmt.Sprintf("http://localhost/landscape/resources/%s/health", hiddenSystemId), "user-1", "")
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
+
+// countingQueries counts the event history queries.
+type countingQueries struct {
+	events.EventManager
+	queries int
+}
+
+func (c *countingQueries) QueryEvents(ctx context.Context, q events.EventQuery) ([]events.StoredEvent, error) {
+	c.queries++
+	return c.EventManager.QueryEvents(ctx, q)
+}
@@ -0,0 +1,90 @@
+//nolint:errcheck
+package oapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("authorization explain endpoint", func() {
+	var (
+		handler  http.Handler
+		systemId uuid.UUID
+	)
+
+	BeforeEach(func() {
+		m, err := model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		s := system.NewSystem(uuid.New())
+		s.SetDisplayName("payments")
+		s.GetAnnotations().Add(authz.OwnerGroupsKey, "team-a")
+		Expect(m.AddSystem(s)).To(Succeed())
+		systemId = s.GetSystemId()
+
+		em, err := eventmgr.NewEventManager()
+		Expect(err).NotTo(HaveOccurred())
+		eval := authz.NewEvaluator(authz.Config{AuditorGroup: "audit-group"})
+		server := oapi.NewApiServer(m, em, "http://localhost", eval)
+		handler = oapi.HandlerFromMuxWithBaseURL(oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: true}), mux.NewRouter(), "")
+	})
+
+	explain := func(query, groups string) *http.Response {
+		req := httptest.NewRequest("GET", "http://localhost/authz/explain?"+query, nil)
+		req.Header.Set("X-Auth-Subject", "caller")
+		req.Header.Set("X-Auth-Groups", groups)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	decision := func(resp *http.Response) oapi.AuthzDecision {
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		var d oapi.AuthzDecision
+		Expect(json.Unmarshal(body, &d)).To(Succeed())
+		return d
+	}
+
+	It("is forbidden for non-auditors", func() {
+		resp := explain(fmt.Sprintf("resourceId=%s", systemId), "team-a")
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	})
+
+	It("explains the decision for an explicit principal", func() {
+		d := decision(explain(fmt.Sprintf("resourceId=%s&subject=bob&groups=team-a,team-b", systemId), "audit-group"))
+		Expect(d.Subject).To(Equal("bob"))
+		Expect(d.Groups).To(Equal([]string{"team-a", "team-b"}))
+		Expect(d.Visible).To(BeTrue())
+		Expect(*d.DecidedBy).To(Equal("ownership"))
+		Expect(*d.Resource.DisplayName).To(Equal("payments"))
+		Expect(d.Annotations).To(ConsistOf(oapi.Annotation{Key: authz.OwnerGroupsKey, Value: "team-a"}))
+		Expect(d.Steps).To(HaveLen(3))
+		Expect(d.Steps[1]).To(Equal(oapi.AuthzStep{Check: "auditor", Granted: false, Detail: "not an auditor"}))
+	})
+
+	It("explains the caller's own access by default", func() {
+		d := decision(explain(fmt.Sprintf("resourceId=%s", systemId), "audit-group"))
+		Expect(d.Subject).To(Equal("caller"))
+		Expect(*d.DecidedBy).To(Equal("auditor"))
+	})
+
+	It("returns 404 for unknown resources and 400 for groups without subject", func() {
+		Expect(explain(fmt.Sprintf("resourceId=%s", uuid.New()), "audit-group").StatusCode).To(Equal(http.StatusNotFound))
+		Expect(explain(fmt.Sprintf("resourceId=%s&groups=x", systemId), "audit-group").StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,115 @@
+//nolint:errcheck
+package oapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("annotation redaction", func() {
+	var (
+		handler  http.Handler
+		history  http.HandlerFunc
+		systemId uuid.UUID
+	)
+
+	BeforeEach(func() {
+		em, err := eventmgr.NewEventManager()
+		Expect(err).NotTo(HaveOccurred())
+		sink, err := em.GetSink()
+		Expect(err).NotTo(HaveOccurred())
+		m, err := model.NewModel(sink)
+		Expect(err).NotTo(HaveOccurred())
+
+		s := system.NewSystem(uuid.New())
+		s.SetDisplayName("payments")
+		s.GetAnnotations().Add(authz.OwnerIdentitiesKey, "alice")
+		s.GetAnnotations().Add("contact.email", "oncall@internal.example")
+		s.GetAnnotations().Add("tier", "gold")
+		Expect(m.AddSystem(s)).To(Succeed())
+		systemId = s.GetSystemId()
+
+		rules, err := authz.ParseRedactionRules("contact.=System")
+		Expect(err).NotTo(HaveOccurred())
+		eval := authz.NewEvaluator(authz.Config{
+			PublicTypes: authz.ParsePublicResourceTypes("System"),
+			Redactions:  rules,
+		})
+		server := oapi.NewApiServer(m, em, "http://localhost", eval)
+		opts := oapi.ApiHandlerOptions{TrustAuthHeaders: true}
+		handler = oapi.HandlerFromMuxWithBaseURL(oapi.NewApiHandler(server, opts), mux.NewRouter(), "")
+		history = oapi.WithAuthentication(server.HandleGetEventsHistory, opts)
+	})
+
+	body := func(h http.Handler, url, subject string) string {
+		req := httptest.NewRequest("GET", url, nil)
+		req.Header.Set("X-Auth-Subject", subject)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		b, err := io.ReadAll(w.Result().Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(b)
+	}
+
+	annotationKeys := func(subject string) []string {
+		var sys oapi.System
+		Expect(json.Unmarshal([]byte(body(handler, fmt.Sprintf("http://localhost/landscape/systems/%s", systemId), subject)), &sys)).To(Succeed())
+		var keys []string
+		for _, a := range *sys.Annotations {
+			keys = append(keys, a.Key)
+		}
+		return keys
+	}
+
+	It("withholds redacted annotations of a public resource from non-owners", func() {
+		Expect(annotationKeys("bob")).To(ConsistOf(authz.OwnerIdentitiesKey, "tier"))
+	})
+
+	It("shows all annotations to the owner", func() {
+		Expect(annotationKeys("alice")).To(ContainElement("contact.email"))
+	})
+
+	historyAnnotations := func(subject string) map[string]string {
+		var stored []struct {
+			ResourceType string        `json:"resourceType"`
+			Objects      []oapi.System `json:"objects"`
+		}
+		Expect(json.Unmarshal([]byte(body(history, "http://localhost/api/events/history?includePayload=true&resourceType=System", subject)), &stored)).To(Succeed())
+		Expect(stored).NotTo(BeEmpty())
+		last := stored[len(stored)-1]
+		Expect(last.Objects).To(HaveLen(1))
+		Expect(last.Objects[0].DisplayName).To(Equal("payments"))
+		out := map[string]string{}
+		if last.Objects[0].Annotations != nil {
+			for _, a := range *last.Objects[0].Annotations {
+				out[a.Key] = a.Value
+			}
+		}
+		return out
+	}
+
+	It("does not leak redacted values through event history payloads", func() {
+		Expect(historyAnnotations("bob")).To(Equal(map[string]string{
+			authz.OwnerIdentitiesKey: "alice",
+			"tier":                   "gold",
+		}))
+	})
+
+	It("shows all annotations in event history payloads to the owner", func() {
+		Expect(historyAnnotations("alice")).To(HaveKeyWithValue("contact.email", "oncall@internal.example"))
+	})
+})
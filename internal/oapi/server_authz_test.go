@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -18,6 +19,7 @@ import (
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/model"
 	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/finding"
 	"go.emeland.io/modelsrv/pkg/model/system"
 )
 
@@ -30,6 +32,7 @@ var _ = Describe("ownership visibility on the API", func() {
 		foreignSystemId = uuid.New()
 		unownedSystemId = uuid.New()
 		contextTypeId2  = uuid.New()
+		ownedFindingId  = uuid.New()
 	)
 
 	BeforeEach(func() {
@@ -54,6 +57,10 @@ var _ = Describe("ownership visibility on the API", func() {
 		addSystem(foreignSystemId, "owned by someone else", "user-2", "team-b")
 		addSystem(unownedSystemId, "unowned", "", "")
 
+		f := finding.NewFinding(ownedFindingId)
+		f.GetAnnotations().Add(authz.OwnerIdentitiesKey, "user-1")
+		Expect(m.AddFinding(f)).To(Succeed())
+
 		ct := mdlctx.NewContextType(contextTypeId2)
 		ct.SetDisplayName("public context type")
 		Expect(m.AddContextType(ct)).To(Succeed())
@@ -156,4 +163,18 @@ var _ = Describe("ownership visibility on the API", func() {
 		ids := listSystemIds("", "")
 		Expect(ids).To(BeEmpty())
 	})
+
+	It("refuses finding triage to an owner without a write Binding", func() {
+		post := func(subject string) int {
+			url := fmt.Sprintf("http://localhost/landscape/findings/%s/status", ownedFindingId)
+			req := httptest.NewRequest("POST", url, strings.NewReader(`{"status":"acknowledged"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Auth-Subject", subject)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Result().StatusCode
+		}
+		Expect(post("user-1")).To(Equal(http.StatusForbidden), "ownership grants read only")
+		Expect(post("user-3")).To(Equal(http.StatusNotFound), "hidden findings stay hidden")
+	})
 })
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
 )
 
 // GetEventsQuerySequenceId implements StrictServerInterface.
@@ -22,6 +26,17 @@ func (a *ApiServer) GetEventsQuerySequenceId(ctx context.Context, request GetEve
 		return GetEventsQuerySequenceId200Response{}, nil
 	}
 	if requestSequenceId < currSequenceId {
+		// Events the caller may not see do not count as news; the first
+		// visible one settles it.
+		if a.Authz != nil {
+			newer, err := a.visibleEvents(ctx, events.EventQuery{SinceSeq: requestSequenceId, Limit: 1})
+			if err != nil {
+				return nil, err
+			}
+			if len(newer) == 0 {
+				return GetEventsQuerySequenceId200Response{}, nil
+			}
+		}
 		return GetEventsQuerySequenceId308Response{}, nil
 	}
 	return GetEventsQuerySequenceId404JSONResponse(""), nil
@@ -29,6 +44,10 @@ func (a *ApiServer) GetEventsQuerySequenceId(ctx context.Context, request GetEve
 
 // PostEventsRegister implements StrictServerInterface.
 func (a *ApiServer) PostEventsRegister(ctx context.Context, request PostEventsRegisterRequestObject) (PostEventsRegisterResponseObject, error) {
+	audit.Update(ctx, func(e *audit.Entry) { e.Subscriber = request.Body.CallbackUrl })
+	if !authz.PrincipalFromCtx(ctx).Scope.AllowsAction(authz.ActionPush) {
+		return PostEventsRegister403JSONResponse(ErrorString("api key may not register subscribers")), nil
+	}
 	if err := a.Events.AddSubscriber(request.Body.CallbackUrl); err != nil {
 		return nil, err
 	}
@@ -37,6 +56,7 @@ func (a *ApiServer) PostEventsRegister(ctx context.Context, request PostEventsRe
 
 // PostEventsUnregister implements StrictServerInterface.
 func (a *ApiServer) PostEventsUnregister(ctx context.Context, request PostEventsUnregisterRequestObject) (PostEventsUnregisterResponseObject, error) {
+	audit.Update(ctx, func(e *audit.Entry) { e.Subscriber = request.Body.CallbackUrl })
 	if err := a.Events.RemoveSubscriber(request.Body.CallbackUrl); err != nil {
 		return PostEventsUnregister404JSONResponse(err.Error()), nil
 	}
@@ -45,8 +65,12 @@ func (a *ApiServer) PostEventsUnregister(ctx context.Context, request PostEvents
 
 // GetEventsSubscribers implements StrictServerInterface.
 func (a *ApiServer) GetEventsSubscribers(ctx context.Context, request GetEventsSubscribersRequestObject) (GetEventsSubscribersResponseObject, error) {
-	_ = ctx
 	_ = request
+	// Callback URLs reveal other consumers of the landscape; only auditors
+	// see them once visibility is enforced.
+	if a.Authz != nil && !a.Authz.IsAuditor(authz.PrincipalFromCtx(ctx)) {
+		return GetEventsSubscribers200JSONResponse([]string{}), nil
+	}
 	subs := a.Events.GetSubscribers()
 	out := make([]string, 0, len(subs))
 	for _, s := range subs {
@@ -58,7 +82,6 @@ func (a *ApiServer) GetEventsSubscribers(ctx context.Context, request GetEventsS
 // PostEventsPush receives replicated events from an upstream server and applies them to the local model.
 // The recording sink forwards applied changes to any registered downstream subscribers.
 func (a *ApiServer) PostEventsPush(ctx context.Context, request PostEventsPushRequestObject) (PostEventsPushResponseObject, error) {
-	_ = ctx
 	if request.Body == nil {
 		return nil, fmt.Errorf("missing event body")
 	}
@@ -66,6 +89,19 @@ func (a *ApiServer) PostEventsPush(ctx context.Context, request PostEventsPushRe
 	if err != nil {
 		return nil, fmt.Errorf("replication decode: %w", err)
 	}
+	audit.Update(ctx, func(e *audit.Entry) {
+		e.ResourceType = ev.ResourceType.WireKind()
+		e.ResourceID = ev.ResourceId
+	})
+	p := authz.PrincipalFromCtx(ctx)
+	if !p.Scope.Allows(authz.ActionPush, ev.ResourceType) {
+		msg := fmt.Sprintf("api key may not push %s events", ev.ResourceType.WireKind())
+		return PostEventsPush403JSONResponse(ErrorString(msg)), nil
+	}
+	if a.Authz != nil && !a.canApply(p, ev) {
+		msg := fmt.Sprintf("not allowed to write %s %s", ev.ResourceType.WireKind(), ev.ResourceId)
+		return PostEventsPush403JSONResponse(ErrorString(msg)), nil
+	}
 	if err := a.Backend.Apply(ev); err != nil {
 		return nil, fmt.Errorf("replication apply: %w", err)
 	}
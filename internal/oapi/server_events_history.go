@@ -1,11 +1,13 @@
 package oapi
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
 
 	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/authz"
 	"go.emeland.io/modelsrv/pkg/events"
 )
 
@@ -62,7 +64,7 @@ func (a *ApiServer) HandleGetEventsHistory(w http.ResponseWriter, r *http.Reques
 		q.IncludePayload = true
 	}
 
-	results, err := a.Events.QueryEvents(r.Context(), q)
+	results, err := a.visibleEvents(r.Context(), q)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -70,7 +72,33 @@ func (a *ApiServer) HandleGetEventsHistory(w http.ResponseWriter, r *http.Reques
 	if results == nil {
 		results = []events.StoredEvent{}
 	}
+	a.encodePayloads(r.Context(), results)
 
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(results)
 }
+
+// encodePayloads replaces the domain objects carried by results with their
+// OpenAPI wire form, leaving out annotations the caller may not read. Objects
+// that are not resources are kept as they are. The stored Objects slices are
+// shared with the event history, so each is replaced rather than modified.
+func (a *ApiServer) encodePayloads(ctx context.Context, results []events.StoredEvent) {
+	for i := range results {
+		if len(results[i].Objects) == 0 {
+			continue
+		}
+		rt := events.ParseWireKind(results[i].ResourceType)
+		objects := make([]any, len(results[i].Objects))
+		for j, obj := range results[i].Objects {
+			objects[j] = obj
+			var keep AnnotationFilter
+			if o, ok := obj.(authz.Ownable); ok {
+				keep = a.annotationFilter(ctx, rt, o)
+			}
+			if m, err := encodeReplicationResourceToWireMap(rt, obj, keep); err == nil {
+				objects[j] = m
+			}
+		}
+		results[i].Objects = objects
+	}
+}
@@ -0,0 +1,182 @@
+package oapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+)
+
+// GetAuthzApiKeys implements [StrictServerInterface].
+func (a *ApiServer) GetAuthzApiKeys(ctx context.Context, request GetAuthzApiKeysRequestObject) (GetAuthzApiKeysResponseObject, error) {
+	_ = request
+	if a.APIKeys == nil {
+		return GetAuthzApiKeys404JSONResponse(ErrorString("api keys are not enabled")), nil
+	}
+	p := authz.PrincipalFromCtx(ctx)
+	all := a.Authz == nil || a.Authz.IsAuditor(p)
+	out := make([]ApiKey, 0)
+	for _, k := range a.APIKeys.List() {
+		if all || (p.Scope == nil && a.mayManageKeysOf(p, k)) {
+			out = append(out, apiKeyToDto(k))
+		}
+	}
+	return GetAuthzApiKeys200JSONResponse(out), nil
+}
+
+// PostAuthzApiKeys implements [StrictServerInterface].
+func (a *ApiServer) PostAuthzApiKeys(ctx context.Context, request PostAuthzApiKeysRequestObject) (PostAuthzApiKeysResponseObject, error) {
+	if a.APIKeys == nil {
+		return PostAuthzApiKeys404JSONResponse(ErrorString("api keys are not enabled")), nil
+	}
+	if request.Body == nil {
+		return PostAuthzApiKeys400JSONResponse(ErrorString("missing request body")), nil
+	}
+	body := request.Body
+	p := authz.PrincipalFromCtx(ctx)
+	audit.Update(ctx, func(e *audit.Entry) {
+		e.ResourceType = events.IdentityResource.WireKind()
+		e.ResourceID = body.IdentityId
+	})
+	if p.Scope != nil {
+		return PostAuthzApiKeys403JSONResponse(ErrorString("api keys cannot manage api keys")), nil
+	}
+
+	spec := authz.APIKeySpec{IdentityID: body.IdentityId}
+	if body.Name != nil {
+		spec.Name = *body.Name
+	}
+	if body.ExpiresAt != nil {
+		spec.ExpiresAt = *body.ExpiresAt
+	}
+	if body.Actions != nil {
+		for _, action := range *body.Actions {
+			spec.Actions = append(spec.Actions, authz.Action(action))
+		}
+	}
+	if body.ResourceTypes != nil {
+		for _, name := range *body.ResourceTypes {
+			rt := parseResourceTypeName(name)
+			if rt == events.UnknownResourceType {
+				return PostAuthzApiKeys400JSONResponse(ErrorString(fmt.Sprintf("unknown resource type %q", name))), nil
+			}
+			spec.ResourceTypes = append(spec.ResourceTypes, rt)
+		}
+	}
+
+	identity := a.Backend.GetIdentityById(body.IdentityId)
+	if identity == nil {
+		msg := fmt.Sprintf("identity %s not found", body.IdentityId.String())
+		return PostAuthzApiKeys404JSONResponse(ErrorString(msg)), nil
+	}
+	if !a.mayManageKeysOfIdentity(p, identity) {
+		return PostAuthzApiKeys403JSONResponse(ErrorString("not allowed to manage api keys of this identity")), nil
+	}
+
+	key, token, err := a.APIKeys.Create(spec)
+	switch {
+	case errors.Is(err, authz.ErrUnknownIdentity):
+		return PostAuthzApiKeys404JSONResponse(ErrorString(err.Error())), nil
+	case err != nil:
+		return PostAuthzApiKeys400JSONResponse(ErrorString(err.Error())), nil
+	}
+	return PostAuthzApiKeys201JSONResponse(ApiKeyCreated{Key: apiKeyToDto(key), Token: token}), nil
+}
+
+// DeleteAuthzApiKeysKeyId implements [StrictServerInterface].
+func (a *ApiServer) DeleteAuthzApiKeysKeyId(ctx context.Context, request DeleteAuthzApiKeysKeyIdRequestObject) (DeleteAuthzApiKeysKeyIdResponseObject, error) {
+	if a.APIKeys == nil {
+		return DeleteAuthzApiKeysKeyId404JSONResponse(ErrorString("api keys are not enabled")), nil
+	}
+	p := authz.PrincipalFromCtx(ctx)
+	k, ok := a.APIKeys.Get(request.KeyId)
+	if !ok {
+		msg := fmt.Sprintf("api key %s not found", request.KeyId)
+		return DeleteAuthzApiKeysKeyId404JSONResponse(ErrorString(msg)), nil
+	}
+	audit.Update(ctx, func(e *audit.Entry) {
+		e.ResourceType = events.IdentityResource.WireKind()
+		e.ResourceID = k.IdentityID
+	})
+	if p.Scope != nil {
+		return DeleteAuthzApiKeysKeyId403JSONResponse(ErrorString("api keys cannot manage api keys")), nil
+	}
+	if !a.mayManageKeysOf(p, k) {
+		return DeleteAuthzApiKeysKeyId403JSONResponse(ErrorString("not allowed to manage api keys of this identity")), nil
+	}
+	if err := a.APIKeys.Revoke(k.ID); err != nil {
+		if errors.Is(err, authz.ErrAPIKeyNotFound) {
+			return DeleteAuthzApiKeysKeyId404JSONResponse(ErrorString(err.Error())), nil
+		}
+		return nil, err
+	}
+	return DeleteAuthzApiKeysKeyId204Response{}, nil
+}
+
+// mayManageKeysOf reports whether p may create or revoke keys of the
+// Identity k is bound to. Keys of Identities no longer in the model can only
+// be managed while visibility is not enforced, or revoked by auditors.
+func (a *ApiServer) mayManageKeysOf(p authz.Principal, k authz.APIKey) bool {
+	if a.Authz == nil {
+		return true
+	}
+	identity := a.Backend.GetIdentityById(k.IdentityID)
+	if identity == nil {
+		return a.Authz.IsAuditor(p)
+	}
+	return a.mayManageKeysOfIdentity(p, identity)
+}
+
+// mayManageKeysOfIdentity reports whether p may create or revoke keys of
+// identity: p needs write access to it, and only auditors manage the keys of
+// an Identity standing for an auditor.
+func (a *ApiServer) mayManageKeysOfIdentity(p authz.Principal, identity iam.Identity) bool {
+	if a.Authz == nil {
+		return true
+	}
+	if a.Authz.IsAuditor(p) {
+		return true
+	}
+	if slices.ContainsFunc(authz.PrincipalValues(identity.GetAnnotations()), func(v string) bool {
+		return a.Authz.IsAuditor(authz.Principal{Subject: v})
+	}) {
+		return false
+	}
+	return a.Authz.CanWrite(p, events.IdentityResource, identity)
+}
+
+func apiKeyToDto(k authz.APIKey) ApiKey {
+	out := ApiKey{
+		KeyId:         k.ID,
+		IdentityId:    k.IdentityID,
+		ResourceTypes: append([]string{}, k.ResourceTypes...),
+		Actions:       make([]string, 0, len(k.Actions)),
+		CreatedAt:     k.CreatedAt,
+	}
+	if k.Name != "" {
+		name := k.Name
+		out.Name = &name
+	}
+	if !k.ExpiresAt.IsZero() {
+		expires := k.ExpiresAt
+		out.ExpiresAt = &expires
+	}
+	for _, action := range k.Actions {
+		out.Actions = append(out.Actions, string(action))
+	}
+	return out
+}
+
+// parseResourceTypeName accepts both type names ("APIInstance") and wire
+// kinds ("ApiInstance").
+func parseResourceTypeName(name string) events.ResourceType {
+	if rt := events.ParseResourceType(name); rt != events.UnknownResourceType {
+		return rt
+	}
+	return events.ParseWireKind(name)
+}
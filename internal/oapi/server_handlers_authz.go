@@ -0,0 +1,68 @@
+package oapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/model"
+)
+
+// GetAuthzExplain implements [StrictServerInterface].
+func (a *ApiServer) GetAuthzExplain(ctx context.Context, request GetAuthzExplainRequestObject) (GetAuthzExplainResponseObject, error) {
+	if a.Authz == nil {
+		return GetAuthzExplain404JSONResponse(ErrorString("visibility is not enforced")), nil
+	}
+	caller := authz.PrincipalFromCtx(ctx)
+	if !a.Authz.IsAuditor(caller) {
+		return GetAuthzExplain403JSONResponse(ErrorString("only auditors may explain authorization decisions")), nil
+	}
+
+	p := caller
+	params := request.Params
+	if params.Subject != nil {
+		p = authz.Principal{Subject: *params.Subject}
+		if params.Groups != nil {
+			p.Groups = authz.ParseGroups(*params.Groups)
+		}
+	} else if params.Groups != nil {
+		return GetAuthzExplain400JSONResponse(ErrorString("groups requires subject")), nil
+	}
+
+	ref := model.FindResource(a.Backend, params.ResourceId)
+	obj, ok := model.ResourceObject(a.Backend, ref).(authz.Ownable)
+	if ref == nil || !ok {
+		msg := fmt.Sprintf("resource %s not found", params.ResourceId.String())
+		return GetAuthzExplain404JSONResponse(ErrorString(msg)), nil
+	}
+
+	return GetAuthzExplain200JSONResponse(decisionToDto(a.Authz.Explain(p, ref.ResourceType, obj), resourceViewFromRef(a.Backend, ref, nil))), nil
+}
+
+func decisionToDto(d authz.Decision, resource ResourceView) AuthzDecision {
+	out := AuthzDecision{
+		Resource:    resource,
+		Subject:     d.Principal.Subject,
+		Groups:      append([]string{}, d.Principal.Groups...),
+		Visible:     d.Visible,
+		Annotations: make([]Annotation, 0, len(d.Annotations)),
+		Steps:       make([]AuthzStep, 0, len(d.Steps)),
+	}
+	if d.DecidedBy != "" {
+		by := d.DecidedBy
+		out.DecidedBy = &by
+	}
+	keys := make([]string, 0, len(d.Annotations))
+	for k := range d.Annotations {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		out.Annotations = append(out.Annotations, Annotation{Key: k, Value: d.Annotations[k]})
+	}
+	for _, s := range d.Steps {
+		out.Steps = append(out.Steps, AuthzStep{Check: s.Check, Granted: s.Granted, Detail: s.Detail})
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package oapi
+
+import (
+	"context"
+	"fmt"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+// PutLandscapeFilterRulesRuleIdEnabled implements [StrictServerInterface].
+//
+// The handler only flips the rule's disabled flag; the filter chain observes
+// the resulting FilterRule update and skips or resumes the filter.
+func (a *ApiServer) PutLandscapeFilterRulesRuleIdEnabled(ctx context.Context, request PutLandscapeFilterRulesRuleIdEnabledRequestObject) (PutLandscapeFilterRulesRuleIdEnabledResponseObject, error) {
+	item := a.Backend.GetFilterRuleById(request.RuleId)
+	if item == nil {
+		msg := fmt.Sprintf("filter rule %s not found", request.RuleId.String())
+		return PutLandscapeFilterRulesRuleIdEnabled404JSONResponse(ErrorString(msg)), nil
+	}
+	if a.Authz != nil && !a.Authz.CanWrite(authz.PrincipalFromCtx(ctx), events.FilterRuleResource, authz.AsOwnable(item, request.RuleId)) {
+		msg := fmt.Sprintf("not allowed to change filter rule %s", request.RuleId.String())
+		return PutLandscapeFilterRulesRuleIdEnabled403JSONResponse(ErrorString(msg)), nil
+	}
+	if request.Body == nil {
+		return PutLandscapeFilterRulesRuleIdEnabled400JSONResponse(ErrorString("missing request body")), nil
+	}
+	if disabled := !request.Body.Enabled; item.GetDisabled() != disabled {
+		item.SetDisabled(disabled)
+	}
+	return PutLandscapeFilterRulesRuleIdEnabled200JSONResponse(FilterRuleToDto(item)), nil
+}
@@ -3,9 +3,13 @@ package oapi
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.emeland.io/modelsrv/pkg/authz"
 	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/findingsummary"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
 )
 
 // GetLandscapeFindings implements [StrictServerInterface].
@@ -18,13 +22,146 @@ func (a *ApiServer) GetLandscapeFindings(ctx context.Context, request GetLandsca
 		principal := authz.PrincipalFromCtx(ctx)
 		items = authz.FilterVisible(a.Authz, principal, events.FindingResource, items)
 	}
+	now := time.Now()
+	visible := a.refVisibility(ctx)
 	out := make([]FindingView, 0, len(items))
 	for _, item := range items {
-		out = append(out, findingToView(a.Backend, a.BaseURL, item))
+		if !a.findingMatches(item, request.Params.Status, request.Params.Severity, now) {
+			continue
+		}
+		out = append(out, findingToView(a.Backend, a.BaseURL, item, visible, a.annotationFilter(ctx, events.FindingResource, item)))
 	}
 	return GetLandscapeFindings200JSONResponse(out), nil
 }
 
+// GetLandscapeFindingsSummary implements [StrictServerInterface].
+func (a *ApiServer) GetLandscapeFindingsSummary(ctx context.Context, request GetLandscapeFindingsSummaryRequestObject) (GetLandscapeFindingsSummaryResponseObject, error) {
+	items, err := a.Backend.GetFindings()
+	if err != nil {
+		return nil, err
+	}
+	if a.Authz != nil {
+		principal := authz.PrincipalFromCtx(ctx)
+		items = authz.FilterVisible(a.Authz, principal, events.FindingResource, items)
+	}
+	now := time.Now()
+	selected := make([]finding.Finding, 0, len(items))
+	for _, item := range items {
+		if a.findingMatches(item, request.Params.Status, request.Params.Severity, now) {
+			selected = append(selected, item)
+		}
+	}
+	summary := findingSummaryToDto(findingsummary.Summarize(a.Backend, selected, now))
+	if visible := a.refVisibility(ctx); visible != nil {
+		redactBucketNames(summary.ByContext, events.ContextResource, visible)
+		redactBucketNames(summary.ByOwnerGroup, events.GroupResource, visible)
+	}
+	return GetLandscapeFindingsSummary200JSONResponse(summary), nil
+}
+
+// GetLandscapeResourcesResourceIdHealth implements [StrictServerInterface].
+func (a *ApiServer) GetLandscapeResourcesResourceIdHealth(ctx context.Context, request GetLandscapeResourcesResourceIdHealthRequestObject) (GetLandscapeResourcesResourceIdHealthResponseObject, error) {
+	items := a.Backend.GetFindingsReferencingResource(request.ResourceId)
+	if a.Authz != nil {
+		principal := authz.PrincipalFromCtx(ctx)
+		items = authz.FilterVisible(a.Authz, principal, events.FindingResource, items)
+	}
+	now := time.Now()
+	h := findingsummary.HealthOf(a.Backend, request.ResourceId, items, now)
+	visible := a.refVisibility(ctx)
+	var ref *common.ResourceRef
+	if h != nil {
+		ref = &common.ResourceRef{ResourceId: h.ResourceId, ResourceType: h.ResourceType}
+	}
+	// A hidden resource is only reported when a visible finding cites it
+	// anyway; otherwise its existence must not leak.
+	if h == nil || (visible != nil && !visible(ref) && len(h.Findings) == 0) {
+		msg := fmt.Sprintf("resource %s not found", request.ResourceId.String())
+		return GetLandscapeResourcesResourceIdHealth404JSONResponse(ErrorString(msg)), nil
+	}
+	out := ResourceHealth{
+		Resource:       resourceViewFromRef(a.Backend, ref, visible),
+		Healthy:        h.Healthy,
+		ActiveFindings: h.Active,
+		Findings:       make([]FindingView, 0, len(h.Findings)),
+	}
+	if h.WorstSeverity != "" {
+		sev := FindingSeverity(h.WorstSeverity)
+		out.WorstSeverity = &sev
+	}
+	for _, f := range h.Findings {
+		out.Findings = append(out.Findings, findingToView(a.Backend, a.BaseURL, f, visible, a.annotationFilter(ctx, events.FindingResource, f)))
+	}
+	return GetLandscapeResourcesResourceIdHealth200JSONResponse(out), nil
+}
+
+// findingMatches applies the optional status and minimum severity query
+// parameters shared by the finding list and summary endpoints.
+func (a *ApiServer) findingMatches(f finding.Finding, status *FindingStatus, severity *FindingSeverity, now time.Time) bool {
+	if status != nil && finding.StatusOf(f, now) != finding.Status(*status) {
+		return false
+	}
+	return severity == nil || a.findingSeverity(f).Rank() >= finding.Severity(*severity).Rank()
+}
+
+// findingSeverity returns the severity of the finding's FindingType, or "" when
+// the type is unknown.
+func (a *ApiServer) findingSeverity(f finding.Finding) finding.Severity {
+	ft := a.Backend.GetFindingTypeById(f.GetFindingTypeId())
+	if ft == nil {
+		return ""
+	}
+	return ft.GetSeverity()
+}
+
+// PostLandscapeFindingsFindingIdStatus implements [StrictServerInterface].
+func (a *ApiServer) PostLandscapeFindingsFindingIdStatus(ctx context.Context, request PostLandscapeFindingsFindingIdStatusRequestObject) (PostLandscapeFindingsFindingIdStatusResponseObject, error) {
+	item := a.Backend.GetFindingById(request.FindingId)
+	if item == nil || (a.Authz != nil && !a.Authz.CanSee(authz.PrincipalFromCtx(ctx), events.FindingResource, item)) {
+		msg := fmt.Sprintf("finding %s not found", request.FindingId.String())
+		return PostLandscapeFindingsFindingIdStatus404JSONResponse(ErrorString(msg)), nil
+	}
+	if a.Authz != nil && !a.Authz.CanWrite(authz.PrincipalFromCtx(ctx), events.FindingResource, item) {
+		msg := fmt.Sprintf("not allowed to change finding %s", request.FindingId.String())
+		return PostLandscapeFindingsFindingIdStatus403JSONResponse(ErrorString(msg)), nil
+	}
+	if request.Body == nil {
+		return PostLandscapeFindingsFindingIdStatus400JSONResponse(ErrorString("missing request body")), nil
+	}
+	status, err := finding.ParseStatus(string(request.Body.Status))
+	if err != nil {
+		return PostLandscapeFindingsFindingIdStatus400JSONResponse(ErrorString(err.Error())), nil
+	}
+	now := time.Now().UTC()
+	if until := request.Body.SuppressedUntil; until != nil {
+		if status != finding.StatusSuppressed {
+			return PostLandscapeFindingsFindingIdStatus400JSONResponse(ErrorString("suppressedUntil requires status suppressed")), nil
+		}
+		if !until.After(now) {
+			return PostLandscapeFindingsFindingIdStatus400JSONResponse(ErrorString("suppressedUntil must lie in the future")), nil
+		}
+	}
+
+	t := &finding.Triage{
+		Status:          status,
+		ChangedAt:       now,
+		SuppressedUntil: request.Body.SuppressedUntil,
+	}
+	if request.Body.Reason != nil {
+		t.Reason = *request.Body.Reason
+	}
+	if request.Body.By != nil {
+		t.By = *request.Body.By
+	}
+	if a.Authz != nil {
+		if subject := authz.PrincipalFromCtx(ctx).Subject; subject != "" {
+			t.By = subject
+		}
+	}
+	item.SetTriage(t)
+	return PostLandscapeFindingsFindingIdStatus200JSONResponse(findingToView(a.Backend, a.BaseURL, item, a.refVisibility(ctx), a.annotationFilter(ctx, events.FindingResource, item))), nil
+}
+
 // GetLandscapeFindingsFindingId implements [StrictServerInterface].
 func (a *ApiServer) GetLandscapeFindingsFindingId(ctx context.Context, request GetLandscapeFindingsFindingIdRequestObject) (GetLandscapeFindingsFindingIdResponseObject, error) {
 	item := a.Backend.GetFindingById(request.FindingId)
@@ -36,7 +173,7 @@ func (a *ApiServer) GetLandscapeFindingsFindingId(ctx context.Context, request G
 		msg := fmt.Sprintf("finding %s not found", request.FindingId.String())
 		return GetLandscapeFindingsFindingId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeFindingsFindingId200JSONResponse(findingToView(a.Backend, a.BaseURL, item)), nil
+	return GetLandscapeFindingsFindingId200JSONResponse(findingToView(a.Backend, a.BaseURL, item, a.refVisibility(ctx), a.annotationFilter(ctx, events.FindingResource, item))), nil
 }
 
 // GetLandscapeFindingTypes implements [StrictServerInterface].
@@ -51,7 +188,7 @@ func (a *ApiServer) GetLandscapeFindingTypes(ctx context.Context, request GetLan
 	}
 	out := make([]FindingType, 0, len(items))
 	for _, item := range items {
-		out = append(out, FindingTypeToDto(item))
+		out = append(out, FindingTypeToDto(item, a.annotationFilter(ctx, events.FindingTypeResource, item)))
 	}
 	return GetLandscapeFindingTypes200JSONResponse(out), nil
 }
@@ -67,5 +204,5 @@ func (a *ApiServer) GetLandscapeFindingTypesFindingTypeId(ctx context.Context, r
 		msg := fmt.Sprintf("finding type %s not found", request.FindingTypeId.String())
 		return GetLandscapeFindingTypesFindingTypeId404JSONResponse(msg), nil
 	}
-	return GetLandscapeFindingTypesFindingTypeId200JSONResponse(FindingTypeToDto(item)), nil
+	return GetLandscapeFindingTypesFindingTypeId200JSONResponse(FindingTypeToDto(item, a.annotationFilter(ctx, events.FindingTypeResource, item))), nil
 }
@@ -34,7 +34,7 @@ func (a *ApiServer) GetLandscapeContextTypesContextTypeId(ctx context.Context, r
 		msg := fmt.Sprintf("context type %s not found", request.ContextTypeId.String())
 		return GetLandscapeContextTypesContextTypeId404JSONResponse(msg), nil
 	}
-	return GetLandscapeContextTypesContextTypeId200JSONResponse(ContextTypeToDto(item)), nil
+	return GetLandscapeContextTypesContextTypeId200JSONResponse(ContextTypeToDto(item, a.annotationFilter(ctx, events.ContextTypeResource, item))), nil
 }
 
 // GetLandscapeContexts implements [StrictServerInterface].
@@ -61,7 +61,7 @@ func (a *ApiServer) GetLandscapeContextsContextId(ctx context.Context, request G
 		msg := fmt.Sprintf("context %s not found", request.ContextId.String())
 		return GetLandscapeContextsContextId404JSONResponse(msg), nil
 	}
-	return GetLandscapeContextsContextId200JSONResponse(ContextToDto(item)), nil
+	return GetLandscapeContextsContextId200JSONResponse(ContextToDto(item, a.annotationFilter(ctx, events.ContextResource, item))), nil
 }
 
 // GetLandscapeSystems implements [StrictServerInterface].
@@ -88,7 +88,7 @@ func (a *ApiServer) GetLandscapeSystemsSystemId(ctx context.Context, request Get
 		msg := fmt.Sprintf("system %s not found", request.SystemId.String())
 		return GetLandscapeSystemsSystemId404JSONResponse(msg), nil
 	}
-	return GetLandscapeSystemsSystemId200JSONResponse(SystemToDto(item)), nil
+	return GetLandscapeSystemsSystemId200JSONResponse(SystemToDto(item, a.annotationFilter(ctx, events.SystemResource, item))), nil
 }
 
 // GetLandscapeNodeTypes implements [StrictServerInterface].
@@ -115,7 +115,7 @@ func (a *ApiServer) GetLandscapeNodeTypesNodeTypeId(ctx context.Context, request
 		msg := fmt.Sprintf("node type %s not found", request.NodeTypeId.String())
 		return GetLandscapeNodeTypesNodeTypeId404JSONResponse(msg), nil
 	}
-	return GetLandscapeNodeTypesNodeTypeId200JSONResponse(NodeTypeToDto(item)), nil
+	return GetLandscapeNodeTypesNodeTypeId200JSONResponse(NodeTypeToDto(item, a.annotationFilter(ctx, events.NodeTypeResource, item))), nil
 }
 
 // GetLandscapeApiInstances implements [StrictServerInterface].
@@ -142,7 +142,7 @@ func (a *ApiServer) GetLandscapeApiInstancesApiInstanceId(ctx context.Context, r
 		msg := fmt.Sprintf("api instance %s not found", request.ApiInstanceId.String())
 		return GetLandscapeApiInstancesApiInstanceId404JSONResponse(msg), nil
 	}
-	return GetLandscapeApiInstancesApiInstanceId200JSONResponse(ApiInstanceToDto(item)), nil
+	return GetLandscapeApiInstancesApiInstanceId200JSONResponse(ApiInstanceToDto(item, a.annotationFilter(ctx, events.APIInstanceResource, item))), nil
 }
 
 // GetLandscapeApis implements [StrictServerInterface].
@@ -169,7 +169,7 @@ func (a *ApiServer) GetLandscapeApisApiId(ctx context.Context, request GetLandsc
 		msg := fmt.Sprintf("api %s not found", request.ApiId.String())
 		return GetLandscapeApisApiId404JSONResponse(msg), nil
 	}
-	return GetLandscapeApisApiId200JSONResponse(APIToDto(item)), nil
+	return GetLandscapeApisApiId200JSONResponse(APIToDto(item, a.annotationFilter(ctx, events.APIResource, item))), nil
 }
 
 // GetLandscapeComponents implements [StrictServerInterface].
@@ -196,7 +196,7 @@ func (a *ApiServer) GetLandscapeComponentsComponentId(ctx context.Context, reque
 		msg := fmt.Sprintf("component %s not found", request.ComponentId.String())
 		return GetLandscapeComponentsComponentId404JSONResponse(msg), nil
 	}
-	return GetLandscapeComponentsComponentId200JSONResponse(ComponentToDto(item)), nil
+	return GetLandscapeComponentsComponentId200JSONResponse(ComponentToDto(item, a.annotationFilter(ctx, events.ComponentResource, item))), nil
 }
 
 // GetLandscapeSystemInstances implements [StrictServerInterface].
@@ -223,7 +223,7 @@ func (a *ApiServer) GetLandscapeSystemInstancesSystemInstanceId(ctx context.Cont
 		msg := fmt.Sprintf("system instance %s not found", request.SystemInstanceId.String())
 		return GetLandscapeSystemInstancesSystemInstanceId404JSONResponse(msg), nil
 	}
-	return GetLandscapeSystemInstancesSystemInstanceId200JSONResponse(SystemInstanceToDto(item)), nil
+	return GetLandscapeSystemInstancesSystemInstanceId200JSONResponse(SystemInstanceToDto(item, a.annotationFilter(ctx, events.SystemInstanceResource, item))), nil
 }
 
 // GetLandscapeComponentInstances implements [StrictServerInterface].
@@ -250,7 +250,7 @@ func (a *ApiServer) GetLandscapeComponentInstancesComponentInstanceId(ctx contex
 		msg := fmt.Sprintf("componentInstance %s not found", request.ComponentInstanceId.String())
 		return GetLandscapeComponentInstancesComponentInstanceId404JSONResponse(msg), nil
 	}
-	return GetLandscapeComponentInstancesComponentInstanceId200JSONResponse(ComponentInstanceToDto(item)), nil
+	return GetLandscapeComponentInstancesComponentInstanceId200JSONResponse(ComponentInstanceToDto(item, a.annotationFilter(ctx, events.ComponentInstanceResource, item))), nil
 }
 
 // GetLandscapeOrgUnits implements [StrictServerInterface].
@@ -277,7 +277,7 @@ func (a *ApiServer) GetLandscapeOrgUnitsOrgUnitId(ctx context.Context, request G
 		msg := fmt.Sprintf("organizational unit %s not found", request.OrgUnitId.String())
 		return GetLandscapeOrgUnitsOrgUnitId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeOrgUnitsOrgUnitId200JSONResponse(OrgUnitToDto(item)), nil
+	return GetLandscapeOrgUnitsOrgUnitId200JSONResponse(OrgUnitToDto(item, a.annotationFilter(ctx, events.OrgUnitResource, item))), nil
 }
 
 // GetLandscapeGroups implements [StrictServerInterface].
@@ -304,7 +304,7 @@ func (a *ApiServer) GetLandscapeGroupsGroupId(ctx context.Context, request GetLa
 		msg := fmt.Sprintf("group %s not found", request.GroupId.String())
 		return GetLandscapeGroupsGroupId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeGroupsGroupId200JSONResponse(GroupToDto(item)), nil
+	return GetLandscapeGroupsGroupId200JSONResponse(GroupToDto(item, a.annotationFilter(ctx, events.GroupResource, item))), nil
 }
 
 // GetLandscapeIdentities implements [StrictServerInterface].
@@ -331,7 +331,7 @@ func (a *ApiServer) GetLandscapeIdentitiesIdentityId(ctx context.Context, reques
 		msg := fmt.Sprintf("identity %s not found", request.IdentityId.String())
 		return GetLandscapeIdentitiesIdentityId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeIdentitiesIdentityId200JSONResponse(IdentityToDto(item)), nil
+	return GetLandscapeIdentitiesIdentityId200JSONResponse(IdentityToDto(item, a.annotationFilter(ctx, events.IdentityResource, item))), nil
 }
 
 // GetLandscapePermissionSpecs implements [StrictServerInterface].
@@ -358,7 +358,7 @@ func (a *ApiServer) GetLandscapePermissionSpecsPermissionSpecId(ctx context.Cont
 		msg := fmt.Sprintf("permission specification %s not found", request.PermissionSpecId.String())
 		return GetLandscapePermissionSpecsPermissionSpecId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapePermissionSpecsPermissionSpecId200JSONResponse(PermissionSpecToDto(item)), nil
+	return GetLandscapePermissionSpecsPermissionSpecId200JSONResponse(PermissionSpecToDto(item, a.annotationFilter(ctx, events.PermissionSpecResource, item))), nil
 }
 
 // GetLandscapeRoleSpecs implements [StrictServerInterface].
@@ -385,7 +385,7 @@ func (a *ApiServer) GetLandscapeRoleSpecsRoleSpecId(ctx context.Context, request
 		msg := fmt.Sprintf("role specification %s not found", request.RoleSpecId.String())
 		return GetLandscapeRoleSpecsRoleSpecId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeRoleSpecsRoleSpecId200JSONResponse(RoleSpecToDto(item)), nil
+	return GetLandscapeRoleSpecsRoleSpecId200JSONResponse(RoleSpecToDto(item, a.annotationFilter(ctx, events.RoleSpecResource, item))), nil
 }
 
 // GetLandscapePermissions implements [StrictServerInterface].
@@ -412,7 +412,7 @@ func (a *ApiServer) GetLandscapePermissionsPermissionId(ctx context.Context, req
 		msg := fmt.Sprintf("permission %s not found", request.PermissionId.String())
 		return GetLandscapePermissionsPermissionId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapePermissionsPermissionId200JSONResponse(PermissionToDto(item)), nil
+	return GetLandscapePermissionsPermissionId200JSONResponse(PermissionToDto(item, a.annotationFilter(ctx, events.PermissionResource, item))), nil
 }
 
 // GetLandscapeRoles implements [StrictServerInterface].
@@ -439,7 +439,7 @@ func (a *ApiServer) GetLandscapeRolesRoleId(ctx context.Context, request GetLand
 		msg := fmt.Sprintf("role %s not found", request.RoleId.String())
 		return GetLandscapeRolesRoleId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeRolesRoleId200JSONResponse(RoleToDto(item)), nil
+	return GetLandscapeRolesRoleId200JSONResponse(RoleToDto(item, a.annotationFilter(ctx, events.RoleResource, item))), nil
 }
 
 // GetLandscapeBindings implements [StrictServerInterface].
@@ -466,7 +466,7 @@ func (a *ApiServer) GetLandscapeBindingsBindingId(ctx context.Context, request G
 		msg := fmt.Sprintf("binding %s not found", request.BindingId.String())
 		return GetLandscapeBindingsBindingId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeBindingsBindingId200JSONResponse(BindingToDto(item)), nil
+	return GetLandscapeBindingsBindingId200JSONResponse(BindingToDto(item, a.annotationFilter(ctx, events.BindingResource, item))), nil
 }
 
 // GetLandscapeArtifacts implements [StrictServerInterface].
@@ -493,7 +493,7 @@ func (a *ApiServer) GetLandscapeArtifactsArtifactId(ctx context.Context, request
 		msg := fmt.Sprintf("artifact %s not found", request.ArtifactId.String())
 		return GetLandscapeArtifactsArtifactId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeArtifactsArtifactId200JSONResponse(ArtifactToDto(item)), nil
+	return GetLandscapeArtifactsArtifactId200JSONResponse(ArtifactToDto(item, a.annotationFilter(ctx, events.ArtifactResource, item))), nil
 }
 
 // GetLandscapeArtifactInstances implements [StrictServerInterface].
@@ -520,7 +520,7 @@ func (a *ApiServer) GetLandscapeArtifactInstancesArtifactInstanceId(ctx context.
 		msg := fmt.Sprintf("artifact instance %s not found", request.ArtifactInstanceId.String())
 		return GetLandscapeArtifactInstancesArtifactInstanceId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeArtifactInstancesArtifactInstanceId200JSONResponse(ArtifactInstanceToDto(item)), nil
+	return GetLandscapeArtifactInstancesArtifactInstanceId200JSONResponse(ArtifactInstanceToDto(item, a.annotationFilter(ctx, events.ArtifactInstanceResource, item))), nil
 }
 
 // GetLandscapeProducts implements [StrictServerInterface].
@@ -547,7 +547,7 @@ func (a *ApiServer) GetLandscapeProductsProductId(ctx context.Context, request G
 		msg := fmt.Sprintf("product %s not found", request.ProductId.String())
 		return GetLandscapeProductsProductId404JSONResponse(ErrorString(msg)), nil
 	}
-	return GetLandscapeProductsProductId200JSONResponse(ProductToDto(item)), nil
+	return GetLandscapeProductsProductId200JSONResponse(ProductToDto(item, a.annotationFilter(ctx, events.ProductResource, item))), nil
 }
 
 // GetLandscapeFilterRules implements [StrictServerInterface].
@@ -612,7 +612,7 @@ func (a *ApiServer) GetLandscapeCapabilitiesCapabilityId(ctx context.Context, re
 		msg := fmt.Sprintf("capability %s not found", request.CapabilityId.String())
 		return GetLandscapeCapabilitiesCapabilityId404JSONResponse(msg), nil
 	}
-	return GetLandscapeCapabilitiesCapabilityId200JSONResponse(CapabilityToDto(item)), nil
+	return GetLandscapeCapabilitiesCapabilityId200JSONResponse(CapabilityToDto(item, a.annotationFilter(ctx, events.CapabilityResource, item))), nil
 }
 
 // GetLandscapeParameters implements [StrictServerInterface].
@@ -639,7 +639,7 @@ func (a *ApiServer) GetLandscapeParametersParameterId(ctx context.Context, reque
 		msg := fmt.Sprintf("parameter %s not found", request.ParameterId.String())
 		return GetLandscapeParametersParameterId404JSONResponse(msg), nil
 	}
-	return GetLandscapeParametersParameterId200JSONResponse(ParameterToDto(item)), nil
+	return GetLandscapeParametersParameterId200JSONResponse(ParameterToDto(item, a.annotationFilter(ctx, events.ParameterResource, item))), nil
 }
 
 // GetLandscapeCapacityResourceTypes implements [StrictServerInterface].
@@ -685,5 +685,5 @@ func (a *ApiServer) GetLandscapeCapacitiesCapacityId(ctx context.Context, reques
 		msg := fmt.Sprintf("capacity %s not found", request.CapacityId.String())
 		return GetLandscapeCapacitiesCapacityId404JSONResponse(msg), nil
 	}
-	return GetLandscapeCapacitiesCapacityId200JSONResponse(CapacityToDto(item)), nil
+	return GetLandscapeCapacitiesCapacityId200JSONResponse(CapacityToDto(item, a.annotationFilter(ctx, events.CapacityResource, item))), nil
 }
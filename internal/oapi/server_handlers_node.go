@@ -20,7 +20,7 @@ func (a *ApiServer) GetLandscapeNodes(ctx context.Context, request GetLandscapeN
 	}
 	out := make([]NodeSummaryView, 0, len(items))
 	for _, item := range items {
-		out = append(out, nodeSummaryToView(a.Backend, a.BaseURL, item))
+		out = append(out, nodeSummaryToView(a.Backend, a.BaseURL, item, a.annotationFilter(ctx, events.NodeResource, item)))
 	}
 	return GetLandscapeNodes200JSONResponse(out), nil
 }
@@ -36,5 +36,5 @@ func (a *ApiServer) GetLandscapeNodesNodeId(ctx context.Context, request GetLand
 		msg := fmt.Sprintf("node %s not found", request.NodeId.String())
 		return GetLandscapeNodesNodeId404JSONResponse(msg), nil
 	}
-	return GetLandscapeNodesNodeId200JSONResponse(nodeToView(a.Backend, a.BaseURL, item)), nil
+	return GetLandscapeNodesNodeId200JSONResponse(nodeToView(a.Backend, a.BaseURL, item, a.annotationFilter(ctx, events.NodeResource, item))), nil
 }
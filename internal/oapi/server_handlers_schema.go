@@ -0,0 +1,26 @@
+package oapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.emeland.io/modelsrv/pkg/ingress/schemas"
+)
+
+// GetSchemasKind implements [StrictServerInterface].
+//
+// The schemas are generated with the ingress field lists and embedded in the
+// binary, so they always match what this server's strict parsing accepts.
+func (a *ApiServer) GetSchemasKind(ctx context.Context, request GetSchemasKindRequestObject) (GetSchemasKindResponseObject, error) {
+	data, ok := schemas.Get(request.Kind)
+	if !ok {
+		msg := fmt.Sprintf("no schema for kind %s", request.Kind)
+		return GetSchemasKind404JSONResponse(ErrorString(msg)), nil
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return GetSchemasKind200JSONResponse(doc), nil
+}
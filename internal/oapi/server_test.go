@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -662,6 +663,73 @@ var _ = Describe("calling the modelsrv API functions", func() {
 		Expect((*finding.Annotations)[0].Value).To(Equal("storage"))
 	})
 
+	It("should call GET on /landscape/findings/summary", func() {
+		req := httptest.NewRequest("GET", "http://localhost/landscape/findings/summary", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		resp := w.Result()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var summary oapi.FindingSummary
+		Expect(json.NewDecoder(resp.Body).Decode(&summary)).To(Succeed())
+		Expect(summary.Total).To(Equal(1))
+		Expect(summary.ByStatus).To(ConsistOf(oapi.FindingSummaryBucket{Key: "open", Count: 1}))
+		Expect(summary.ByResourceType).To(ConsistOf(oapi.FindingSummaryBucket{Key: "API", Count: 1}))
+		Expect(summary.ByFindingType).To(HaveLen(1))
+		Expect(summary.ByFindingType[0].Key).To(Equal(findingTypeId.String()))
+		Expect(*summary.ByFindingType[0].DisplayName).To(Equal("Test Finding Type"))
+
+		req = httptest.NewRequest("GET", "http://localhost/landscape/findings/summary?status=resolved", nil)
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(json.NewDecoder(w.Result().Body).Decode(&summary)).To(Succeed())
+		Expect(summary.Total).To(Equal(0))
+	})
+
+	It("should call GET on /schemas/{kind}", func() {
+		req := httptest.NewRequest("GET", "http://localhost/schemas/apiinstance", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		resp := w.Result()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var schema map[string]any
+		Expect(json.NewDecoder(resp.Body).Decode(&schema)).To(Succeed())
+		Expect(schema).To(HaveKeyWithValue("title", "APIInstance document"))
+
+		req = httptest.NewRequest("GET", "http://localhost/schemas/Nonsense", nil)
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("should call GET on /landscape/resources/{resourceId}/health", func() {
+		url := fmt.Sprintf("http://localhost/landscape/resources/%s/health", componentId.String())
+		req := httptest.NewRequest("GET", url, nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		resp := w.Result()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var health oapi.ResourceHealth
+		Expect(json.NewDecoder(resp.Body).Decode(&health)).To(Succeed())
+		Expect(health.Resource.Id).To(Equal(componentId))
+		Expect(health.Resource.ResourceType).To(Equal("Component"))
+		Expect(health.Healthy).To(BeFalse())
+		Expect(health.ActiveFindings).To(Equal(1))
+		Expect(health.Findings).To(HaveLen(1))
+		Expect(health.Findings[0].FindingId).To(Equal(findingId))
+
+		url = fmt.Sprintf("http://localhost/landscape/resources/%s/health", uuid.New().String())
+		req = httptest.NewRequest("GET", url, nil)
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+	})
+
 	It("should call GET on /landscape/findingTypes", func() {
 		url := "http://localhost/landscape/findingTypes"
 		req := httptest.NewRequest("GET", url, nil)
@@ -707,6 +775,46 @@ var _ = Describe("calling the modelsrv API functions", func() {
 
 	})
 
+	It("should call POST on /landscape/findings/{findingId}/status", func() {
+		DeferCleanup(func() { backend.GetFindingById(findingId).SetTriage(nil) })
+
+		post := func(id, body string) *http.Response {
+			url := fmt.Sprintf("http://localhost/landscape/findings/%s/status", id)
+			req := httptest.NewRequest("POST", url, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Result()
+		}
+		list := func(query string) []oapi.FindingView {
+			req := httptest.NewRequest("GET", "http://localhost/landscape/findings?"+query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			Expect(w.Result().StatusCode).To(Equal(http.StatusOK))
+			var arr []oapi.FindingView
+			Expect(json.NewDecoder(w.Result().Body).Decode(&arr)).To(Succeed())
+			return arr
+		}
+
+		Expect(list("status=open")).To(HaveLen(1))
+
+		resp := post(findingId.String(), `{"status":"acknowledged","reason":"tracked in JIRA-1","by":"alice"}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var view oapi.FindingView
+		Expect(json.NewDecoder(resp.Body).Decode(&view)).To(Succeed())
+		Expect(view.Status).To(Equal(oapi.FindingStatus("acknowledged")))
+		Expect(view.Triage).NotTo(BeNil())
+		Expect(*view.Triage.By).To(Equal("alice"))
+		Expect(*view.Triage.Reason).To(Equal("tracked in JIRA-1"))
+
+		Expect(list("status=open")).To(BeEmpty())
+		Expect(list("status=acknowledged")).To(HaveLen(1))
+
+		Expect(post(findingId.String(), `{"status":"closed"}`).StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(post(findingId.String(), `{"status":"acknowledged","suppressedUntil":"2999-01-01T00:00:00Z"}`).StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(post(uuid.New().String(), `{"status":"resolved"}`).StatusCode).To(Equal(http.StatusNotFound))
+	})
+
 	It("should call GET on /landscape/filter-rules", func() {
 		url := "http://localhost/landscape/filter-rules"
 		req := httptest.NewRequest("GET", url, nil)
@@ -748,6 +856,41 @@ var _ = Describe("calling the modelsrv API functions", func() {
 		Expect(rule.RuleId).To(Equal(filterRuleId))
 	})
 
+	It("should call PUT on /landscape/filter-rules/{ruleId}/enabled", func() {
+		url := fmt.Sprintf("http://localhost/landscape/filter-rules/%s/enabled", filterRuleId.String())
+		req := httptest.NewRequest("PUT", url, strings.NewReader(`{"enabled": false}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		resp := w.Result()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		var rule oapi.FilterRule
+		Expect(json.NewDecoder(resp.Body).Decode(&rule)).To(Succeed())
+		Expect(rule.Disabled).NotTo(BeNil())
+		Expect(*rule.Disabled).To(BeTrue())
+		Expect(backend.GetFilterRuleById(filterRuleId).GetDisabled()).To(BeTrue())
+	})
+
+	It("should return 404 on PUT /landscape/filter-rules/{ruleId}/enabled for an unknown rule", func() {
+		url := fmt.Sprintf("http://localhost/landscape/filter-rules/%s/enabled", uuid.New().String())
+		req := httptest.NewRequest("PUT", url, strings.NewReader(`{"enabled": true}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		Expect(w.Result().StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("should answer PUT /landscape/filter-rules/{ruleId}/enabled without a body with 400", func() {
+		server := oapi.NewApiServer(backend, eventMgr, "http://localhost", nil)
+		resp, err := server.PutLandscapeFilterRulesRuleIdEnabled(ctx, oapi.PutLandscapeFilterRulesRuleIdEnabledRequestObject{RuleId: filterRuleId})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp).To(Equal(oapi.PutLandscapeFilterRulesRuleIdEnabled400JSONResponse("missing request body")))
+	})
+
 	It("should call GET on /landscape/merge-rules", func() {
 		url := "http://localhost/landscape/merge-rules"
 		req := httptest.NewRequest("GET", url, nil)
@@ -10,5 +10,6 @@ type Finding struct {
 	Description *string             `json:"description,omitempty"`
 	Type        *openapi_types.UUID `json:"type,omitempty"`
 	Resources   []ResourceRef       `json:"resources"`
+	Triage      *FindingTriage      `json:"triage,omitempty"`
 	Annotations *[]Annotation       `json:"annotations,omitempty"`
 }
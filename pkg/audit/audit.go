@@ -0,0 +1,144 @@
+// Package audit records who did what through the modelsrv API.
+//
+// Every API call produces one [Entry] carrying the caller's principal, the
+// operation, the addressed resource and the authorization decision. Entries go
+// to an [AuditSink]; [FileSink] writes them as JSON lines with size-based
+// rotation. The audit stream has its own [Level], independent of the request
+// log, so compliance can keep every read without raising the log verbosity.
+//
+// The HTTP middleware creating entries lives in pkg/endpoint. Handlers deeper
+// in the stack enrich the entry of their request through [Update], e.g. with
+// the principal once it is authenticated or the resource of a pushed event.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/authz"
+)
+
+// Decision is the authorization outcome of an audited call.
+type Decision string
+
+const (
+	// DecisionAllowed marks a call that was served.
+	DecisionAllowed Decision = "allowed"
+	// DecisionHidden marks a read of an existing resource the caller may not
+	// see; the API answered 404.
+	DecisionHidden Decision = "hidden"
+	// DecisionDenied marks a call rejected for lack of authentication or
+	// permission.
+	DecisionDenied Decision = "denied"
+	// DecisionFailed marks a call that failed for other reasons, e.g. an
+	// invalid request or a missing resource.
+	DecisionFailed Decision = "failed"
+)
+
+// Operations of an [Entry] besides plain reads and writes.
+const (
+	OperationRead                 = "read"
+	OperationWrite                = "write"
+	OperationEventPush            = "event-push"
+	OperationSubscriberRegister   = "subscriber-register"
+	OperationSubscriberUnregister = "subscriber-unregister"
+)
+
+// Entry is one audited API call.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"requestId"`
+	Tenant    string    `json:"tenant,omitempty"`
+	Subject   string    `json:"subject,omitempty"`
+	Groups    []string  `json:"groups,omitempty"`
+	// APIKey is the id of the API key the caller authenticated with.
+	APIKey       string    `json:"apiKey,omitempty"`
+	Operation    string    `json:"operation"`
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	ResourceType string    `json:"resourceType,omitempty"`
+	ResourceID   uuid.UUID `json:"resourceId,omitzero"`
+	// Subscriber is the callback URL of a subscriber (un)registration.
+	Subscriber string   `json:"subscriber,omitempty"`
+	Decision   Decision `json:"decision"`
+	Status     int      `json:"status"`
+	// Reason explains a refusal the response keeps vague, such as why a
+	// bearer token was rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SetPrincipal attributes the entry to p.
+func (e *Entry) SetPrincipal(p authz.Principal) {
+	e.Subject = p.Subject
+	e.Groups = p.Groups
+	if p.Scope != nil {
+		e.APIKey = p.Scope.KeyID
+	}
+}
+
+// AuditSink receives audit entries. Implementations must be safe for
+// concurrent use.
+type AuditSink interface {
+	Record(e Entry) error
+}
+
+// Level selects which entries are recorded.
+type Level int
+
+const (
+	// LevelAll records every call.
+	LevelAll Level = iota
+	// LevelWrite records everything but allowed or failed reads.
+	LevelWrite
+	// LevelDenied records hidden and denied calls only.
+	LevelDenied
+	// LevelOff records nothing.
+	LevelOff
+)
+
+// ParseLevel parses all, write, denied or off; "" is all.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "all":
+		return LevelAll, nil
+	case "write":
+		return LevelWrite, nil
+	case "denied":
+		return LevelDenied, nil
+	case "off":
+		return LevelOff, nil
+	}
+	return LevelOff, fmt.Errorf("unknown audit level %q (want all, write, denied or off)", s)
+}
+
+// Enabled reports whether e is recorded at level l.
+func (l Level) Enabled(e Entry) bool {
+	denied := e.Decision == DecisionHidden || e.Decision == DecisionDenied
+	switch l {
+	case LevelAll:
+		return true
+	case LevelWrite:
+		return denied || e.Operation != OperationRead
+	case LevelDenied:
+		return denied
+	}
+	return false
+}
+
+type entryKey struct{}
+
+// WithEntry returns a context carrying e for [Update].
+func WithEntry(ctx context.Context, e *Entry) context.Context {
+	return context.WithValue(ctx, entryKey{}, e)
+}
+
+// Update applies fn to the entry of the request ctx belongs to; it does
+// nothing when the request is not audited.
+func Update(ctx context.Context, fn func(e *Entry)) {
+	if e, ok := ctx.Value(entryKey{}).(*Entry); ok && e != nil {
+		fn(e)
+	}
+}
@@ -0,0 +1,155 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/authz"
+)
+
+func readEntries(path string) []audit.Entry {
+	f, err := os.Open(path)
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close() //nolint:errcheck
+	var out []audit.Entry
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e audit.Entry
+		Expect(json.Unmarshal(sc.Bytes(), &e)).To(Succeed())
+		out = append(out, e)
+	}
+	return out
+}
+
+var _ = Describe("FileSink", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "audit.jsonl")
+	})
+
+	It("appends entries as JSON lines", func() {
+		s, err := audit.NewFileSink(path, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		id := uuid.New()
+		Expect(s.Record(audit.Entry{RequestID: "r1", Subject: "alice", Operation: audit.OperationRead, ResourceID: id, Decision: audit.DecisionAllowed, Status: 200})).To(Succeed())
+		Expect(s.Record(audit.Entry{RequestID: "r2", Decision: audit.DecisionHidden, Status: 404})).To(Succeed())
+		Expect(s.Close()).To(Succeed())
+
+		entries := readEntries(path)
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Subject).To(Equal("alice"))
+		Expect(entries[0].ResourceID).To(Equal(id))
+		Expect(entries[1].Decision).To(Equal(audit.DecisionHidden))
+
+		raw, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(raw)).NotTo(ContainSubstring(`"resourceId":"00000000`), "unset ids are omitted")
+	})
+
+	It("rotates at the size limit and keeps MaxBackups files", func() {
+		s, err := audit.NewFileSink(path, 200, 2)
+		Expect(err).NotTo(HaveOccurred())
+		for i := 0; i < 10; i++ {
+			Expect(s.Record(audit.Entry{RequestID: uuid.NewString(), Operation: audit.OperationWrite})).To(Succeed())
+		}
+		Expect(s.Close()).To(Succeed())
+
+		for _, p := range []string{path, path + ".1", path + ".2"} {
+			info, err := os.Stat(p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Size()).To(BeNumerically("<=", 200))
+		}
+		Expect(path + ".3").NotTo(BeAnExistingFile())
+	})
+
+	It("keeps writing to the current file when a rotation fails", func() {
+		// A non-empty directory in the backup's place makes the rename fail.
+		Expect(os.MkdirAll(filepath.Join(path+".1", "blocked"), 0o700)).To(Succeed())
+		s, err := audit.NewFileSink(path, 200, 1)
+		Expect(err).NotTo(HaveOccurred())
+		var failed int
+		for i := 0; i < 5; i++ {
+			if s.Record(audit.Entry{RequestID: uuid.NewString(), Operation: audit.OperationWrite}) != nil {
+				failed++
+			}
+		}
+		Expect(failed).To(BeNumerically(">", 0), "the rotation failure is reported")
+		Expect(readEntries(path)).To(HaveLen(5), "no entry is dropped")
+
+		Expect(os.RemoveAll(path + ".1")).To(Succeed())
+		Expect(s.Record(audit.Entry{RequestID: "after"})).To(Succeed())
+		Expect(s.Close()).To(Succeed())
+		Expect(readEntries(path + ".1")).To(HaveLen(5))
+		Expect(readEntries(path)).To(HaveLen(1))
+	})
+
+	It("refuses to rotate without backups", func() {
+		_, err := audit.NewFileSink(path, 200, 0)
+		Expect(err).To(MatchError(ContainSubstring("at least 1 backup")))
+		Expect(path).NotTo(BeAnExistingFile())
+
+		s, err := audit.NewFileSink(path, 0, 0)
+		Expect(err).NotTo(HaveOccurred(), "backups do not matter without rotation")
+		Expect(s.Close()).To(Succeed())
+	})
+
+	It("continues an existing file", func() {
+		s, err := audit.NewFileSink(path, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Record(audit.Entry{RequestID: "first"})).To(Succeed())
+		Expect(s.Close()).To(Succeed())
+		Expect(s.Record(audit.Entry{})).NotTo(Succeed(), "closed")
+
+		s, err = audit.NewFileSink(path, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Record(audit.Entry{RequestID: "second"})).To(Succeed())
+		Expect(s.Close()).To(Succeed())
+		Expect(readEntries(path)).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("Level", func() {
+	read := audit.Entry{Operation: audit.OperationRead, Decision: audit.DecisionAllowed}
+	write := audit.Entry{Operation: audit.OperationWrite, Decision: audit.DecisionAllowed}
+	hidden := audit.Entry{Operation: audit.OperationRead, Decision: audit.DecisionHidden}
+	push := audit.Entry{Operation: audit.OperationEventPush, Decision: audit.DecisionDenied}
+
+	DescribeTable("selects entries",
+		func(level string, want []bool) {
+			l, err := audit.ParseLevel(level)
+			Expect(err).NotTo(HaveOccurred())
+			Expect([]bool{l.Enabled(read), l.Enabled(write), l.Enabled(hidden), l.Enabled(push)}).To(Equal(want))
+		},
+		Entry("all", "all", []bool{true, true, true, true}),
+		Entry("default", "", []bool{true, true, true, true}),
+		Entry("write", "write", []bool{false, true, true, true}),
+		Entry("denied", "denied", []bool{false, false, true, true}),
+		Entry("off", "OFF", []bool{false, false, false, false}),
+	)
+
+	It("rejects unknown levels", func() {
+		_, err := audit.ParseLevel("verbose")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Update", func() {
+	It("changes the entry of the request and ignores unaudited contexts", func() {
+		e := &audit.Entry{}
+		ctx := audit.WithEntry(context.Background(), e)
+		audit.Update(ctx, func(e *audit.Entry) { e.SetPrincipal(authz.Principal{Subject: "alice", Groups: []string{"g"}}) })
+		Expect(e.Subject).To(Equal("alice"))
+		Expect(e.Groups).To(Equal([]string{"g"}))
+
+		audit.Update(context.Background(), func(*audit.Entry) { Fail("must not be called") })
+	})
+})
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileSink writes entries as JSON lines to a file. When MaxSize is set, the
+// file is rotated before it would grow beyond it: path becomes path.1, path.1
+// becomes path.2 and so on, keeping at most MaxBackups old files. The oldest
+// backup is deleted on rotation; path itself is never truncated.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+var _ AuditSink = (*FileSink)(nil)
+
+// NewFileSink opens path for appending. maxSize is in bytes; 0 disables
+// rotation. With rotation, maxBackups must be at least 1, since rotating
+// without a backup would discard every record written so far.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if maxSize > 0 && maxBackups < 1 {
+		return nil, fmt.Errorf("audit file %s: rotation needs at least 1 backup, got %d", path, maxBackups)
+	}
+	s := &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Record appends e as one JSON line. If the file cannot be rotated, e is
+// still appended to the current file and the rotation error is returned; the
+// next record tries again.
+func (s *FileSink) Record(e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return fmt.Errorf("audit file %s is closed", s.path)
+	}
+	var rotateErr error
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		rotateErr = s.rotate()
+	}
+	n, err := s.f.Write(line)
+	s.size += int64(n)
+	return errors.Join(rotateErr, err)
+}
+
+// Close closes the file; later records fail.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("opening audit file: %w", err)
+	}
+	s.f, s.size = f, info.Size()
+	return nil
+}
+
+// rotate must be called with mu held. The current file stays open until its
+// successor is, so a failed rotation leaves the sink writing where it was.
+func (s *FileSink) rotate() error {
+	_ = os.Remove(s.backup(s.maxBackups))
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("rotating audit file: %w", err)
+		}
+	}
+	// path is already gone when an earlier rotation failed after moving it.
+	if err := os.Rename(s.path, s.backup(1)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("rotating audit file: %w", err)
+	}
+	old := s.f
+	if err := s.open(); err != nil {
+		return fmt.Errorf("rotating audit file: %w", err)
+	}
+	return old.Close()
+}
+
+func (s *FileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
@@ -0,0 +1,346 @@
+package authz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+)
+
+// APIKeyPrefix starts every API key token, telling it apart from a JWT.
+const APIKeyPrefix = "emk_"
+
+// apiKeySubjectPrefix starts the subject of principals authenticated by an
+// API key; the id of the key's Identity follows.
+const apiKeySubjectPrefix = "apikey:"
+
+// APIKeySubject returns the subject of principals authenticated by an API key
+// bound to the Identity id. It is derived from the id rather than from the
+// Identity's principal values, which whoever may write the Identity can
+// change, so a key never stands for another subject.
+func APIKeySubject(id uuid.UUID) string {
+	return apiKeySubjectPrefix + id.String()
+}
+
+// apiKeyIdentity returns the Identity a principal authenticated by an API key
+// stands for.
+func apiKeyIdentity(p Principal) (uuid.UUID, bool) {
+	if p.Scope == nil {
+		return uuid.Nil, false
+	}
+	raw, ok := strings.CutPrefix(p.Subject, apiKeySubjectPrefix)
+	if !ok {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(raw)
+	return id, err == nil
+}
+
+// Errors returned by [APIKeyStore].
+var (
+	ErrAPIKeyNotFound  = errors.New("api key not found")
+	ErrUnknownIdentity = errors.New("identity not found")
+)
+
+// Scope limits what a principal authenticated by an API key may do. The
+// Identity's own grants still apply; the scope can only narrow them.
+type Scope struct {
+	// KeyID identifies the API key the principal authenticated with.
+	KeyID string
+	// Types restricts the key to these resource types; nil allows all.
+	Types map[events.ResourceType]bool
+	// Actions lists the permitted actions (read, write, push or *).
+	Actions []Action
+}
+
+// Allows reports whether the scope permits action on resources of type rt. A
+// nil scope permits everything.
+func (s *Scope) Allows(action Action, rt events.ResourceType) bool {
+	if s == nil {
+		return true
+	}
+	if s.Types != nil && !s.Types[rt] {
+		return false
+	}
+	return slices.Contains(s.Actions, action) || slices.Contains(s.Actions, ActionAll)
+}
+
+// AllowsAction reports whether the scope permits action on any resource type.
+func (s *Scope) AllowsAction(action Action) bool {
+	return s == nil || slices.Contains(s.Actions, action) || slices.Contains(s.Actions, ActionAll)
+}
+
+// IdentityLookup resolves the Identity an API key is bound to.
+type IdentityLookup interface {
+	GetIdentityById(uuid.UUID) iam.Identity
+}
+
+// APIKey is the stored form of an API key. Only the SHA-256 of the token is
+// kept; the token itself is returned once by [APIKeyStore.Create].
+type APIKey struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name,omitempty"`
+	IdentityID uuid.UUID `json:"identityId"`
+	// ResourceTypes holds wire kinds, e.g. "ApiInstance"; empty allows all.
+	ResourceTypes []string  `json:"resourceTypes,omitempty"`
+	Actions       []Action  `json:"actions"`
+	CreatedAt     time.Time `json:"createdAt"`
+	ExpiresAt     time.Time `json:"expiresAt,omitzero"`
+	Hash          string    `json:"hash"`
+}
+
+// Expired reports whether the key has expired at now.
+func (k APIKey) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// APIKeySpec describes a key to create.
+type APIKeySpec struct {
+	Name          string
+	IdentityID    uuid.UUID
+	ResourceTypes []events.ResourceType
+	// Actions defaults to read.
+	Actions   []Action
+	ExpiresAt time.Time
+}
+
+// APIKeyStore holds API keys bound to Identities of the model. Keys are kept
+// in memory and, when a path is given, persisted to a JSON file on every
+// change. The store is safe for concurrent use.
+type APIKeyStore struct {
+	path       string
+	identities IdentityLookup
+	now        func() time.Time
+
+	mu   sync.RWMutex
+	keys map[string]APIKey
+}
+
+var _ Authenticator = (*APIKeyStore)(nil)
+
+// NewAPIKeyStore returns a store resolving Identities in identities. When
+// path is set, existing keys are loaded from it; a missing file is an empty
+// store.
+func NewAPIKeyStore(path string, identities IdentityLookup) (*APIKeyStore, error) {
+	s := &APIKeyStore{path: path, identities: identities, now: time.Now, keys: map[string]APIKey{}}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading api key file: %w", err)
+	}
+	var keys []APIKey
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("parsing api key file %s: %w", path, err)
+	}
+	for _, k := range keys {
+		s.keys[k.ID] = k
+	}
+	return s, nil
+}
+
+// SetClock replaces the clock used for creation times and expiry, which lets
+// tests control time.
+func (s *APIKeyStore) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// Create stores a new key for spec and returns it together with its token.
+// The token cannot be recovered later.
+func (s *APIKeyStore) Create(spec APIKeySpec) (APIKey, string, error) {
+	if s.identities == nil || s.identities.GetIdentityById(spec.IdentityID) == nil {
+		return APIKey{}, "", fmt.Errorf("%w: %s", ErrUnknownIdentity, spec.IdentityID)
+	}
+	actions := spec.Actions
+	if len(actions) == 0 {
+		actions = []Action{ActionRead}
+	}
+	for _, a := range actions {
+		if !slices.Contains([]Action{ActionRead, ActionWrite, ActionPush, ActionAll}, a) {
+			return APIKey{}, "", fmt.Errorf("unknown action %q (want read, write, push or *)", a)
+		}
+	}
+	now := s.clock().UTC()
+	if !spec.ExpiresAt.IsZero() && !spec.ExpiresAt.After(now) {
+		return APIKey{}, "", errors.New("expiry must be in the future")
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	token := APIKeyPrefix + id + "_" + secret
+	k := APIKey{
+		ID:         id,
+		Name:       spec.Name,
+		IdentityID: spec.IdentityID,
+		Actions:    slices.Clone(actions),
+		CreatedAt:  now,
+		ExpiresAt:  spec.ExpiresAt.UTC(),
+		Hash:       hashToken(token),
+	}
+	for _, rt := range spec.ResourceTypes {
+		k.ResourceTypes = append(k.ResourceTypes, rt.WireKind())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[id] = k
+	if err := s.save(); err != nil {
+		delete(s.keys, id)
+		return APIKey{}, "", err
+	}
+	return k, token, nil
+}
+
+// List returns all keys ordered by creation time.
+func (s *APIKeyStore) List() []APIKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]APIKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// Get returns the key with the given id.
+func (s *APIKeyStore) Get(id string) (APIKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	k, ok := s.keys[id]
+	return k, ok
+}
+
+// Revoke deletes the key with the given id.
+func (s *APIKeyStore) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k, ok := s.keys[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrAPIKeyNotFound, id)
+	}
+	delete(s.keys, id)
+	if err := s.save(); err != nil {
+		s.keys[id] = k
+		return err
+	}
+	return nil
+}
+
+// Authenticate verifies an API key token. The principal's subject is
+// [APIKeySubject] of the bound Identity; its [Scope] carries the key's
+// restrictions. Expired keys and keys whose Identity left the model are
+// rejected.
+func (s *APIKeyStore) Authenticate(_ context.Context, token string) (Principal, error) {
+	id, _, ok := strings.Cut(strings.TrimPrefix(token, APIKeyPrefix), "_")
+	if !strings.HasPrefix(token, APIKeyPrefix) || !ok {
+		return Principal{}, fmt.Errorf("%w: not an api key", ErrUnauthenticated)
+	}
+	k, found := s.Get(id)
+	if !found || subtle.ConstantTimeCompare([]byte(k.Hash), []byte(hashToken(token))) != 1 {
+		return Principal{}, fmt.Errorf("%w: invalid api key", ErrUnauthenticated)
+	}
+	if k.Expired(s.clock()) {
+		return Principal{}, fmt.Errorf("%w: api key %s expired", ErrUnauthenticated, k.ID)
+	}
+	var identity iam.Identity
+	if s.identities != nil {
+		identity = s.identities.GetIdentityById(k.IdentityID)
+	}
+	if identity == nil {
+		return Principal{}, fmt.Errorf("%w: identity %s of api key %s not found", ErrUnauthenticated, k.IdentityID, k.ID)
+	}
+
+	scope := &Scope{KeyID: k.ID, Actions: k.Actions}
+	if len(k.ResourceTypes) > 0 {
+		scope.Types = map[events.ResourceType]bool{}
+		for _, name := range k.ResourceTypes {
+			scope.Types[events.ParseWireKind(name)] = true
+		}
+	}
+	return Principal{Subject: APIKeySubject(k.IdentityID), Scope: scope}, nil
+}
+
+// save writes all keys to the file atomically; it must be called with mu
+// held.
+func (s *APIKeyStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	keys := make([]APIKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	data, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing api key file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing api key file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing api key file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("writing api key file: %w", err)
+	}
+	return nil
+}
+
+func (s *APIKeyStore) clock() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.now()
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,162 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("APIKeyStore", func() {
+	var (
+		m        model.Model
+		identity iam.Identity
+		store    *authz.APIKeyStore
+		path     string
+		now      time.Time
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		identity = iam.NewIdentity(uuid.New())
+		identity.GetAnnotations().Add(authz.PrincipalKey, "ci-pipeline")
+		Expect(m.AddIdentity(identity)).To(Succeed())
+
+		path = filepath.Join(GinkgoT().TempDir(), "keys.json")
+		store, err = authz.NewAPIKeyStore(path, m)
+		Expect(err).NotTo(HaveOccurred())
+		now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		store.SetClock(func() time.Time { return now })
+	})
+
+	It("authenticates a created key as its Identity with the key's scope", func() {
+		key, token, err := store.Create(authz.APIKeySpec{
+			Name:          "ci",
+			IdentityID:    identity.GetIdentityId(),
+			ResourceTypes: []events.ResourceType{events.SystemResource},
+			Actions:       []authz.Action{authz.ActionWrite},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(HavePrefix(authz.APIKeyPrefix + key.ID + "_"))
+		Expect(key.ResourceTypes).To(Equal([]string{"System"}))
+
+		p, err := store.Authenticate(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Subject).To(Equal(authz.APIKeySubject(identity.GetIdentityId())))
+		Expect(p.Scope.KeyID).To(Equal(key.ID))
+		Expect(p.Scope.Allows(authz.ActionWrite, events.SystemResource)).To(BeTrue())
+		Expect(p.Scope.Allows(authz.ActionRead, events.SystemResource)).To(BeFalse())
+		Expect(p.Scope.Allows(authz.ActionWrite, events.APIResource)).To(BeFalse())
+	})
+
+	It("never takes the subject from the Identity's principal values", func() {
+		_, token, err := store.Create(authz.APIKeySpec{IdentityID: identity.GetIdentityId()})
+		Expect(err).NotTo(HaveOccurred())
+		identity.GetAnnotations().Add(authz.PrincipalKey, "auditor")
+
+		p, err := store.Authenticate(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Subject).To(Equal(authz.APIKeySubject(identity.GetIdentityId())))
+		Expect(p.Scope.Actions).To(Equal([]authz.Action{authz.ActionRead}))
+		Expect(authz.NewEvaluator(authz.Config{AuditorIdentity: "auditor"}).IsAuditor(p)).To(BeFalse())
+	})
+
+	It("persists only the hash and reloads keys from the file", func() {
+		key, token, err := store.Create(authz.APIKeySpec{IdentityID: identity.GetIdentityId()})
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(key.ID))
+		Expect(string(data)).NotTo(ContainSubstring(strings.TrimPrefix(token, authz.APIKeyPrefix+key.ID+"_")))
+
+		reloaded, err := authz.NewAPIKeyStore(path, m)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reloaded.List()).To(HaveLen(1))
+		_, err = reloaded.Authenticate(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects tampered, expired, revoked and orphaned keys", func() {
+		key, token, err := store.Create(authz.APIKeySpec{
+			IdentityID: identity.GetIdentityId(),
+			ExpiresAt:  now.Add(time.Hour),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = store.Authenticate(ctx, token+"0")
+		Expect(errors.Is(err, authz.ErrUnauthenticated)).To(BeTrue())
+		_, err = store.Authenticate(ctx, "not-a-key")
+		Expect(errors.Is(err, authz.ErrUnauthenticated)).To(BeTrue())
+
+		now = now.Add(2 * time.Hour)
+		_, err = store.Authenticate(ctx, token)
+		Expect(err).To(MatchError(ContainSubstring("expired")))
+		now = now.Add(-2 * time.Hour)
+
+		Expect(m.DeleteIdentity(identity.GetIdentityId())).To(Succeed())
+		_, err = store.Authenticate(ctx, token)
+		Expect(err).To(MatchError(ContainSubstring("identity")))
+
+		Expect(store.Revoke(key.ID)).To(Succeed())
+		Expect(store.Revoke(key.ID)).To(MatchError(authz.ErrAPIKeyNotFound))
+		Expect(store.List()).To(BeEmpty())
+	})
+
+	It("validates the spec", func() {
+		_, _, err := store.Create(authz.APIKeySpec{IdentityID: uuid.New()})
+		Expect(err).To(MatchError(authz.ErrUnknownIdentity))
+		_, _, err = store.Create(authz.APIKeySpec{IdentityID: identity.GetIdentityId(), Actions: []authz.Action{"admin"}})
+		Expect(err).To(MatchError(ContainSubstring("unknown action")))
+		_, _, err = store.Create(authz.APIKeySpec{IdentityID: identity.GetIdentityId(), ExpiresAt: now.Add(-time.Minute)})
+		Expect(err).To(MatchError(ContainSubstring("future")))
+	})
+
+	It("narrows the Identity's grants to the key's scope", func() {
+		sys := system.NewSystem(uuid.New())
+		sys.GetAnnotations().Add(authz.OwnerIdentitiesKey, authz.APIKeySubject(identity.GetIdentityId()))
+		Expect(m.AddSystem(sys)).To(Succeed())
+		eval := authz.NewEvaluator(authz.Config{PublicTypes: map[events.ResourceType]bool{events.APIResource: true}})
+
+		_, token, err := store.Create(authz.APIKeySpec{
+			IdentityID:    identity.GetIdentityId(),
+			ResourceTypes: []events.ResourceType{events.APIResource},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		p, err := store.Authenticate(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(eval.CanSee(authz.Principal{Subject: authz.APIKeySubject(identity.GetIdentityId())}, events.SystemResource, sys)).To(BeTrue())
+		Expect(eval.CanSee(p, events.SystemResource, sys)).To(BeFalse())
+		d := eval.Explain(p, events.SystemResource, sys)
+		Expect(d.Visible).To(BeFalse())
+		Expect(d.Steps[0].Check).To(Equal(authz.CheckAPIKeyScope))
+	})
+})
+
+var _ = Describe("WithAPIKeys", func() {
+	It("routes API keys to the store and other tokens to the fallback", func() {
+		auth := authz.WithAPIKeys(nil, nil)
+		_, err := auth.Authenticate(context.Background(), authz.APIKeyPrefix+"abc_def")
+		Expect(err).To(MatchError(ContainSubstring("not enabled")))
+		_, err = auth.Authenticate(context.Background(), "eyJhbGciOi")
+		Expect(err).To(MatchError(ContainSubstring("only api keys")))
+		Expect(errors.Is(err, authz.ErrUnauthenticated)).To(BeTrue())
+	})
+})
@@ -0,0 +1,40 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Authenticator verifies a bearer token and returns the Principal it stands
+// for. Errors wrap [ErrUnauthenticated].
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+// WithAPIKeys returns an Authenticator verifying tokens starting with
+// [APIKeyPrefix] with keys and all other tokens with tokens. Either may be
+// nil, in which case the matching tokens are rejected.
+func WithAPIKeys(keys *APIKeyStore, tokens Authenticator) Authenticator {
+	return apiKeyDispatch{keys: keys, tokens: tokens}
+}
+
+type apiKeyDispatch struct {
+	keys   *APIKeyStore
+	tokens Authenticator
+}
+
+func (d apiKeyDispatch) Authenticate(ctx context.Context, token string) (Principal, error) {
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		if d.keys == nil {
+			return Principal{}, fmt.Errorf("%w: api keys are not enabled", ErrUnauthenticated)
+		}
+		return d.keys.Authenticate(ctx, token)
+	}
+	if d.tokens == nil {
+		return Principal{}, fmt.Errorf("%w: only api keys are accepted", ErrUnauthenticated)
+	}
+	return d.tokens.Authenticate(ctx, token)
+}
@@ -183,3 +183,38 @@ var _ = Describe("ParseGroups", func() {
 		Expect(groups).To(Equal([]string{"a", "b", "c"}))
 	})
 })
+
+var _ = Describe("Evaluator tenant membership", func() {
+	eval := authz.NewEvaluator(authz.Config{
+		Tenant:            "payments",
+		TenantGroupPrefix: "tenant:",
+		AuditorGroup:      "auditors",
+		PublicTypes:       map[events.ResourceType]bool{events.SystemResource: true},
+	})
+	owned := newOwnable("alice", "")
+
+	It("hides even owned and public resources from non-members", func() {
+		alice := authz.Principal{Subject: "alice"}
+		Expect(eval.InTenant(alice)).To(BeFalse())
+		Expect(eval.CanSee(alice, events.SystemResource, owned)).To(BeFalse())
+		Expect(eval.CanSee(alice, events.APIResource, owned)).To(BeFalse())
+
+		d := eval.Explain(alice, events.SystemResource, owned)
+		Expect(d.Visible).To(BeFalse())
+		Expect(d.Steps[0].Check).To(Equal(authz.CheckTenant))
+	})
+
+	It("applies the usual rules to members, auditors and API keys", func() {
+		member := authz.Principal{Subject: "alice", Groups: []string{"tenant:payments"}}
+		Expect(eval.CanSee(member, events.APIResource, owned)).To(BeTrue())
+		Expect(eval.CanSee(authz.Principal{Subject: "bob", Groups: []string{"tenant:payments"}}, events.APIResource, owned)).To(BeFalse())
+		Expect(eval.CanSee(authz.Principal{Subject: "carol", Groups: []string{"auditors"}}, events.APIResource, owned)).To(BeTrue())
+		key := authz.Principal{Subject: "alice", Scope: &authz.Scope{Actions: []authz.Action{authz.ActionRead}}}
+		Expect(eval.CanSee(key, events.APIResource, owned)).To(BeTrue())
+	})
+
+	It("does not gate without a group prefix", func() {
+		open := authz.NewEvaluator(authz.Config{Tenant: "payments"})
+		Expect(open.InTenant(authz.Principal{})).To(BeTrue())
+	})
+})
@@ -0,0 +1,393 @@
+package authz
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/annotations"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+)
+
+// Action is an operation a Permission grants on resources.
+type Action string
+
+const (
+	ActionRead  Action = "read"
+	ActionWrite Action = "write"
+	// ActionPush allows pushing events and registering subscribers. It is
+	// only meaningful in the [Scope] of an API key.
+	ActionPush Action = "push"
+	// ActionAll grants every action.
+	ActionAll Action = "*"
+)
+
+// PrincipalKey is the annotation key on Identity and Group resources listing
+// the token subjects or group claim values the resource stands for, in
+// addition to its own id.
+const PrincipalKey = "emeland.io/principal"
+
+// PermissionActionsKey is the annotation key on Permission and PermissionSpec
+// resources listing the granted actions (read, write or *). Without it a
+// permission grants read.
+const PermissionActionsKey = "emeland.io/actions"
+
+// PermissionResourceTypesKey is the annotation key on Permission and
+// PermissionSpec resources restricting the permission to resource types
+// (e.g. "System, SystemInstance"). Without it all types are covered.
+const PermissionResourceTypesKey = "emeland.io/resource-types"
+
+// IAMSource is the part of the landscape model read by [BindingRule].
+type IAMSource interface {
+	GetContextById(id uuid.UUID) mdlctx.Context
+	iam.IdentityModel
+	iam.GroupModel
+	iam.BindingModel
+	iam.RoleModel
+	iam.RoleSpecModel
+	iam.PermissionModel
+	iam.PermissionSpecModel
+}
+
+// WriteRule grants write access for a resource under specific conditions.
+type WriteRule interface {
+	Name() string
+	GrantsWrite(p Principal, rt events.ResourceType, r Ownable) bool
+}
+
+// BindingRule grants access from the model's own IAM resources: the principal
+// is resolved to Identities and Groups, their Bindings lead to Roles, and the
+// Roles' Permissions name the granted actions and resource types. A Role with
+// a ContextRef only covers the subtree of that Context: the Context, its
+// descendants and resources assigned to any of them; a Role with Resources
+// only covers those. A Role with neither covers the landscape.
+type BindingRule struct {
+	m IAMSource
+}
+
+var (
+	_ VisibilityRule = BindingRule{}
+	_ WriteRule      = BindingRule{}
+)
+
+// NewBindingRule returns a rule evaluating the IAM resources in m.
+func NewBindingRule(m IAMSource) BindingRule {
+	return BindingRule{m: m}
+}
+
+func (BindingRule) Name() string { return "iam-binding" }
+
+// Grants reports whether a Binding of the principal grants read on r.
+func (b BindingRule) Grants(p Principal, rt events.ResourceType, r Ownable) bool {
+	return b.Allows(p, ActionRead, rt, r)
+}
+
+// GrantsWrite reports whether a Binding of the principal grants write on r.
+func (b BindingRule) GrantsWrite(p Principal, rt events.ResourceType, r Ownable) bool {
+	return b.Allows(p, ActionWrite, rt, r)
+}
+
+// Allows reports whether any Role bound to the principal grants action on r.
+func (b BindingRule) Allows(p Principal, action Action, rt events.ResourceType, r Ownable) bool {
+	if b.m == nil {
+		return false
+	}
+	return b.allows(b.roles(p), action, rt, r)
+}
+
+// allows reports whether any of roles grants action on r.
+func (b BindingRule) allows(roles []iam.Role, action Action, rt events.ResourceType, r Ownable) bool {
+	for _, role := range roles {
+		if !b.roleCovers(role, rt, r) {
+			continue
+		}
+		for _, g := range b.permissionGrants(role) {
+			if g.allows(action, rt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bind implements [principalBinder]: the principal's Roles are resolved once
+// instead of on every check.
+func (b BindingRule) bind(p Principal) VisibilityRule {
+	if b.m == nil {
+		return b
+	}
+	return boundBindingRule{BindingRule: b, roles: b.roles(p)}
+}
+
+// boundBindingRule is a [BindingRule] for one principal, whose Roles it holds.
+type boundBindingRule struct {
+	BindingRule
+	roles []iam.Role
+}
+
+func (b boundBindingRule) Grants(_ Principal, rt events.ResourceType, r Ownable) bool {
+	return b.allows(b.roles, ActionRead, rt, r)
+}
+
+// roles returns the Roles bound to the principal's Identities and Groups.
+func (b BindingRule) roles(p Principal) []iam.Role {
+	bindings, err := b.m.GetBindings()
+	if err != nil || len(bindings) == 0 {
+		return nil
+	}
+	identities := b.identitiesOf(p)
+	groups := b.groupsOf(p, identities)
+	if len(identities) == 0 && len(groups) == 0 {
+		return nil
+	}
+
+	var roles []iam.Role
+	for _, binding := range bindings {
+		subject := binding.GetSubject()
+		switch subject.EffectiveKind() {
+		case iam.SubjectKindIdentity:
+			if !identities[subject.EffectiveIdentityID()] {
+				continue
+			}
+		case iam.SubjectKindGroup:
+			if !groups[subject.EffectiveGroupID()] {
+				continue
+			}
+		default:
+			continue
+		}
+		ref := binding.GetRole()
+		role := ref.ResolvedRole()
+		if role == nil && ref.EffectiveRoleID() != uuid.Nil {
+			role = b.m.GetRoleById(ref.EffectiveRoleID())
+		}
+		if role != nil {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// identitiesOf returns the Identities standing for the principal. An API key
+// stands for its own Identity only, whatever principal values Identities list.
+func (b BindingRule) identitiesOf(p Principal) map[uuid.UUID]bool {
+	out := map[uuid.UUID]bool{}
+	if id, ok := apiKeyIdentity(p); ok {
+		if b.m.GetIdentityById(id) != nil {
+			out[id] = true
+		}
+		return out
+	}
+	if p.Subject == "" {
+		return out
+	}
+	identities, err := b.m.GetIdentities()
+	if err != nil {
+		return out
+	}
+	for _, id := range identities {
+		if standsFor(id.GetIdentityId(), id.GetAnnotations(), p.Subject) {
+			out[id.GetIdentityId()] = true
+		}
+	}
+	return out
+}
+
+// groupsOf returns the Groups named in the principal's group claims and the
+// Groups listing one of its Identities as member.
+func (b BindingRule) groupsOf(p Principal, identities map[uuid.UUID]bool) map[uuid.UUID]bool {
+	out := map[uuid.UUID]bool{}
+	groups, err := b.m.GetGroups()
+	if err != nil {
+		return out
+	}
+	for _, g := range groups {
+		id := g.GetGroupId()
+		if slices.ContainsFunc(p.Groups, func(claim string) bool { return standsFor(id, g.GetAnnotations(), claim) }) {
+			out[id] = true
+			continue
+		}
+		for _, m := range g.GetMembers() {
+			if identities[m.EffectiveIdentityID()] {
+				out[id] = true
+				break
+			}
+		}
+	}
+	return out
+}
+
+// PrincipalValues returns the token subjects or group claim values the
+// Identity or Group with annotations a stands for, see [PrincipalKey].
+func PrincipalValues(a annotations.Annotations) []string {
+	if a == nil {
+		return nil
+	}
+	return parseList(a.GetValue(PrincipalKey))
+}
+
+// standsFor reports whether an Identity or Group with id and annotations a
+// represents the principal value v.
+func standsFor(id uuid.UUID, a annotations.Annotations, v string) bool {
+	if v == "" {
+		return false
+	}
+	if id.String() == v {
+		return true
+	}
+	return slices.Contains(PrincipalValues(a), v)
+}
+
+type contextRefHolder interface {
+	GetContextRef() *mdlctx.ContextRef
+}
+
+type contextIDHolder interface {
+	GetContextId() uuid.UUID
+}
+
+type parentIDHolder interface {
+	GetParentId() uuid.UUID
+}
+
+// maxContextDepth bounds the walk up the Context hierarchy, so a parent cycle
+// in the landscape cannot stall a decision.
+const maxContextDepth = 64
+
+// roleCovers reports whether r lies in the scope of role.
+func (b BindingRule) roleCovers(role iam.Role, rt events.ResourceType, r Ownable) bool {
+	resources := role.GetResources()
+	scope := role.GetContextRef().EffectiveParentContextID()
+	if len(resources) == 0 && scope == uuid.Nil {
+		return true
+	}
+	id := r.GetResourceId()
+	for _, ref := range resources {
+		if ref != nil && ref.ResourceId == id {
+			return true
+		}
+	}
+	if scope == uuid.Nil {
+		return false
+	}
+	ctx := contextOf(rt, r)
+	if ctx == scope {
+		return true
+	}
+	// A Context is judged by its own parent, which also covers a Context that
+	// is about to be created and not yet in the model.
+	if p, ok := r.(parentIDHolder); ok && rt == events.ContextResource {
+		ctx = p.GetParentId()
+	}
+	return b.inSubtree(ctx, scope)
+}
+
+// inSubtree reports whether the Context ctx is scope or one of its
+// descendants.
+func (b BindingRule) inSubtree(ctx, scope uuid.UUID) bool {
+	for depth := 0; ctx != uuid.Nil && depth < maxContextDepth; depth++ {
+		if ctx == scope {
+			return true
+		}
+		c := b.m.GetContextById(ctx)
+		if c == nil {
+			return false
+		}
+		ctx = c.GetParentId()
+	}
+	return false
+}
+
+// contextOf returns the Context a resource is assigned to; for a Context it
+// is the Context itself.
+func contextOf(rt events.ResourceType, r Ownable) uuid.UUID {
+	if rt == events.ContextResource {
+		return r.GetResourceId()
+	}
+	switch v := r.(type) {
+	case contextRefHolder:
+		return v.GetContextRef().EffectiveParentContextID()
+	case contextIDHolder:
+		return v.GetContextId()
+	}
+	return uuid.Nil
+}
+
+type permissionGrant struct {
+	actions []Action
+	types   map[events.ResourceType]bool
+}
+
+func (g permissionGrant) allows(action Action, rt events.ResourceType) bool {
+	if g.types != nil && !g.types[rt] {
+		return false
+	}
+	return slices.Contains(g.actions, action) || slices.Contains(g.actions, ActionAll)
+}
+
+// permissionGrants returns the grants of the role's Permissions, or of its
+// RoleSpec's PermissionSpecs when the role lists no Permissions.
+func (b BindingRule) permissionGrants(role iam.Role) []permissionGrant {
+	var out []permissionGrant
+	for _, ref := range role.GetPermissions() {
+		perm := ref.ResolvedPermission()
+		if perm == nil && ref.EffectivePermissionID() != uuid.Nil {
+			perm = b.m.GetPermissionById(ref.EffectivePermissionID())
+		}
+		if perm == nil {
+			continue
+		}
+		var spec annotations.Annotations
+		if ps := b.m.GetPermissionSpecById(perm.GetPermissionSpecId()); ps != nil {
+			spec = ps.GetAnnotations()
+		}
+		out = append(out, grantOf(perm.GetAnnotations(), spec))
+	}
+	if len(role.GetPermissions()) > 0 {
+		return out
+	}
+
+	rs := b.m.GetRoleSpecById(role.GetRoleSpecId())
+	if rs == nil {
+		return nil
+	}
+	for _, ref := range rs.GetPermissions() {
+		ps := ref.ResolvedPermissionSpec()
+		if ps == nil && ref.EffectivePermissionSpecID() != uuid.Nil {
+			ps = b.m.GetPermissionSpecById(ref.EffectivePermissionSpecID())
+		}
+		if ps != nil {
+			out = append(out, grantOf(ps.GetAnnotations(), nil))
+		}
+	}
+	return out
+}
+
+// grantOf reads actions and resource types from own, falling back per key to
+// the annotations of the PermissionSpec.
+func grantOf(own, spec annotations.Annotations) permissionGrant {
+	value := func(key string) string {
+		if own != nil {
+			if v := own.GetValue(key); v != "" {
+				return v
+			}
+		}
+		if spec != nil {
+			return spec.GetValue(key)
+		}
+		return ""
+	}
+
+	g := permissionGrant{actions: []Action{ActionRead}}
+	if actions := parseList(value(PermissionActionsKey)); len(actions) > 0 {
+		g.actions = g.actions[:0]
+		for _, a := range actions {
+			g.actions = append(g.actions, Action(a))
+		}
+	}
+	if types := value(PermissionResourceTypesKey); types != "" {
+		g.types = ParsePublicResourceTypes(types)
+	}
+	return g
+}
@@ -0,0 +1,243 @@
+package authz_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("BindingRule", func() {
+	var (
+		m        model.Model
+		eval     *authz.Evaluator
+		payments mdlctx.Context
+		other    mdlctx.Context
+		inPay    system.SystemInstance
+		inOther  system.SystemInstance
+		sys      system.System
+	)
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		eval = authz.NewEvaluator(authz.Config{AuditorGroup: "auditors"}, authz.NewBindingRule(m))
+
+		payments = mdlctx.NewContext(uuid.New())
+		Expect(m.AddContext(payments)).To(Succeed())
+		other = mdlctx.NewContext(uuid.New())
+		Expect(m.AddContext(other)).To(Succeed())
+
+		inPay = system.NewSystemInstance(uuid.New())
+		inPay.SetContextRef(&mdlctx.ContextRef{ContextId: payments.GetContextId()})
+		Expect(m.AddSystemInstance(inPay)).To(Succeed())
+		inOther = system.NewSystemInstance(uuid.New())
+		inOther.SetContextRef(&mdlctx.ContextRef{ContextId: other.GetContextId()})
+		Expect(m.AddSystemInstance(inOther)).To(Succeed())
+		sys = system.NewSystem(uuid.New())
+		Expect(m.AddSystem(sys)).To(Succeed())
+	})
+
+	permission := func(actions, types string) iam.Permission {
+		spec := iam.NewPermissionSpec(uuid.New())
+		if actions != "" {
+			spec.GetAnnotations().Add(authz.PermissionActionsKey, actions)
+		}
+		if types != "" {
+			spec.GetAnnotations().Add(authz.PermissionResourceTypesKey, types)
+		}
+		Expect(m.AddPermissionSpec(spec)).To(Succeed())
+		p := iam.NewPermission(uuid.New())
+		p.SetPermissionSpecById(spec.GetPermissionSpecId())
+		Expect(m.AddPermission(p)).To(Succeed())
+		return p
+	}
+
+	role := func(scope mdlctx.Context, perms ...iam.Permission) iam.Role {
+		r := iam.NewRole(uuid.New())
+		refs := make([]*iam.PermissionRef, 0, len(perms))
+		for _, p := range perms {
+			refs = append(refs, &iam.PermissionRef{PermissionId: p.GetPermissionId()})
+		}
+		r.SetPermissions(refs)
+		if scope != nil {
+			r.SetContextRef(&mdlctx.ContextRef{ContextId: scope.GetContextId()})
+		}
+		Expect(m.AddRole(r)).To(Succeed())
+		return r
+	}
+
+	bind := func(r iam.Role, subject *iam.SubjectRef) {
+		b := iam.NewBinding(uuid.New())
+		b.SetRole(&iam.RoleRef{RoleId: r.GetRoleId()})
+		b.SetSubject(subject)
+		Expect(m.AddBinding(b)).To(Succeed())
+	}
+
+	identity := func(principal string) iam.Identity {
+		id := iam.NewIdentity(uuid.New())
+		id.GetAnnotations().Add(authz.PrincipalKey, principal)
+		Expect(m.AddIdentity(id)).To(Succeed())
+		return id
+	}
+
+	identitySubject := func(id iam.Identity) *iam.SubjectRef {
+		return &iam.SubjectRef{Identity: &iam.IdentityRef{IdentityId: id.GetIdentityId()}}
+	}
+
+	alice := authz.Principal{Subject: "alice"}
+
+	It("grants nothing without bindings", func() {
+		identity("alice")
+		Expect(eval.CanSee(alice, events.SystemResource, sys)).To(BeFalse())
+	})
+
+	It("grants read to an identity bound to an unscoped role", func() {
+		bind(role(nil, permission("", "System")), identitySubject(identity("alice")))
+
+		Expect(eval.CanSee(alice, events.SystemResource, sys)).To(BeTrue())
+		Expect(eval.CanSee(alice, events.SystemInstanceResource, inPay)).To(BeFalse(), "type not covered")
+		Expect(eval.CanSee(authz.Principal{Subject: "bob"}, events.SystemResource, sys)).To(BeFalse())
+		Expect(eval.CanWrite(alice, events.SystemResource, sys)).To(BeFalse())
+	})
+
+	It("matches an identity by its id", func() {
+		id := iam.NewIdentity(uuid.New())
+		Expect(m.AddIdentity(id)).To(Succeed())
+		bind(role(nil, permission("read", "")), identitySubject(id))
+
+		Expect(eval.CanSee(authz.Principal{Subject: id.GetIdentityId().String()}, events.SystemResource, sys)).To(BeTrue())
+	})
+
+	It("limits a context-scoped role to the Context and its resources", func() {
+		bind(role(payments, permission("read", "")), identitySubject(identity("alice")))
+
+		Expect(eval.CanSee(alice, events.SystemInstanceResource, inPay)).To(BeTrue())
+		Expect(eval.CanSee(alice, events.ContextResource, payments)).To(BeTrue())
+		Expect(eval.CanSee(alice, events.SystemInstanceResource, inOther)).To(BeFalse())
+		Expect(eval.CanSee(alice, events.SystemResource, sys)).To(BeFalse(), "Systems carry no context")
+	})
+
+	It("limits a role with resources to those resources", func() {
+		r := role(nil, permission("read", ""))
+		r.SetResources([]*common.ResourceRef{{ResourceId: inOther.GetInstanceId(), ResourceType: events.SystemInstanceResource}})
+		bind(r, identitySubject(identity("alice")))
+
+		Expect(eval.CanSee(alice, events.SystemInstanceResource, inOther)).To(BeTrue())
+		Expect(eval.CanSee(alice, events.SystemInstanceResource, inPay)).To(BeFalse())
+	})
+
+	It("grants through groups named in claims and through membership", func() {
+		claimed := iam.NewGroup(uuid.New())
+		claimed.GetAnnotations().Add(authz.PrincipalKey, "team-payments")
+		Expect(m.AddGroup(claimed)).To(Succeed())
+		bind(role(payments, permission("read", "")), &iam.SubjectRef{Group: &iam.GroupRef{GroupId: claimed.GetGroupId()}})
+
+		member := identity("carol")
+		team := iam.NewGroup(uuid.New())
+		team.SetMembers([]*iam.IdentityRef{{IdentityId: member.GetIdentityId()}})
+		Expect(m.AddGroup(team)).To(Succeed())
+		bind(role(other, permission("read", "")), &iam.SubjectRef{Group: &iam.GroupRef{GroupId: team.GetGroupId()}})
+
+		Expect(eval.CanSee(authz.Principal{Subject: "dave", Groups: []string{"team-payments"}}, events.SystemInstanceResource, inPay)).To(BeTrue())
+		Expect(eval.CanSee(authz.Principal{Subject: "carol"}, events.SystemInstanceResource, inOther)).To(BeTrue())
+		Expect(eval.CanSee(authz.Principal{Subject: "carol"}, events.SystemInstanceResource, inPay)).To(BeFalse())
+	})
+
+	It("grants write only for permissions naming write", func() {
+		bind(role(payments, permission("read, write", "SystemInstance")), identitySubject(identity("alice")))
+
+		Expect(eval.CanWrite(alice, events.SystemInstanceResource, inPay)).To(BeTrue())
+		Expect(eval.CanWrite(alice, events.SystemInstanceResource, inOther)).To(BeFalse())
+		Expect(eval.CanWrite(authz.Principal{Subject: "x", Groups: []string{"auditors"}}, events.SystemInstanceResource, inPay)).To(BeFalse(), "auditors read only")
+	})
+
+	It("covers the subtree below a context-scoped role", func() {
+		bind(role(payments, permission("write", "")), identitySubject(identity("alice")))
+		child := mdlctx.NewContext(uuid.New())
+		child.SetParentById(payments.GetContextId())
+		Expect(m.AddContext(child)).To(Succeed())
+		inChild := system.NewSystemInstance(uuid.New())
+		inChild.SetContextRef(&mdlctx.ContextRef{ContextId: child.GetContextId()})
+
+		Expect(eval.CanWrite(alice, events.ContextResource, child)).To(BeTrue())
+		Expect(eval.CanWrite(alice, events.SystemInstanceResource, inChild)).To(BeTrue())
+		Expect(eval.CanWrite(alice, events.ContextResource, other)).To(BeFalse())
+	})
+
+	It("refuses changes moving a resource across the writable subtree", func() {
+		bind(role(payments, permission("write", "SystemInstance")), identitySubject(identity("alice")))
+		moved := system.NewSystemInstance(inPay.GetInstanceId())
+		moved.SetContextRef(&mdlctx.ContextRef{ContextId: other.GetContextId()})
+
+		Expect(eval.CanChange(alice, authz.ActionWrite, events.SystemInstanceResource, nil, inPay)).To(BeTrue(), "create")
+		Expect(eval.CanChange(alice, authz.ActionWrite, events.SystemInstanceResource, inPay, nil)).To(BeTrue(), "delete")
+		Expect(eval.CanChange(alice, authz.ActionWrite, events.SystemInstanceResource, inPay, moved)).To(BeFalse(), "move out")
+		Expect(eval.CanChange(alice, authz.ActionWrite, events.SystemInstanceResource, moved, inPay)).To(BeFalse(), "move in")
+		Expect(eval.CanChange(alice, authz.ActionWrite, events.SystemInstanceResource, nil, nil)).To(BeFalse())
+	})
+
+	It("records refused writes of a WriteGuard as findings", func() {
+		guard := authz.WriteGuard{Evaluator: eval, Principal: alice, Origin: "file:///landscape", Model: m}
+		fid := authz.WriteDeniedFindingID("file:///landscape", "alice", inPay.GetInstanceId())
+
+		err := guard.AuthorizeWrite(events.SystemInstanceResource, inPay.GetInstanceId(), inPay, inPay)
+		Expect(err).To(MatchError(authz.ErrWriteDenied))
+		f := m.GetFindingById(fid)
+		Expect(f).NotTo(BeNil())
+		Expect(f.GetResources()).To(ConsistOf(&common.ResourceRef{ResourceId: inPay.GetInstanceId(), ResourceType: events.SystemInstanceResource}))
+
+		bind(role(payments, permission("write", "")), identitySubject(identity("alice")))
+		Expect(guard.AuthorizeWrite(events.SystemInstanceResource, inPay.GetInstanceId(), inPay, inPay)).To(Succeed())
+		Expect(m.GetFindingById(fid)).To(BeNil())
+	})
+
+	It("falls back to the RoleSpec's PermissionSpecs", func() {
+		spec := iam.NewPermissionSpec(uuid.New())
+		spec.GetAnnotations().Add(authz.PermissionActionsKey, "*")
+		Expect(m.AddPermissionSpec(spec)).To(Succeed())
+		rs := iam.NewRoleSpec(uuid.New())
+		rs.SetPermissions([]*iam.PermissionSpecRef{{PermissionSpecId: spec.GetPermissionSpecId()}})
+		Expect(m.AddRoleSpec(rs)).To(Succeed())
+		r := role(nil)
+		r.SetRoleSpecById(rs.GetRoleSpecId())
+		bind(r, identitySubject(identity("alice")))
+
+		Expect(eval.CanSee(alice, events.SystemResource, sys)).To(BeTrue())
+		Expect(eval.CanWrite(alice, events.SystemResource, sys)).To(BeTrue())
+	})
+
+	It("resolves the principal's roles once per FilterVisible", func() {
+		bind(role(payments, permission("read", "")), identitySubject(identity("alice")))
+		counting := &countingBindings{Model: m}
+		eval := authz.NewEvaluator(authz.Config{}, authz.NewBindingRule(counting))
+
+		items := []system.SystemInstance{inPay, inOther, inPay, inOther}
+		Expect(authz.FilterVisible(eval, alice, events.SystemInstanceResource, items)).To(Equal([]system.SystemInstance{inPay, inPay}))
+		Expect(counting.calls).To(Equal(1))
+	})
+
+	It("grants nothing for a permission restricted to unknown types", func() {
+		bind(role(nil, permission("read", "NoSuchType")), identitySubject(identity("alice")))
+		Expect(eval.CanSee(alice, events.SystemResource, sys)).To(BeFalse())
+	})
+})
+
+// countingBindings counts the reads of all Bindings.
+type countingBindings struct {
+	model.Model
+	calls int
+}
+
+func (c *countingBindings) GetBindings() ([]iam.Binding, error) {
+	c.calls++
+	return c.Model.GetBindings()
+}
@@ -0,0 +1,176 @@
+package authz
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+// ContextSource is the part of the landscape model read by [ContextIndex].
+type ContextSource interface {
+	GetContexts() ([]mdlctx.Context, error)
+	GetSystemInstances() ([]system.SystemInstance, error)
+}
+
+type contextOwners struct {
+	identities []string
+	groups     []string
+}
+
+// ContextIndex caches the Context hierarchy for [ContextInheritanceRule]: for
+// every Context the owners it inherits from itself and its ancestors, and the
+// Context of every SystemInstance. The index is rebuilt lazily on the first
+// lookup after [ContextIndex.Invalidate]; feed model events to
+// [ContextIndex.Observe] to keep it current.
+type ContextIndex struct {
+	src ContextSource
+
+	mu       sync.Mutex
+	valid    bool
+	owners   map[uuid.UUID]contextOwners
+	instance map[uuid.UUID]uuid.UUID
+}
+
+// NewContextIndex returns an index over the Contexts and SystemInstances of src.
+func NewContextIndex(src ContextSource) *ContextIndex {
+	return &ContextIndex{src: src}
+}
+
+// Invalidate discards the index; it is rebuilt on the next lookup.
+func (x *ContextIndex) Invalidate() {
+	x.mu.Lock()
+	x.valid = false
+	x.mu.Unlock()
+}
+
+// Observe invalidates the index when ev creates, updates or deletes a Context
+// or SystemInstance. Changes to a Context's owner annotations arrive as
+// updates of the Context.
+func (x *ContextIndex) Observe(ev events.Event) {
+	if ev.ResourceType != events.ContextResource && ev.ResourceType != events.SystemInstanceResource {
+		return
+	}
+	switch ev.Operation {
+	case events.CreateOperation, events.UpdateOperation, events.DeleteOperation:
+		x.Invalidate()
+	}
+}
+
+// lookup returns the inherited owners of the Context ctxID and, for a
+// non-nil instanceID, resolves the Context of that SystemInstance first.
+func (x *ContextIndex) lookup(ctxID, instanceID uuid.UUID) contextOwners {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if !x.valid {
+		x.rebuild()
+	}
+	if instanceID != uuid.Nil {
+		ctxID = x.instance[instanceID]
+	}
+	return x.owners[ctxID]
+}
+
+// rebuild must be called with mu held.
+func (x *ContextIndex) rebuild() {
+	x.owners = map[uuid.UUID]contextOwners{}
+	x.instance = map[uuid.UUID]uuid.UUID{}
+	x.valid = true
+
+	contexts, err := x.src.GetContexts()
+	if err != nil {
+		return
+	}
+	byID := make(map[uuid.UUID]mdlctx.Context, len(contexts))
+	for _, c := range contexts {
+		byID[c.GetContextId()] = c
+	}
+	// Every Context walks its own ancestry, so a parent cycle yields the same
+	// owners whichever of its Contexts is visited first.
+	for id := range byID {
+		var chain []mdlctx.Context
+		seen := map[uuid.UUID]bool{}
+		for c := byID[id]; c != nil && !seen[c.GetContextId()]; c = byID[c.GetParentId()] {
+			seen[c.GetContextId()] = true
+			chain = append(chain, c)
+		}
+		var o contextOwners
+		for i := len(chain) - 1; i >= 0; i-- {
+			o.identities = append(o.identities, OwnerIdentities(chain[i].GetAnnotations())...)
+			o.groups = append(o.groups, OwnerGroups(chain[i].GetAnnotations())...)
+		}
+		x.owners[id] = o
+	}
+
+	instances, err := x.src.GetSystemInstances()
+	if err != nil {
+		return
+	}
+	for _, si := range instances {
+		if id := si.GetContextRef().EffectiveParentContextID(); id != uuid.Nil {
+			x.instance[si.GetInstanceId()] = id
+		}
+	}
+}
+
+type systemInstanceHolder interface {
+	GetSystemInstance() *system.SystemInstanceRef
+}
+
+// ContextInheritanceRule grants visibility from owning a Context: the owners
+// of a Context see its descendant Contexts, every SystemInstance, Node,
+// Capacity and Role assigned to a Context in that subtree, and the
+// ApiInstances and ComponentInstances of those SystemInstances.
+type ContextInheritanceRule struct {
+	idx *ContextIndex
+}
+
+var _ VisibilityRule = ContextInheritanceRule{}
+
+// NewContextInheritanceRule returns a rule answering from idx.
+func NewContextInheritanceRule(idx *ContextIndex) ContextInheritanceRule {
+	return ContextInheritanceRule{idx: idx}
+}
+
+func (ContextInheritanceRule) Name() string { return "context-inheritance" }
+
+// Grants reports whether the principal owns the Context r is assigned to or
+// one of its ancestors.
+func (c ContextInheritanceRule) Grants(p Principal, rt events.ResourceType, r Ownable) bool {
+	ctxID, instanceID, ok := c.scope(rt, r)
+	if !ok {
+		return false
+	}
+	owners := c.idx.lookup(ctxID, instanceID)
+	if p.Subject != "" && slices.Contains(owners.identities, p.Subject) {
+		return true
+	}
+	return slices.ContainsFunc(owners.groups, p.InGroup)
+}
+
+// scope returns the Context r is assigned to or, for resources belonging to a
+// SystemInstance, that instance; ok is false when r is in no Context.
+func (c ContextInheritanceRule) scope(rt events.ResourceType, r Ownable) (ctxID, instanceID uuid.UUID, ok bool) {
+	if c.idx == nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	switch rt {
+	case events.ContextResource, events.SystemInstanceResource, events.NodeResource,
+		events.CapacityResource, events.RoleResource:
+		ctxID = contextOf(rt, r)
+	case events.APIInstanceResource, events.ComponentInstanceResource:
+		h, ok := r.(systemInstanceHolder)
+		if !ok || h.GetSystemInstance() == nil {
+			return uuid.Nil, uuid.Nil, false
+		}
+		ref := h.GetSystemInstance()
+		instanceID = ref.InstanceId
+		if ref.SystemInstance != nil {
+			instanceID = ref.SystemInstance.GetInstanceId()
+		}
+	}
+	return ctxID, instanceID, ctxID != uuid.Nil || instanceID != uuid.Nil
+}
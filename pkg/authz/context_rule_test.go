@@ -0,0 +1,143 @@
+package authz_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	mdlapi "go.emeland.io/modelsrv/pkg/model/api"
+	"go.emeland.io/modelsrv/pkg/model/component"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("ContextInheritanceRule", func() {
+	var (
+		m                  model.Model
+		idx                *authz.ContextIndex
+		eval               *authz.Evaluator
+		root, team, sub    mdlctx.Context
+		sibling            mdlctx.Context
+		inSub, inSibling   system.SystemInstance
+		apiInst            mdlapi.ApiInstance
+		compInst           component.ComponentInstance
+		role               iam.Role
+		owner, teamMember  authz.Principal
+		stranger           authz.Principal
+		addContext         func(parent mdlctx.Context) mdlctx.Context
+		addSystemInstance  func(c mdlctx.Context) system.SystemInstance
+		contextSourceCalls int
+	)
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		contextSourceCalls = 0
+		idx = authz.NewContextIndex(countingSource{m, &contextSourceCalls})
+		eval = authz.NewEvaluator(authz.Config{}, authz.NewContextInheritanceRule(idx))
+
+		addContext = func(parent mdlctx.Context) mdlctx.Context {
+			c := mdlctx.NewContext(uuid.New())
+			if parent != nil {
+				c.SetParentById(parent.GetContextId())
+			}
+			Expect(m.AddContext(c)).To(Succeed())
+			return c
+		}
+		addSystemInstance = func(c mdlctx.Context) system.SystemInstance {
+			si := system.NewSystemInstance(uuid.New())
+			si.SetContextRef(&mdlctx.ContextRef{ContextId: c.GetContextId()})
+			Expect(m.AddSystemInstance(si)).To(Succeed())
+			return si
+		}
+
+		root = addContext(nil)
+		team = addContext(root)
+		team.GetAnnotations().Add(authz.OwnerIdentitiesKey, "alice")
+		team.GetAnnotations().Add(authz.OwnerGroupsKey, "team-payments")
+		sub = addContext(team)
+		sibling = addContext(root)
+
+		inSub = addSystemInstance(sub)
+		inSibling = addSystemInstance(sibling)
+
+		apiInst = mdlapi.NewApiInstance(uuid.New())
+		apiInst.SetSystemInstance(&system.SystemInstanceRef{InstanceId: inSub.GetInstanceId()})
+		compInst = component.NewComponentInstance(uuid.New())
+		compInst.SetSystemInstance(&system.SystemInstanceRef{SystemInstance: inSub})
+
+		role = iam.NewRole(uuid.New())
+		role.SetContextRef(&mdlctx.ContextRef{ContextId: sub.GetContextId()})
+
+		owner = authz.Principal{Subject: "alice"}
+		teamMember = authz.Principal{Subject: "bob", Groups: []string{"team-payments"}}
+		stranger = authz.Principal{Subject: "eve"}
+	})
+
+	It("grants the owners of a Context its descendants and their resources", func() {
+		for _, p := range []authz.Principal{owner, teamMember} {
+			Expect(eval.CanSee(p, events.ContextResource, sub)).To(BeTrue())
+			Expect(eval.CanSee(p, events.SystemInstanceResource, inSub)).To(BeTrue())
+			Expect(eval.CanSee(p, events.APIInstanceResource, apiInst)).To(BeTrue())
+			Expect(eval.CanSee(p, events.ComponentInstanceResource, compInst)).To(BeTrue())
+			Expect(eval.CanSee(p, events.RoleResource, role)).To(BeTrue())
+		}
+	})
+
+	It("does not grant ancestors, siblings or other principals", func() {
+		Expect(eval.CanSee(owner, events.ContextResource, root)).To(BeFalse())
+		Expect(eval.CanSee(owner, events.ContextResource, sibling)).To(BeFalse())
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSibling)).To(BeFalse())
+		Expect(eval.CanSee(stranger, events.SystemInstanceResource, inSub)).To(BeFalse())
+	})
+
+	It("answers from the index until it is invalidated", func() {
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeTrue())
+		Expect(eval.CanSee(owner, events.ContextResource, sub)).To(BeTrue())
+		Expect(contextSourceCalls).To(Equal(1))
+
+		sub.SetParentById(sibling.GetContextId())
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeTrue(), "stale until observed")
+
+		idx.Observe(events.Event{ResourceType: events.ContextResource, Operation: events.UpdateOperation, ResourceId: sub.GetContextId()})
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeFalse())
+		Expect(contextSourceCalls).To(Equal(2))
+	})
+
+	It("follows owner annotation updates on an ancestor Context", func() {
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeTrue())
+		team.GetAnnotations().Add(authz.OwnerIdentitiesKey, "carol")
+		idx.Observe(events.Event{ResourceType: events.ContextResource, Operation: events.UpdateOperation, ResourceId: team.GetContextId()})
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeFalse())
+		Expect(eval.CanSee(authz.Principal{Subject: "carol"}, events.SystemInstanceResource, inSub)).To(BeTrue())
+	})
+
+	It("follows SystemInstances moved into an owned subtree", func() {
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSibling)).To(BeFalse())
+		inSibling.SetContextRef(&mdlctx.ContextRef{ContextId: sub.GetContextId()})
+		idx.Observe(events.Event{ResourceType: events.SystemInstanceResource, Operation: events.UpdateOperation, ResourceId: inSibling.GetInstanceId()})
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSibling)).To(BeTrue())
+	})
+
+	It("survives parent cycles", func() {
+		root.SetParentById(sub.GetContextId())
+		idx.Invalidate()
+		Expect(eval.CanSee(owner, events.SystemInstanceResource, inSub)).To(BeTrue())
+	})
+})
+
+// countingSource counts index rebuilds.
+type countingSource struct {
+	model.Model
+	calls *int
+}
+
+func (c countingSource) GetContexts() ([]mdlctx.Context, error) {
+	*c.calls++
+	return c.Model.GetContexts()
+}
@@ -9,6 +9,14 @@ type Config struct {
 	AuditorIdentity string
 	AuditorGroup    string
 	PublicTypes     map[events.ResourceType]bool
+	// Redactions withhold sensitive annotations from callers who see a
+	// resource only because its type is public.
+	Redactions []RedactionRule
+	// Tenant names the tenant whose landscape the Evaluator guards. With
+	// TenantGroupPrefix set, only members of group TenantGroupPrefix+Tenant,
+	// auditors and API keys of the tenant see or change anything in it.
+	Tenant            string
+	TenantGroupPrefix string
 }
 
 // Evaluator decides whether a principal may read a resource.
@@ -17,35 +25,75 @@ type Evaluator struct {
 	rules []VisibilityRule
 }
 
-// NewEvaluator constructs an Evaluator with the base OwnershipRule.
-func NewEvaluator(cfg Config) *Evaluator {
+// NewEvaluator constructs an Evaluator with the base OwnershipRule followed
+// by the given scope rules, e.g. a [BindingRule].
+func NewEvaluator(cfg Config, rules ...VisibilityRule) *Evaluator {
 	return &Evaluator{
 		cfg:   cfg,
-		rules: []VisibilityRule{OwnershipRule{}},
+		rules: append([]VisibilityRule{OwnershipRule{}}, rules...),
 	}
 }
 
-// CanSee reports whether principal p may read resource r of type rt.
+// CanSee reports whether principal p may read resource r of type rt. A
+// principal with a [Scope] must also be allowed to read rt by it.
 func (e *Evaluator) CanSee(p Principal, rt events.ResourceType, r Ownable) bool {
-	if e.cfg.PublicTypes[rt] {
-		return true
+	return e.trace(p, rt, r, false).Visible
+}
+
+// granted reports whether any rule grants p access to r.
+func (e *Evaluator) granted(p Principal, rt events.ResourceType, r Ownable) bool {
+	for _, rule := range e.rules {
+		if rule.Grants(p, rt, r) {
+			return true
+		}
 	}
-	if e.isAuditor(p) {
-		return true
+	return false
+}
+
+// CanWrite reports whether principal p may create, modify or delete resource
+// r of type rt. Only rules that also implement [WriteRule] grant writes;
+// public types and auditors confer read access only. A principal with a
+// [Scope] must also be allowed to write rt by it.
+func (e *Evaluator) CanWrite(p Principal, rt events.ResourceType, r Ownable) bool {
+	return e.canWrite(p, ActionWrite, rt, r)
+}
+
+// canWrite reports whether rules grant p write on r and p's [Scope] allows
+// action, which is how the write reaches the model.
+func (e *Evaluator) canWrite(p Principal, action Action, rt events.ResourceType, r Ownable) bool {
+	if !p.Scope.Allows(action, rt) || !e.inTenant(p) {
+		return false
 	}
 	for _, rule := range e.rules {
-		if rule.Grants(p, rt, r) {
+		if w, ok := rule.(WriteRule); ok && w.GrantsWrite(p, rt, r) {
 			return true
 		}
 	}
 	return false
 }
 
+// CanChange reports whether principal p may turn resource current of type rt
+// into next. current is nil for a resource that does not exist yet and next
+// is nil for a deletion. Both present sides must be writable, so a resource
+// can neither be taken from nor moved into a Context subtree p may not write.
+// action is how the change arrives, [ActionWrite] or [ActionPush] for pushed
+// events; a principal with a [Scope] must be allowed it.
+func (e *Evaluator) CanChange(p Principal, action Action, rt events.ResourceType, current, next Ownable) bool {
+	if current == nil && next == nil {
+		return false
+	}
+	if current != nil && !e.canWrite(p, action, rt, current) {
+		return false
+	}
+	return next == nil || e.canWrite(p, action, rt, next)
+}
+
 // FilterVisible returns only items visible to principal p.
 func FilterVisible[T Ownable](e *Evaluator, p Principal, rt events.ResourceType, items []T) []T {
 	if e == nil {
 		return items
 	}
+	e = e.For(p)
 	out := make([]T, 0, len(items))
 	for _, item := range items {
 		if e.CanSee(p, rt, item) {
@@ -55,6 +103,32 @@ func FilterVisible[T Ownable](e *Evaluator, p Principal, rt events.ResourceType,
 	return out
 }
 
+// principalBinder is implemented by rules that can resolve what a decision
+// needs to know about a principal once, for a series of checks by it.
+type principalBinder interface {
+	bind(p Principal) VisibilityRule
+}
+
+// For returns an Evaluator for a series of checks by p, e.g. over the items of
+// a listing: rules resolve what they need to know about p, such as the Roles
+// bound to it, once. It must only be asked about p.
+func (e *Evaluator) For(p Principal) *Evaluator {
+	rules := make([]VisibilityRule, len(e.rules))
+	for i, rule := range e.rules {
+		if b, ok := rule.(principalBinder); ok {
+			rule = b.bind(p)
+		}
+		rules[i] = rule
+	}
+	return &Evaluator{cfg: e.cfg, rules: rules}
+}
+
+// IsAuditor reports whether p is an auditor: flagged by the BFF, or matching
+// the configured auditor identity or group.
+func (e *Evaluator) IsAuditor(p Principal) bool {
+	return e.isAuditor(p)
+}
+
 func (e *Evaluator) isAuditor(p Principal) bool {
 	if p.AuditorHeader {
 		return true
@@ -68,6 +142,19 @@ func (e *Evaluator) isAuditor(p Principal) bool {
 	return false
 }
 
+// InTenant reports whether p may use the Evaluator's tenant at all. It is
+// always true without a TenantGroupPrefix.
+func (e *Evaluator) InTenant(p Principal) bool {
+	return e.inTenant(p)
+}
+
+func (e *Evaluator) inTenant(p Principal) bool {
+	if e.cfg.TenantGroupPrefix == "" || p.Scope != nil || e.isAuditor(p) {
+		return true
+	}
+	return p.InGroup(e.cfg.TenantGroupPrefix + e.cfg.Tenant)
+}
+
 // ParsePublicResourceTypes parses a comma-separated list of resource type names.
 func ParsePublicResourceTypes(s string) map[events.ResourceType]bool {
 	out := make(map[events.ResourceType]bool)
@@ -0,0 +1,213 @@
+package authz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+// Names of the checks an [Evaluator] performs before consulting its rules.
+const (
+	CheckPublicType = "public-type"
+	CheckAuditor    = "auditor"
+	// CheckAPIKeyScope is only traced for principals with a [Scope]; it
+	// cannot grant, but hides the resource when the scope excludes it.
+	CheckAPIKeyScope = "api-key-scope"
+	// CheckTenant is only traced when tenant membership is enforced; like
+	// the scope it cannot grant, but hides everything outside the tenant.
+	CheckTenant = "tenant"
+)
+
+// Step is one check in a [Decision]: the public type list, the auditor check
+// or a [VisibilityRule] by name.
+type Step struct {
+	Check   string
+	Granted bool
+	// Detail explains the outcome, e.g. which owner values were compared.
+	Detail string
+}
+
+// Decision is the trace of a visibility decision.
+type Decision struct {
+	Principal    Principal
+	ResourceType events.ResourceType
+	ResourceId   uuid.UUID
+	Visible      bool
+	// DecidedBy is the Check of the first granting step, or "" when hidden.
+	DecidedBy string
+	// Annotations holds the resource's ownership annotation values.
+	Annotations map[string]string
+	Steps       []Step
+}
+
+// RuleExplainer is implemented by rules that can describe why they granted or
+// denied access. Rules without it are reported by outcome only.
+type RuleExplainer interface {
+	Explain(p Principal, rt events.ResourceType, r Ownable) string
+}
+
+// Explain evaluates every check [Evaluator.CanSee] performs for p on r and
+// returns the trace. Unlike CanSee it does not stop at the first grant, so the
+// outcome of all rules is reported; both share [Evaluator.trace], so Visible
+// always equals CanSee.
+func (e *Evaluator) Explain(p Principal, rt events.ResourceType, r Ownable) Decision {
+	return e.trace(p, rt, r, true)
+}
+
+// trace runs the visibility checks for p on r in order. Without explain it
+// records no steps and returns at the first decisive check.
+func (e *Evaluator) trace(p Principal, rt events.ResourceType, r Ownable, explain bool) Decision {
+	t := tracer{explain: explain, d: Decision{Principal: p, ResourceType: rt, ResourceId: r.GetResourceId()}}
+	if explain {
+		t.d.Annotations = map[string]string{}
+		if a := r.GetAnnotations(); a != nil {
+			for _, key := range []string{OwnerIdentitiesKey, OwnerGroupsKey} {
+				if v := a.GetValue(key); v != "" {
+					t.d.Annotations[key] = v
+				}
+			}
+		}
+	}
+
+	if p.Scope != nil {
+		scoped := p.Scope.Allows(ActionRead, rt)
+		if t.gate(CheckAPIKeyScope, scoped, func() string {
+			if scoped {
+				return fmt.Sprintf("api key %s may read %s", p.Scope.KeyID, rt)
+			}
+			return fmt.Sprintf("api key %s may not read %s", p.Scope.KeyID, rt)
+		}) {
+			return t.d
+		}
+	}
+	if e.cfg.TenantGroupPrefix != "" {
+		member := e.inTenant(p)
+		if t.gate(CheckTenant, member, func() string {
+			if member {
+				return fmt.Sprintf("member of tenant %s", e.cfg.Tenant)
+			}
+			return fmt.Sprintf("not in group %q of tenant %s", e.cfg.TenantGroupPrefix+e.cfg.Tenant, e.cfg.Tenant)
+		}) {
+			return t.d
+		}
+	}
+
+	public := e.cfg.PublicTypes[rt]
+	if t.grant(CheckPublicType, public, func() string {
+		if public {
+			return fmt.Sprintf("%s is a public type", rt)
+		}
+		return fmt.Sprintf("%s is not a public type", rt)
+	}) {
+		return t.d
+	}
+	if t.grant(CheckAuditor, e.isAuditor(p), func() string { return e.auditorDetail(p) }) {
+		return t.d
+	}
+	for _, rule := range e.rules {
+		if t.grant(rule.Name(), rule.Grants(p, rt, r), func() string {
+			if x, ok := rule.(RuleExplainer); ok {
+				return x.Explain(p, rt, r)
+			}
+			return ""
+		}) {
+			return t.d
+		}
+	}
+	return t.d
+}
+
+// tracer accumulates a [Decision]. detail funcs are only called when
+// explaining.
+type tracer struct {
+	d       Decision
+	explain bool
+	// blocked is set by a failed gate; no later grant makes d visible.
+	blocked bool
+}
+
+// gate records a check that cannot grant but hides the resource when it
+// fails. It reports whether evaluation can stop.
+func (t *tracer) gate(check string, ok bool, detail func() string) bool {
+	if t.explain {
+		t.d.Steps = append(t.d.Steps, Step{Check: check, Granted: ok, Detail: detail()})
+	}
+	if !ok {
+		t.blocked = true
+		return !t.explain
+	}
+	return false
+}
+
+// grant records a check that makes the resource visible when it succeeds.
+// It reports whether evaluation can stop.
+func (t *tracer) grant(check string, ok bool, detail func() string) bool {
+	if t.explain {
+		t.d.Steps = append(t.d.Steps, Step{Check: check, Granted: ok, Detail: detail()})
+	}
+	if ok && !t.blocked && !t.d.Visible {
+		t.d.Visible = true
+		t.d.DecidedBy = check
+	}
+	return t.d.Visible && !t.explain
+}
+
+func (e *Evaluator) auditorDetail(p Principal) string {
+	switch {
+	case p.AuditorHeader:
+		return "flagged as auditor by the BFF"
+	case e.cfg.AuditorIdentity != "" && p.Subject == e.cfg.AuditorIdentity:
+		return fmt.Sprintf("subject %q is the auditor identity", p.Subject)
+	case e.cfg.AuditorGroup != "" && p.InGroup(e.cfg.AuditorGroup):
+		return fmt.Sprintf("member of auditor group %q", e.cfg.AuditorGroup)
+	default:
+		return "not an auditor"
+	}
+}
+
+// Explain names the owner values compared with the principal.
+func (OwnershipRule) Explain(p Principal, _ events.ResourceType, r Ownable) string {
+	return describeOwners(p, OwnerIdentities(r.GetAnnotations()), OwnerGroups(r.GetAnnotations()))
+}
+
+// Explain names the owners inherited from the resource's Context.
+func (c ContextInheritanceRule) Explain(p Principal, rt events.ResourceType, r Ownable) string {
+	ctxID, instanceID, ok := c.scope(rt, r)
+	if !ok {
+		return "resource is not assigned to a context"
+	}
+	owners := c.idx.lookup(ctxID, instanceID)
+	return "inherited: " + describeOwners(p, owners.identities, owners.groups)
+}
+
+// Explain names the bound Roles that cover the resource.
+func (b BindingRule) Explain(p Principal, rt events.ResourceType, r Ownable) string {
+	if b.m == nil {
+		return "no IAM model"
+	}
+	var covering []string
+	for _, role := range b.roles(p) {
+		if b.roleCovers(role, rt, r) {
+			name := role.GetDisplayName()
+			if name == "" {
+				name = role.GetRoleId().String()
+			}
+			covering = append(covering, name)
+		}
+	}
+	if len(covering) == 0 {
+		return "no bound role covers the resource"
+	}
+	return fmt.Sprintf("bound roles covering the resource: [%s]", strings.Join(covering, ", "))
+}
+
+// describeOwners summarizes owner lists for a trace.
+func describeOwners(p Principal, identities, groups []string) string {
+	if len(identities) == 0 && len(groups) == 0 {
+		return "no owners"
+	}
+	return fmt.Sprintf("owner identities [%s], owner groups [%s]; principal %q in groups [%s]",
+		strings.Join(identities, ", "), strings.Join(groups, ", "), p.Subject, strings.Join(p.Groups, ", "))
+}
@@ -0,0 +1,97 @@
+package authz_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+var _ = Describe("Evaluator.Explain", func() {
+	eval := authz.NewEvaluator(authz.Config{
+		AuditorGroup: "auditors",
+		PublicTypes:  authz.ParsePublicResourceTypes("ContextType"),
+	})
+	checks := func(d authz.Decision) []string {
+		out := make([]string, 0, len(d.Steps))
+		for _, s := range d.Steps {
+			out = append(out, s.Check)
+		}
+		return out
+	}
+
+	It("traces every check in evaluation order", func() {
+		r := newOwnable("alice", "team-a")
+		d := eval.Explain(authz.Principal{Subject: "alice"}, events.SystemResource, r)
+
+		Expect(checks(d)).To(Equal([]string{authz.CheckPublicType, authz.CheckAuditor, "ownership"}))
+		Expect(d.Visible).To(BeTrue())
+		Expect(d.DecidedBy).To(Equal("ownership"))
+		Expect(d.ResourceId).To(Equal(r.GetResourceId()))
+		Expect(d.Annotations).To(Equal(map[string]string{
+			authz.OwnerIdentitiesKey: "alice",
+			authz.OwnerGroupsKey:     "team-a",
+		}))
+		Expect(d.Steps[2].Detail).To(ContainSubstring("owner identities [alice]"))
+	})
+
+	It("reports the first granting check and keeps evaluating", func() {
+		d := eval.Explain(authz.Principal{Subject: "alice", Groups: []string{"auditors"}}, events.SystemResource, newOwnable("alice", ""))
+		Expect(d.DecidedBy).To(Equal(authz.CheckAuditor))
+		Expect(d.Steps[2].Granted).To(BeTrue())
+	})
+
+	It("explains a hidden resource", func() {
+		p := authz.Principal{Subject: "bob"}
+		r := newOwnable("alice", "")
+		d := eval.Explain(p, events.SystemResource, r)
+		Expect(d.Visible).To(Equal(eval.CanSee(p, events.SystemResource, r)))
+		Expect(d.Visible).To(BeFalse())
+		Expect(d.DecidedBy).To(BeEmpty())
+		for _, s := range d.Steps {
+			Expect(s.Granted).To(BeFalse(), s.Check)
+		}
+	})
+
+	It("grants public types", func() {
+		d := eval.Explain(authz.Principal{}, events.ContextTypeResource, newOwnable("", ""))
+		Expect(d.DecidedBy).To(Equal(authz.CheckPublicType))
+		Expect(d.Steps[2].Detail).To(Equal("no owners"))
+	})
+})
+
+var _ = Describe("Evaluator.Explain agrees with CanSee", func() {
+	eval := authz.NewEvaluator(authz.Config{
+		Tenant:            "payments",
+		TenantGroupPrefix: "tenant:",
+		AuditorGroup:      "auditors",
+		PublicTypes:       authz.ParsePublicResourceTypes("ContextType"),
+	})
+	member := []string{"tenant:payments"}
+	readSystems := &authz.Scope{
+		KeyID:   "k1",
+		Actions: []authz.Action{authz.ActionRead},
+		Types:   map[events.ResourceType]bool{events.SystemResource: true},
+	}
+
+	DescribeTable("for",
+		func(p authz.Principal, rt events.ResourceType, r *stubOwnable, visible bool) {
+			d := eval.Explain(p, rt, r)
+			Expect(d.Visible).To(Equal(eval.CanSee(p, rt, r)))
+			Expect(d.Visible).To(Equal(visible))
+			if !visible {
+				Expect(d.DecidedBy).To(BeEmpty())
+			}
+		},
+		Entry("an owning tenant member", authz.Principal{Subject: "alice", Groups: member}, events.SystemResource, newOwnable("alice", ""), true),
+		Entry("a non-owning tenant member", authz.Principal{Subject: "bob", Groups: member}, events.SystemResource, newOwnable("alice", ""), false),
+		Entry("an owner outside the tenant", authz.Principal{Subject: "alice"}, events.SystemResource, newOwnable("alice", ""), false),
+		Entry("a public type outside the tenant", authz.Principal{Subject: "bob"}, events.ContextTypeResource, newOwnable("", ""), false),
+		Entry("a public type inside the tenant", authz.Principal{Subject: "bob", Groups: member}, events.ContextTypeResource, newOwnable("", ""), true),
+		Entry("an auditor outside the tenant group", authz.Principal{Subject: "carol", Groups: []string{"auditors"}}, events.SystemResource, newOwnable("alice", ""), true),
+		Entry("an api key within its scope", authz.Principal{Subject: "alice", Scope: readSystems}, events.SystemResource, newOwnable("alice", ""), true),
+		Entry("an api key outside its scope", authz.Principal{Subject: "alice", Scope: readSystems}, events.APIResource, newOwnable("alice", ""), false),
+		Entry("an auditor api key outside its scope", authz.Principal{Subject: "carol", Groups: []string{"auditors"}, Scope: readSystems}, events.ContextTypeResource, newOwnable("", ""), false),
+	)
+})
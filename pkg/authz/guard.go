@@ -0,0 +1,84 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// ErrWriteDenied is returned for writes the principal is not allowed to make.
+var ErrWriteDenied = errors.New("write denied")
+
+// SHA-1 namespace so each (origin, subject, resource id) maps to one finding id.
+var writeDeniedNamespace = uuid.MustParse("8e3f6a2d-1c4b-4d7e-a9f0-5b2c8d1e6f73")
+
+// WriteGuard authorizes the writes of a principal that reach the model
+// outside the API, e.g. the documents of a sensor Source. A refused write is
+// recorded as a [finding.WriteDenied] finding on the resource; the finding is
+// removed once the same write is allowed.
+type WriteGuard struct {
+	Evaluator *Evaluator
+	Principal Principal
+	// Origin names where the writes come from, e.g. a Source URI.
+	Origin string
+	// Model receives the findings; it is the model the writes go to.
+	Model model.Model
+}
+
+// WriteDeniedFindingID returns the id of the finding recording that subject
+// may not write resource id from origin.
+func WriteDeniedFindingID(origin, subject string, id uuid.UUID) uuid.UUID {
+	key := append(id[:], []byte(origin+"\x00"+subject)...)
+	return uuid.NewSHA1(writeDeniedNamespace, key)
+}
+
+// AuthorizeWrite returns an error wrapping [ErrWriteDenied] unless the
+// guard's principal may turn current into next (see [Evaluator.CanChange]).
+func (g WriteGuard) AuthorizeWrite(rt events.ResourceType, id uuid.UUID, current, next any) error {
+	cur, nxt := AsOwnable(current, id), AsOwnable(next, id)
+	fid := WriteDeniedFindingID(g.Origin, g.Principal.Subject, id)
+	if g.Evaluator.CanChange(g.Principal, ActionWrite, rt, cur, nxt) {
+		g.clearDenied(fid)
+		return nil
+	}
+	err := fmt.Errorf("%w: %q may not write %s %s", ErrWriteDenied, g.Principal.Subject, rt.WireKind(), id)
+	g.raiseDenied(fid, rt, id, err)
+	return err
+}
+
+func (g WriteGuard) raiseDenied(fid uuid.UUID, rt events.ResourceType, id uuid.UUID, cause error) {
+	if g.Model == nil {
+		return
+	}
+	description := fmt.Sprintf("WriteDenied: %v from %s", cause, g.Origin)
+	if cur := g.Model.GetFindingById(fid); cur != nil && cur.GetDescription() == description {
+		return
+	}
+	typeID, err := finding.EnsureType(g.Model, finding.WriteDenied)
+	if err != nil {
+		log.Printf("authz: %v", err)
+	}
+	f := finding.NewFinding(fid)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName("Write authorization check")
+	f.SetDescription(description)
+	f.SetResources([]*common.ResourceRef{{ResourceId: id, ResourceType: rt}})
+	if err := g.Model.AddFinding(f); err != nil {
+		log.Printf("authz: AddFinding id=%s: %v", fid, err)
+	}
+}
+
+func (g WriteGuard) clearDenied(fid uuid.UUID) {
+	if g.Model == nil || g.Model.GetFindingById(fid) == nil {
+		return
+	}
+	if err := g.Model.DeleteFindingById(fid); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+		log.Printf("authz: DeleteFindingById id=%s: %v", fid, err)
+	}
+}
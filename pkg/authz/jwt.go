@@ -0,0 +1,259 @@
+package authz
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Defaults applied by [NewJWTAuthenticator] for empty [JWTConfig] fields.
+const (
+	DefaultSubjectClaim    = "sub"
+	DefaultGroupsClaim     = "groups"
+	DefaultJWKSMinRefresh  = time.Minute
+	DefaultJWKSMaxAge      = time.Hour
+	defaultJWKSHTTPTimeout = 10 * time.Second
+)
+
+// ErrUnauthenticated is wrapped by every error returned from
+// [JWTAuthenticator.Authenticate].
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// signingMethods lists the asymmetric algorithms accepted in tokens. HMAC is
+// not accepted: modelsrv only ever holds the issuer's public keys.
+var signingMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// JWTConfig configures a [JWTAuthenticator]. Exactly one of JWKSFile and
+// JWKSURL must be set.
+type JWTConfig struct {
+	// Issuer, when set, must match the iss claim.
+	Issuer string
+	// Audience, when set, must be contained in the aud claim.
+	Audience string
+	// JWKSFile is a local JWKS document with the issuer's public keys.
+	JWKSFile string
+	// JWKSURL is fetched for the issuer's public keys, e.g. the jwks_uri of
+	// an OIDC provider.
+	JWKSURL string
+	// SubjectClaim names the claim used as Principal.Subject (default "sub").
+	SubjectClaim string
+	// GroupsClaim names the claim used as Principal.Groups (default "groups").
+	// Nested claims are addressed with dots, e.g. "realm_access.roles". The
+	// claim may be a list of strings or a comma- or space-separated string.
+	GroupsClaim string
+	// Leeway is the clock skew tolerated for exp, nbf and iat.
+	Leeway time.Duration
+	// MinRefresh is the minimum interval between JWKS reloads triggered by
+	// unknown key ids or stale keys (default one minute).
+	MinRefresh time.Duration
+	// MaxAge is how long fetched keys are used before the JWKS is reloaded
+	// (default one hour).
+	MaxAge time.Duration
+	// HTTPClient fetches JWKSURL; a client with a short timeout is used when nil.
+	HTTPClient *http.Client
+}
+
+// JWTAuthenticator verifies bearer tokens against the issuer's JWKS and maps
+// their claims to a [Principal].
+type JWTAuthenticator struct {
+	cfg    JWTConfig
+	parser *jwt.Parser
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	loadedAt    time.Time
+	lastAttempt time.Time
+}
+
+// NewJWTAuthenticator validates cfg and loads the JWKS once so configuration
+// errors surface at startup.
+func NewJWTAuthenticator(cfg JWTConfig) (*JWTAuthenticator, error) {
+	if (cfg.JWKSFile == "") == (cfg.JWKSURL == "") {
+		return nil, fmt.Errorf("exactly one of JWKS file and JWKS URL must be set")
+	}
+	if cfg.SubjectClaim == "" {
+		cfg.SubjectClaim = DefaultSubjectClaim
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = DefaultGroupsClaim
+	}
+	if cfg.MinRefresh <= 0 {
+		cfg.MinRefresh = DefaultJWKSMinRefresh
+	}
+	if cfg.MaxAge <= 0 {
+		cfg.MaxAge = DefaultJWKSMaxAge
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: defaultJWKSHTTPTimeout}
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	a := &JWTAuthenticator{cfg: cfg, parser: jwt.NewParser(opts...)}
+	if err := a.reload(context.Background()); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authenticate verifies token and returns the Principal named by its claims.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.key(ctx, kid)
+	})
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	subject, _ := lookupClaim(claims, a.cfg.SubjectClaim).(string)
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return Principal{}, fmt.Errorf("%w: claim %q missing or empty", ErrUnauthenticated, a.cfg.SubjectClaim)
+	}
+	return Principal{
+		Subject: subject,
+		Groups:  claimStrings(lookupClaim(claims, a.cfg.GroupsClaim)),
+	}, nil
+}
+
+// key returns the verification key for kid. The JWKS is reloaded when kid is
+// unknown or the keys are older than MaxAge, at most once per MinRefresh;
+// stale keys stay in use while the JWKS cannot be reloaded. A token without
+// kid is accepted when the JWKS holds a single key.
+func (a *JWTAuthenticator) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k, ok, stale := a.lookup(kid)
+	if ok && !stale {
+		return k, nil
+	}
+	if a.beginReload() {
+		err := a.reload(ctx)
+		if err != nil && !ok {
+			return nil, err
+		}
+		if err == nil {
+			k, ok, _ = a.lookup(kid)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return k, nil
+}
+
+func (a *JWTAuthenticator) lookup(kid string) (crypto.PublicKey, bool, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	stale := time.Since(a.loadedAt) > a.cfg.MaxAge
+	if kid == "" && len(a.keys) == 1 {
+		for _, k := range a.keys {
+			return k, true, stale
+		}
+	}
+	k, ok := a.keys[kid]
+	return k, ok, stale
+}
+
+// beginReload reports whether a reload may be attempted now and, if so,
+// records the attempt.
+func (a *JWTAuthenticator) beginReload() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if time.Since(a.lastAttempt) < a.cfg.MinRefresh {
+		return false
+	}
+	a.lastAttempt = time.Now()
+	return true
+}
+
+func (a *JWTAuthenticator) reload(ctx context.Context) error {
+	data, err := a.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("loading JWKS: %w", err)
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.keys = keys
+	a.loadedAt = time.Now()
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *JWTAuthenticator) fetch(ctx context.Context) ([]byte, error) {
+	if a.cfg.JWKSFile != "" {
+		return os.ReadFile(a.cfg.JWKSFile)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: HTTP %d", a.cfg.JWKSURL, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// lookupClaim resolves a dotted claim path in claims.
+func lookupClaim(claims map[string]any, path string) any {
+	var cur any = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[part]
+	}
+	return cur
+}
+
+// claimStrings converts a list or a separated string claim to a string slice.
+func claimStrings(v any) []string {
+	switch t := v.(type) {
+	case string:
+		return parseList(t)
+	case []any:
+		out := make([]string, 0, len(t))
+		for _, item := range t {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
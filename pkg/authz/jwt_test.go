@@ -0,0 +1,212 @@
+package authz_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+)
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+func rsaJWK(kid string, k *rsa.PublicKey) map[string]any {
+	return map[string]any{"kty": "RSA", "kid": kid, "use": "sig", "n": b64(k.N.Bytes()), "e": b64(big.NewInt(int64(k.E)).Bytes())}
+}
+
+func ecJWK(kid string, k *ecdsa.PublicKey) map[string]any {
+	return map[string]any{"kty": "EC", "kid": kid, "crv": "P-256", "x": b64(k.X.FillBytes(make([]byte, 32))), "y": b64(k.Y.FillBytes(make([]byte, 32)))}
+}
+
+func jwksJSON(keys ...map[string]any) []byte {
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+func sign(method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	t := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		t.Header["kid"] = kid
+	}
+	s, err := t.SignedString(key)
+	Expect(err).NotTo(HaveOccurred())
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":    "https://idp.example",
+		"aud":    "modelsrv",
+		"sub":    "user-1",
+		"groups": []string{"team-a", "team-b"},
+		"iat":    now.Unix(),
+		"exp":    now.Add(time.Hour).Unix(),
+	}
+}
+
+var _ = Describe("JWTAuthenticator", func() {
+	var (
+		rsaKey *rsa.PrivateKey
+		ecKey  *ecdsa.PrivateKey
+		file   string
+		auth   *authz.JWTAuthenticator
+	)
+
+	BeforeEach(func() {
+		var err error
+		rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		file = filepath.Join(GinkgoT().TempDir(), "jwks.json")
+		Expect(os.WriteFile(file, jwksJSON(rsaJWK("rsa-1", &rsaKey.PublicKey), ecJWK("ec-1", &ecKey.PublicKey)), 0o600)).To(Succeed())
+
+		auth, err = authz.NewJWTAuthenticator(authz.JWTConfig{
+			Issuer:   "https://idp.example",
+			Audience: "modelsrv",
+			JWKSFile: file,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("builds the principal from the subject and groups claims", func() {
+		p, err := auth.Authenticate(context.Background(), sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Subject).To(Equal("user-1"))
+		Expect(p.Groups).To(Equal([]string{"team-a", "team-b"}))
+		Expect(p.AuditorHeader).To(BeFalse())
+	})
+
+	It("accepts EC keys", func() {
+		_, err := auth.Authenticate(context.Background(), sign(jwt.SigningMethodES256, "ec-1", ecKey, validClaims()))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("rejects invalid tokens",
+		func(mutate func(jwt.MapClaims), method jwt.SigningMethod, kid string, key func() any) {
+			claims := validClaims()
+			mutate(claims)
+			_, err := auth.Authenticate(context.Background(), sign(method, kid, key(), claims))
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, authz.ErrUnauthenticated)).To(BeTrue())
+		},
+		Entry("expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+			jwt.SigningMethodRS256, "rsa-1", func() any { return rsaKey }),
+		Entry("without exp", func(c jwt.MapClaims) { delete(c, "exp") },
+			jwt.SigningMethodRS256, "rsa-1", func() any { return rsaKey }),
+		Entry("wrong issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example" },
+			jwt.SigningMethodRS256, "rsa-1", func() any { return rsaKey }),
+		Entry("wrong audience", func(c jwt.MapClaims) { c["aud"] = "other" },
+			jwt.SigningMethodRS256, "rsa-1", func() any { return rsaKey }),
+		Entry("missing subject", func(c jwt.MapClaims) { delete(c, "sub") },
+			jwt.SigningMethodRS256, "rsa-1", func() any { return rsaKey }),
+		Entry("unknown key id", func(jwt.MapClaims) {},
+			jwt.SigningMethodRS256, "rsa-2", func() any { return rsaKey }),
+		Entry("key id of another key", func(jwt.MapClaims) {},
+			jwt.SigningMethodRS256, "ec-1", func() any { return rsaKey }),
+		Entry("HMAC signed", func(jwt.MapClaims) {},
+			jwt.SigningMethodHS256, "rsa-1", func() any { return []byte("secret") }),
+	)
+
+	It("rejects a token signed by a key that is not in the JWKS", func() {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = auth.Authenticate(context.Background(), sign(jwt.SigningMethodRS256, "rsa-1", other, validClaims()))
+		Expect(err).To(MatchError(authz.ErrUnauthenticated))
+	})
+
+	It("reads nested and string-valued groups claims from configured claim names", func() {
+		a, err := authz.NewJWTAuthenticator(authz.JWTConfig{
+			JWKSFile:     file,
+			SubjectClaim: "preferred_username",
+			GroupsClaim:  "realm_access.roles",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		claims := validClaims()
+		claims["preferred_username"] = "alice"
+		claims["realm_access"] = map[string]any{"roles": "team-a, auditors"}
+		p, err := a.Authenticate(context.Background(), sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, claims))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Subject).To(Equal("alice"))
+		Expect(p.Groups).To(Equal([]string{"team-a", "auditors"}))
+	})
+
+	It("requires exactly one JWKS source", func() {
+		_, err := authz.NewJWTAuthenticator(authz.JWTConfig{})
+		Expect(err).To(HaveOccurred())
+		_, err = authz.NewJWTAuthenticator(authz.JWTConfig{JWKSFile: file, JWKSURL: "http://localhost"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails at construction when the JWKS cannot be loaded", func() {
+		_, err := authz.NewJWTAuthenticator(authz.JWTConfig{JWKSFile: filepath.Join(GinkgoT().TempDir(), "missing.json")})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reloads a JWKS URL when a token names a new key id", func() {
+		rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		var doc atomic.Value
+		doc.Store(jwksJSON(rsaJWK("rsa-1", &rsaKey.PublicKey)))
+		var fetches atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fetches.Add(1)
+			_, _ = w.Write(doc.Load().([]byte))
+		}))
+		DeferCleanup(srv.Close)
+
+		a, err := authz.NewJWTAuthenticator(authz.JWTConfig{JWKSURL: srv.URL, MinRefresh: time.Nanosecond})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = a.Authenticate(context.Background(), sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fetches.Load()).To(BeEquivalentTo(1))
+
+		doc.Store(jwksJSON(rsaJWK("rsa-1", &rsaKey.PublicKey), rsaJWK("rsa-2", &rotated.PublicKey)))
+		p, err := a.Authenticate(context.Background(), sign(jwt.SigningMethodRS256, "rsa-2", rotated, validClaims()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Subject).To(Equal("user-1"))
+		Expect(fetches.Load()).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("ParseJWKS", func() {
+	It("skips encryption keys and unsupported key types", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		enc := rsaJWK("enc", &key.PublicKey)
+		enc["use"] = "enc"
+		keys, err := authz.ParseJWKS(jwksJSON(rsaJWK("sig", &key.PublicKey), enc, map[string]any{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(HaveLen(1))
+		Expect(keys).To(HaveKey("sig"))
+	})
+
+	It("rejects EC points that are not on the curve", func() {
+		_, err := authz.ParseJWKS([]byte(`{"keys":[{"kty":"EC","kid":"x","crv":"P-256","x":"AQ","y":"AQ"}]}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a JWKS without signing keys", func() {
+		_, err := authz.ParseJWKS([]byte(`{"keys":[]}`))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -7,11 +7,15 @@ import (
 
 type ctxKey struct{}
 
-// Principal holds the authenticated caller identity forwarded by the BFF.
+// Principal holds the authenticated caller identity forwarded by the BFF or
+// taken from a verified bearer token.
 type Principal struct {
 	Subject       string
 	Groups        []string
 	AuditorHeader bool
+	// Scope is set when the caller authenticated with an API key and limits
+	// what the key may do on top of the Identity's own grants.
+	Scope *Scope
 }
 
 // InGroup reports whether the principal belongs to the given group id.
@@ -0,0 +1,70 @@
+package authz
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+// RedactionRule withholds annotations whose key starts with KeyPrefix from
+// callers who are neither auditors nor granted the resource by a
+// [VisibilityRule], even when the resource type is public. With Types set,
+// the rule only applies to resources of those types.
+type RedactionRule struct {
+	KeyPrefix string
+	Types     map[events.ResourceType]bool
+}
+
+// ParseRedactionRules parses comma-separated rules of the form
+// "prefix" or "prefix=Type|Type", e.g.
+// "emeland.io/endpoint.host=ApiInstance|SystemInstance,contact.".
+func ParseRedactionRules(s string) ([]RedactionRule, error) {
+	var out []RedactionRule
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		prefix, types, hasTypes := strings.Cut(part, "=")
+		rule := RedactionRule{KeyPrefix: strings.TrimSpace(prefix)}
+		if rule.KeyPrefix == "" {
+			return nil, fmt.Errorf("redaction rule %q: empty key prefix", part)
+		}
+		if hasTypes {
+			rule.Types = map[events.ResourceType]bool{}
+			for _, name := range strings.Split(types, "|") {
+				name = strings.TrimSpace(name)
+				rt := events.ParseResourceType(name)
+				if rt == events.UnknownResourceType {
+					rt = events.ParseWireKind(name)
+				}
+				if rt == events.UnknownResourceType {
+					return nil, fmt.Errorf("redaction rule %q: unknown resource type %q", part, name)
+				}
+				rule.Types[rt] = true
+			}
+		}
+		out = append(out, rule)
+	}
+	return out, nil
+}
+
+// AnnotationFilter returns nil when p may read every annotation of r.
+// Otherwise the returned func reports whether the annotation with the given
+// key may be shown to p.
+func (e *Evaluator) AnnotationFilter(p Principal, rt events.ResourceType, r Ownable) func(key string) bool {
+	var prefixes []string
+	for _, rule := range e.cfg.Redactions {
+		if len(rule.Types) == 0 || rule.Types[rt] {
+			prefixes = append(prefixes, rule.KeyPrefix)
+		}
+	}
+	if len(prefixes) == 0 || e.isAuditor(p) || e.granted(p, rt, r) {
+		return nil
+	}
+	return func(key string) bool {
+		return !slices.ContainsFunc(prefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) })
+	}
+}
@@ -0,0 +1,60 @@
+package authz_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+var _ = Describe("ParseRedactionRules", func() {
+	It("parses prefixes with optional resource types", func() {
+		rules, err := authz.ParseRedactionRules(" emeland.io/endpoint.host=ApiInstance|SystemInstance, contact. ,")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(HaveLen(2))
+		Expect(rules[0].KeyPrefix).To(Equal("emeland.io/endpoint.host"))
+		Expect(rules[0].Types).To(Equal(map[events.ResourceType]bool{
+			events.APIInstanceResource:    true,
+			events.SystemInstanceResource: true,
+		}))
+		Expect(rules[1]).To(Equal(authz.RedactionRule{KeyPrefix: "contact."}))
+	})
+
+	It("rejects unknown types and empty prefixes", func() {
+		_, err := authz.ParseRedactionRules("contact.=NoSuchType")
+		Expect(err).To(HaveOccurred())
+		_, err = authz.ParseRedactionRules("=System")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Evaluator.AnnotationFilter", func() {
+	rules, _ := authz.ParseRedactionRules("contact.,emeland.io/endpoint.host=ApiInstance")
+	eval := authz.NewEvaluator(authz.Config{
+		AuditorGroup: "auditors",
+		PublicTypes:  authz.ParsePublicResourceTypes("System,APIInstance"),
+		Redactions:   rules,
+	})
+	r := newOwnable("alice", "")
+
+	It("withholds matching keys from callers who see the resource only as public", func() {
+		keep := eval.AnnotationFilter(authz.Principal{Subject: "bob"}, events.SystemResource, r)
+		Expect(keep).NotTo(BeNil())
+		Expect(keep("contact.email")).To(BeFalse())
+		Expect(keep("emeland.io/endpoint.host")).To(BeTrue(), "rule limited to ApiInstance")
+		Expect(keep("tier")).To(BeTrue())
+
+		keep = eval.AnnotationFilter(authz.Principal{Subject: "bob"}, events.APIInstanceResource, r)
+		Expect(keep("emeland.io/endpoint.host")).To(BeFalse())
+	})
+
+	It("shows everything to owners and auditors", func() {
+		Expect(eval.AnnotationFilter(authz.Principal{Subject: "alice"}, events.SystemResource, r)).To(BeNil())
+		Expect(eval.AnnotationFilter(authz.Principal{Subject: "x", Groups: []string{"auditors"}}, events.SystemResource, r)).To(BeNil())
+	})
+
+	It("returns nil without applicable rules", func() {
+		Expect(authz.NewEvaluator(authz.Config{}).AnnotationFilter(authz.Principal{}, events.SystemResource, r)).To(BeNil())
+	})
+})
@@ -12,6 +12,27 @@ type Ownable interface {
 	GetAnnotations() annotations.Annotations
 }
 
+// AsOwnable returns obj as an [Ownable], or nil for a nil obj. Resources
+// without annotations, such as FilterRules, are represented by id alone, so
+// only rules that do not depend on owners, e.g. an unscoped Binding, grant
+// access to them.
+func AsOwnable(obj any, id uuid.UUID) Ownable {
+	if obj == nil {
+		return nil
+	}
+	if o, ok := obj.(Ownable); ok {
+		return o
+	}
+	return unowned(id)
+}
+
+// unowned stands in for a resource without annotations.
+type unowned uuid.UUID
+
+func (u unowned) GetResourceId() uuid.UUID { return uuid.UUID(u) }
+
+func (unowned) GetAnnotations() annotations.Annotations { return nil }
+
 // VisibilityRule grants read access for a resource under specific conditions.
 // Scope rules (e.g. K8s cluster owner inheritance) implement this interface.
 type VisibilityRule interface {
@@ -15,8 +15,10 @@
 package backend
 
 import (
+	"go.emeland.io/modelsrv/internal/celrule"
 	eventmgr "go.emeland.io/modelsrv/internal/events"
 	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/eventfilter/lifecycle"
 	"go.emeland.io/modelsrv/pkg/eventfilter/phase0"
 	"go.emeland.io/modelsrv/pkg/eventfilter/resolvefindings"
 	"go.emeland.io/modelsrv/pkg/events"
@@ -28,6 +30,7 @@ import (
 type config struct {
 	eventHistoryLimit int
 	logger            *zap.SugaredLogger
+	subscriberToken   string
 }
 
 // Option configures a Backend at construction time.
@@ -47,6 +50,12 @@ func WithLogger(log *zap.SugaredLogger) Option {
 	return func(c *config) { c.logger = log }
 }
 
+// WithSubscriberToken makes the event manager authenticate event pushes to
+// subscribers with token; see eventmgr.WithSubscriberToken.
+func WithSubscriberToken(token string) Option {
+	return func(c *config) { c.subscriberToken = token }
+}
+
 // Backend bundles the model, the filter chain, and the event manager.
 // External consumers (sensors, the web endpoint) retrieve only the part they need.
 type Backend interface {
@@ -88,6 +97,9 @@ func New(opts ...Option) (Backend, error) {
 	if cfg.logger != nil {
 		mgrOpts = append(mgrOpts, eventmgr.WithLogger(cfg.logger))
 	}
+	if cfg.subscriberToken != "" {
+		mgrOpts = append(mgrOpts, eventmgr.WithSubscriberToken(cfg.subscriberToken))
+	}
 	eventMgr, err := eventmgr.NewEventManager(mgrOpts...)
 	if err != nil {
 		return nil, err
@@ -113,6 +125,9 @@ func New(opts ...Option) (Backend, error) {
 	phase0.EnsureWellKnownFindingTypes(m)
 	chain.RegisterFilter(resolvefindings.New())
 	resolvefindings.EnsureWellKnownFindingTypes(m)
+	chain.RegisterFilter(lifecycle.New())
+	lifecycle.EnsureWellKnownFindingTypes(m)
+	chain.RegisterFilter(celrule.NewController(chain).Filter())
 	registerMergeRules(m)
 
 	return &backendData{
@@ -0,0 +1,68 @@
+package backend
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// DefaultTenant is the tenant of requests that name none. It always exists.
+const DefaultTenant = "default"
+
+var tenantNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// ValidateTenantName checks that name can be used as a tenant, i.e. as a URL
+// path segment: lower-case letters, digits and inner dashes, at most 63
+// characters.
+func ValidateTenantName(name string) error {
+	if !tenantNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid tenant name %q: use lower-case letters, digits and dashes", name)
+	}
+	return nil
+}
+
+// Tenants hosts one isolated landscape per tenant within a single process.
+// Every tenant is a complete [Backend] with its own model, filter chain and
+// event manager, so resources, event history and subscribers never cross
+// tenants.
+type Tenants struct {
+	names    []string
+	backends map[string]Backend
+}
+
+// NewTenants constructs a Backend for [DefaultTenant] and each of names,
+// all with the same opts. Duplicates are ignored.
+func NewTenants(names []string, opts ...Option) (*Tenants, error) {
+	t := &Tenants{backends: map[string]Backend{}}
+	for _, name := range append([]string{DefaultTenant}, names...) {
+		if err := ValidateTenantName(name); err != nil {
+			return nil, err
+		}
+		if _, ok := t.backends[name]; ok {
+			continue
+		}
+		b, err := New(opts...)
+		if err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", name, err)
+		}
+		t.backends[name] = b
+		t.names = append(t.names, name)
+	}
+	return t, nil
+}
+
+// Get returns the Backend of the named tenant.
+func (t *Tenants) Get(name string) (Backend, bool) {
+	b, ok := t.backends[name]
+	return b, ok
+}
+
+// Default returns the Backend of [DefaultTenant].
+func (t *Tenants) Default() Backend {
+	return t.backends[DefaultTenant]
+}
+
+// Names returns the tenant names, [DefaultTenant] first.
+func (t *Tenants) Names() []string {
+	return slices.Clone(t.names)
+}
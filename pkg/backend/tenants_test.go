@@ -0,0 +1,43 @@
+package backend_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("Tenants", func() {
+	It("always hosts the default tenant first", func() {
+		t, err := backend.NewTenants([]string{"payments", "retail", "payments"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.Names()).To(Equal([]string{backend.DefaultTenant, "payments", "retail"}))
+		b, ok := t.Get(backend.DefaultTenant)
+		Expect(ok).To(BeTrue())
+		Expect(b).To(BeIdenticalTo(t.Default()))
+		_, ok = t.Get("unknown")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("isolates the landscapes and event streams of tenants", func() {
+		t, err := backend.NewTenants([]string{"payments"})
+		Expect(err).NotTo(HaveOccurred())
+		payments, _ := t.Get("payments")
+
+		id := uuid.New()
+		Expect(payments.GetModel().AddSystem(system.NewSystem(id))).To(Succeed())
+
+		Expect(payments.GetModel().GetSystemById(id)).NotTo(BeNil())
+		Expect(t.Default().GetModel().GetSystemById(id)).To(BeNil())
+		Expect(payments.GetEventManager()).NotTo(BeIdenticalTo(t.Default().GetEventManager()))
+	})
+
+	It("rejects names that are not URL path segments", func() {
+		for _, name := range []string{"", "Payments", "a/b", "-x", "x-"} {
+			_, err := backend.NewTenants([]string{name})
+			Expect(err).To(MatchError(ContainSubstring("invalid tenant name")), name)
+		}
+	})
+})
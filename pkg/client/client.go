@@ -14,10 +14,50 @@ type ModelSrvClient struct {
 	hc          *http.Client
 }
 
-func NewModelSrvClient(url string) (*ModelSrvClient, error) {
+// Option configures a [ModelSrvClient].
+type Option func(*ModelSrvClient)
+
+// WithBearerToken sends token as "Authorization: Bearer" on every request.
+// The token may be a JWT or an API key issued by the server.
+func WithBearerToken(token string) Option {
+	return withHeader("Authorization", "Bearer "+token)
+}
+
+// WithTenant addresses the landscape of the named tenant through the X-Tenant
+// header. Without it the server answers from its default tenant.
+func WithTenant(name string) Option {
+	return withHeader("X-Tenant", name)
+}
+
+func withHeader(key, value string) Option {
+	return func(c *ModelSrvClient) {
+		next := c.hc.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		c.hc.Transport = headerTransport{key: key, value: value, next: next}
+	}
+}
+
+// headerTransport sets a fixed header on every request.
+type headerTransport struct {
+	key, value string
+	next       http.RoundTripper
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(t.key, t.value)
+	return t.next.RoundTrip(req)
+}
+
+func NewModelSrvClient(url string, opts ...Option) (*ModelSrvClient, error) {
 	ret := &ModelSrvClient{
 		hc: &http.Client{},
 	}
+	for _, opt := range opts {
+		opt(ret)
+	}
 
 	oapiClient, err := oapi.NewClientWithResponses(url, oapi.WithHTTPClient(ret.hc))
 	if err != nil {
@@ -4,14 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"go.emeland.io/modelsrv/internal/oapi"
 	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
 )
 
 func (c *ModelSrvClient) GetFindings() ([]oapi.FindingView, error) {
-	resp, err := c.oapi_client.GetLandscapeFindingsWithResponse(context.TODO())
+	resp, err := c.oapi_client.GetLandscapeFindingsWithResponse(context.TODO(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,3 +42,69 @@ func (c *ModelSrvClient) GetFindingById(id uuid.UUID) (oapi.FindingView, error)
 	}
 	return *resp.JSON200, nil
 }
+
+// SetFindingStatus changes the triage status of a finding and returns the
+// updated view. A nil suppressedUntil suppresses indefinitely.
+func (c *ModelSrvClient) SetFindingStatus(id uuid.UUID, status finding.Status, reason string, suppressedUntil *time.Time) (oapi.FindingView, error) {
+	body := oapi.FindingStatusUpdate{
+		Status:          oapi.FindingStatus(status),
+		SuppressedUntil: suppressedUntil,
+	}
+	if reason != "" {
+		body.Reason = &reason
+	}
+	resp, err := c.oapi_client.PostLandscapeFindingsFindingIdStatusWithResponse(context.TODO(), id, body)
+	if err != nil {
+		return oapi.FindingView{}, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return oapi.FindingView{}, common.ErrFindingNotFound
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return oapi.FindingView{}, fmt.Errorf("set finding status: %s", string(*resp.JSON400))
+		}
+		return oapi.FindingView{}, fmt.Errorf("set finding status: HTTP 400")
+	default:
+		return oapi.FindingView{}, fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode())
+	}
+	if resp.JSON200 == nil {
+		return oapi.FindingView{}, nil
+	}
+	return *resp.JSON200, nil
+}
+
+// GetFindingSummary returns finding counts broken down by status, type,
+// severity, owner group, Context subtree and subject resource type.
+func (c *ModelSrvClient) GetFindingSummary() (oapi.FindingSummary, error) {
+	resp, err := c.oapi_client.GetLandscapeFindingsSummaryWithResponse(context.TODO(), nil)
+	if err != nil {
+		return oapi.FindingSummary{}, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return oapi.FindingSummary{}, fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode())
+	}
+	if resp.JSON200 == nil {
+		return oapi.FindingSummary{}, nil
+	}
+	return *resp.JSON200, nil
+}
+
+// GetResourceHealth returns the findings citing the resource with id.
+func (c *ModelSrvClient) GetResourceHealth(id uuid.UUID) (oapi.ResourceHealth, error) {
+	resp, err := c.oapi_client.GetLandscapeResourcesResourceIdHealthWithResponse(context.TODO(), id)
+	if err != nil {
+		return oapi.ResourceHealth{}, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return oapi.ResourceHealth{}, fmt.Errorf("resource %s not found", id)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return oapi.ResourceHealth{}, fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode())
+	}
+	if resp.JSON200 == nil {
+		return oapi.ResourceHealth{}, nil
+	}
+	return *resp.JSON200, nil
+}
@@ -0,0 +1,114 @@
+package endpoint
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.uber.org/zap"
+)
+
+// HeaderRequestID carries the request id. A client-supplied value is kept so
+// that the BFF's id correlates with the audit stream; otherwise one is created.
+const HeaderRequestID = "X-Request-Id"
+
+// requestIDMiddleware ensures every request has an id and echoes it in the
+// response.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSpace(r.Header.Get(HeaderRequestID))
+		if id == "" {
+			id = uuid.NewString()
+			r.Header.Set(HeaderRequestID, id)
+		}
+		w.Header().Set(HeaderRequestID, id)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// auditMiddleware records one audit entry per API call. The resource is the
+// first UUID in the path; its type is resolved before the call so deletes are
+// attributed too. hidden reports 404s for existing resources as hidden, which
+// only happens when visibility is enforced. tenant is recorded when the server
+// hosts tenants.
+func auditMiddleware(sink audit.AuditSink, level audit.Level, backend model.Model, hidden bool, tenant string, log *zap.SugaredLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if sink == nil || level == audit.LevelOff {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/api/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			e := &audit.Entry{
+				Time:      time.Now().UTC(),
+				RequestID: r.Header.Get(HeaderRequestID),
+				Tenant:    tenant,
+				Operation: auditOperation(r),
+				Method:    r.Method,
+				Path:      r.URL.Path,
+			}
+			existed := false
+			if id, ok := pathResourceID(r.URL.Path); ok {
+				e.ResourceID = id
+				if ref := model.FindResource(backend, id); ref != nil {
+					e.ResourceType = ref.ResourceType.WireKind()
+					existed = true
+				}
+			}
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(audit.WithEntry(r.Context(), e)))
+
+			e.Status = sw.status
+			if e.Decision == "" {
+				e.Decision = auditDecision(sw.status, hidden && existed)
+			}
+			if !level.Enabled(*e) {
+				return
+			}
+			if err := sink.Record(*e); err != nil {
+				log.Errorw("audit record failed", "requestId", e.RequestID, "error", err)
+			}
+		})
+	}
+}
+
+func auditOperation(r *http.Request) string {
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/events/push"):
+		return audit.OperationEventPush
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/events/register"):
+		return audit.OperationSubscriberRegister
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/events/unregister"):
+		return audit.OperationSubscriberUnregister
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return audit.OperationRead
+	}
+	return audit.OperationWrite
+}
+
+func auditDecision(status int, existed bool) audit.Decision {
+	switch {
+	case status < 400:
+		return audit.DecisionAllowed
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return audit.DecisionDenied
+	case status == http.StatusNotFound && existed:
+		return audit.DecisionHidden
+	}
+	return audit.DecisionFailed
+}
+
+func pathResourceID(path string) (uuid.UUID, bool) {
+	for _, seg := range strings.Split(path, "/") {
+		if id, err := uuid.Parse(seg); err == nil {
+			return id, true
+		}
+	}
+	return uuid.Nil, false
+}
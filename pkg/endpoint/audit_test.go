@@ -0,0 +1,131 @@
+package endpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/pkg/audit"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+type memSink struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (s *memSink) Record(e audit.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+	return nil
+}
+
+func (s *memSink) last(t *testing.T) audit.Entry {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) == 0 {
+		t.Fatal("no audit entry recorded")
+	}
+	return s.entries[len(s.entries)-1]
+}
+
+func newAuditedHandler(t *testing.T, level audit.Level) (http.Handler, *memSink, system.System) {
+	t.Helper()
+	backend, err := model.NewModel(events.NewDummySink())
+	if err != nil {
+		t.Fatalf("failed to create model: %v", err)
+	}
+	eventMgr, err := eventmgr.NewEventManager()
+	if err != nil {
+		t.Fatalf("failed to create event manager: %v", err)
+	}
+	sys := system.NewSystem(uuid.New())
+	sys.GetAnnotations().Add(authz.OwnerIdentitiesKey, "alice")
+	if err := backend.AddSystem(sys); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	sink := &memSink{}
+	h, stop := NewHandler(backend, eventMgr, "http://localhost/api", WebListenerOptions{TrustAuthHeaders: true, Audit: sink, AuditLevel: level})
+	t.Cleanup(stop)
+	return h, sink, sys
+}
+
+func auditedGet(h http.Handler, path, subject string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set(authz.HeaderAuthSubject, subject)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestAudit_RecordsPrincipalResourceAndDecision(t *testing.T) {
+	h, sink, sys := newAuditedHandler(t, audit.LevelAll)
+	path := "/api/landscape/systems/" + sys.GetSystemId().String()
+
+	w := auditedGet(h, path, "alice")
+	e := sink.last(t)
+	if e.Subject != "alice" || e.Operation != audit.OperationRead || e.Decision != audit.DecisionAllowed || e.Status != http.StatusOK {
+		t.Errorf("unexpected entry for owner: %+v", e)
+	}
+	if e.ResourceID != sys.GetSystemId() || e.ResourceType != "System" {
+		t.Errorf("expected System %s, got %s %s", sys.GetSystemId(), e.ResourceType, e.ResourceID)
+	}
+	if e.RequestID == "" || w.Header().Get(HeaderRequestID) != e.RequestID {
+		t.Errorf("request id %q not echoed (response %q)", e.RequestID, w.Header().Get(HeaderRequestID))
+	}
+
+	auditedGet(h, path, "eve")
+	if e := sink.last(t); e.Subject != "eve" || e.Decision != audit.DecisionHidden {
+		t.Errorf("expected hidden read by eve, got %+v", e)
+	}
+
+	auditedGet(h, "/api/landscape/systems/"+uuid.NewString(), "alice")
+	if e := sink.last(t); e.Decision != audit.DecisionFailed {
+		t.Errorf("expected failed read of a missing resource, got %+v", e)
+	}
+}
+
+func TestAudit_KeepsClientRequestID(t *testing.T) {
+	h, sink, _ := newAuditedHandler(t, audit.LevelAll)
+	req := httptest.NewRequest("GET", "/api/landscape/systems", nil)
+	req.Header.Set(HeaderRequestID, "bff-123")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got := sink.last(t).RequestID; got != "bff-123" {
+		t.Errorf("expected client request id, got %q", got)
+	}
+}
+
+func TestAudit_RecordsSubscriberRegistration(t *testing.T) {
+	h, sink, _ := newAuditedHandler(t, audit.LevelWrite)
+	auditedGet(h, "/api/landscape/systems", "alice")
+
+	req := httptest.NewRequest("POST", "/api/events/register", strings.NewReader(`{"callbackUrl":"http://127.0.0.1:1/api"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(authz.HeaderAuthSubject, "ops")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(sink.entries) != 1 {
+		t.Fatalf("write level must skip allowed reads, got %d entries", len(sink.entries))
+	}
+	e := sink.last(t)
+	if e.Operation != audit.OperationSubscriberRegister || e.Subscriber != "http://127.0.0.1:1/api" || e.Subject != "ops" {
+		t.Errorf("unexpected registration entry: %+v", e)
+	}
+}
+
+func TestAudit_SkipsNonAPIPaths(t *testing.T) {
+	h, sink, _ := newAuditedHandler(t, audit.LevelAll)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/metrics", nil))
+	if len(sink.entries) != 0 {
+		t.Errorf("expected no entries, got %+v", sink.entries)
+	}
+}
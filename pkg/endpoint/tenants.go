@@ -0,0 +1,89 @@
+package endpoint
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	backendpkg "go.emeland.io/modelsrv/pkg/backend"
+	"go.uber.org/zap"
+)
+
+// HeaderTenant selects the tenant of an API request whose path does not name
+// one.
+const HeaderTenant = "X-Tenant"
+
+const tenantPathPrefix = "/api/tenants/"
+
+// tenantRouter dispatches API requests to the handler of their tenant.
+// Requests naming no tenant go to the default tenant.
+type tenantRouter struct {
+	def      http.Handler
+	handlers map[string]http.Handler
+}
+
+// withTenants builds the API of every tenant besides the default one, which
+// is def. The returned func runs stop and unregisters the tenants' filters.
+func withTenants(def http.Handler, stop func(), baseURL string, opts WebListenerOptions, log *zap.SugaredLogger) (http.Handler, func()) {
+	t := tenantRouter{def: def, handlers: map[string]http.Handler{backendpkg.DefaultTenant: def}}
+	stops := []func(){stop}
+	for _, name := range opts.Tenants.Names() {
+		if name == backendpkg.DefaultTenant {
+			continue
+		}
+		b, _ := opts.Tenants.Get(name)
+		h, unregister := apiHandler(b.GetModel(), b.GetEventManager(), baseURL+"/tenants/"+name, opts.forTenant(name, b), log)
+		t.handlers[name] = h
+		stops = append(stops, unregister)
+	}
+	return t, func() {
+		for _, s := range stops {
+			s()
+		}
+	}
+}
+
+// forTenant returns the options for the API of tenant b.
+func (o WebListenerOptions) forTenant(name string, b backendpkg.Backend) WebListenerOptions {
+	o.Chain = b.GetChain()
+	o.APIKeys = o.TenantAPIKeys[name]
+	o.AuthzConfig.Tenant = name
+	return o
+}
+
+func (t tenantRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	header := strings.TrimSpace(r.Header.Get(HeaderTenant))
+	name, rest, inPath := tenantFromPath(r.URL.Path)
+	switch {
+	case inPath && header != "" && header != name:
+		http.Error(w, fmt.Sprintf("tenant %q in path conflicts with %s header %q", name, HeaderTenant, header), http.StatusBadRequest)
+		return
+	case !inPath:
+		name = header
+	}
+	if name == "" {
+		t.def.ServeHTTP(w, r)
+		return
+	}
+	h, ok := t.handlers[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("tenant %q not found", name), http.StatusNotFound)
+		return
+	}
+	if inPath {
+		r = r.Clone(r.Context())
+		r.URL.Path, r.URL.RawPath = rest, ""
+	}
+	h.ServeHTTP(w, r)
+}
+
+// tenantFromPath splits /api/tenants/{tenant}/rest into the tenant and
+// /api/rest.
+func tenantFromPath(path string) (string, string, bool) {
+	after, ok := strings.CutPrefix(path, tenantPathPrefix)
+	if !ok {
+		return "", path, false
+	}
+	name, rest, _ := strings.Cut(after, "/")
+	return name, "/api/" + rest, name != ""
+}
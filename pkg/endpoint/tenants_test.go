@@ -0,0 +1,97 @@
+package endpoint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/authz"
+	backendpkg "go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+func newTenantHandler(t *testing.T, opts WebListenerOptions) (http.Handler, *backendpkg.Tenants) {
+	t.Helper()
+	tenants, err := backendpkg.NewTenants([]string{"payments"})
+	if err != nil {
+		t.Fatalf("creating tenants: %v", err)
+	}
+	def := tenants.Default()
+	opts.Tenants = tenants
+	opts.Chain = def.GetChain()
+	h, stop := NewHandler(def.GetModel(), def.GetEventManager(), "http://localhost/api", opts)
+	t.Cleanup(stop)
+	return h, tenants
+}
+
+func serve(h http.Handler, path string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestTenantRouting(t *testing.T) {
+	h, tenants := newTenantHandler(t, WebListenerOptions{})
+	payments, _ := tenants.Get("payments")
+	id := uuid.New()
+	if err := payments.GetModel().AddSystem(system.NewSystem(id)); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	path := "/landscape/systems/" + id.String()
+
+	cases := []struct {
+		name   string
+		path   string
+		header map[string]string
+		want   int
+	}{
+		{"tenant path", "/api/tenants/payments" + path, nil, http.StatusOK},
+		{"tenant header", "/api" + path, map[string]string{HeaderTenant: "payments"}, http.StatusOK},
+		{"default tenant", "/api" + path, nil, http.StatusNotFound},
+		{"default tenant by name", "/api/tenants/default" + path, nil, http.StatusNotFound},
+		{"unknown tenant", "/api/tenants/retail" + path, nil, http.StatusNotFound},
+		{"conflicting header", "/api/tenants/payments" + path, map[string]string{HeaderTenant: "default"}, http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		if got := serve(h, c.path, c.header).Code; got != c.want {
+			t.Errorf("%s: status %d, want %d", c.name, got, c.want)
+		}
+	}
+
+	w := serve(h, "/api/tenants/payments/events/history", nil)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), id.String()) {
+		t.Errorf("tenant history must contain the tenant's event, got %d %s", w.Code, w.Body.String())
+	}
+	w = serve(h, "/api/events/history", nil)
+	if strings.Contains(w.Body.String(), id.String()) {
+		t.Error("default history must not contain events of other tenants")
+	}
+}
+
+func TestTenantMembership(t *testing.T) {
+	h, tenants := newTenantHandler(t, WebListenerOptions{
+		TrustAuthHeaders: true,
+		AuthzConfig:      authz.Config{TenantGroupPrefix: "tenant:"},
+	})
+	payments, _ := tenants.Get("payments")
+	s := system.NewSystem(uuid.New())
+	s.GetAnnotations().Add(authz.OwnerIdentitiesKey, "alice")
+	if err := payments.GetModel().AddSystem(s); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	path := "/api/tenants/payments/landscape/systems/" + s.GetSystemId().String()
+
+	if got := serve(h, path, map[string]string{authz.HeaderAuthSubject: "alice"}).Code; got != http.StatusNotFound {
+		t.Errorf("owner outside the tenant group: status %d, want 404", got)
+	}
+	member := map[string]string{authz.HeaderAuthSubject: "alice", authz.HeaderAuthGroups: "tenant:payments"}
+	if got := serve(h, path, member).Code; got != http.StatusOK {
+		t.Errorf("owner in the tenant group: status %d, want 200", got)
+	}
+}
@@ -18,7 +18,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/audit"
 	"go.emeland.io/modelsrv/pkg/authz"
+	backendpkg "go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/metrics"
 	"go.emeland.io/modelsrv/pkg/model"
@@ -28,10 +31,39 @@ import (
 // WebListenerOptions configures the web API listener.
 type WebListenerOptions struct {
 	TrustAuthHeaders bool
-	AuthzConfig      authz.Config
+	// Authenticator, when set, verifies bearer tokens on API requests and
+	// enables visibility enforcement like TrustAuthHeaders.
+	Authenticator authz.Authenticator
+	// APIKeys, when set, accepts its API keys as bearer tokens, enables the
+	// key management endpoints and enables visibility enforcement.
+	APIKeys     *authz.APIKeyStore
+	AuthzConfig authz.Config
 	// Logger is used for endpoint lifecycle messages and HTTP request logging.
 	// When nil, a no-op logger is used (no output).
 	Logger *zap.SugaredLogger
+	// Chain, when set, has its per-filter statistics exported on /metrics and
+	// enables Context ownership inheritance for visibility.
+	Chain eventfilter.Chain
+	// Audit, when set, receives one entry per API call at AuditLevel.
+	Audit      audit.AuditSink
+	AuditLevel audit.Level
+	// Tenants, when set, serves the API of every tenant under
+	// /api/tenants/{tenant}/ or for requests with a HeaderTenant header.
+	// The landscape passed to NewHandler or StartWebListener serves requests
+	// naming no tenant and should be the default tenant's.
+	Tenants *backendpkg.Tenants
+	// TenantAPIKeys holds the API keys of tenants other than the default;
+	// keys only authenticate within their tenant.
+	TenantAPIKeys map[string]*authz.APIKeyStore
+}
+
+// handlerOptions returns the authentication settings of the API handler.
+func (o WebListenerOptions) handlerOptions() oapi.ApiHandlerOptions {
+	auth := o.Authenticator
+	if o.APIKeys != nil {
+		auth = authz.WithAPIKeys(o.APIKeys, o.Authenticator)
+	}
+	return oapi.ApiHandlerOptions{TrustAuthHeaders: o.TrustAuthHeaders, Authenticator: auth}
 }
 
 var (
@@ -41,8 +73,38 @@ var (
 	metricsHandler http.Handler
 	metricsReg     *prometheus.Registry
 	endpointLog    *zap.SugaredLogger // set by StartWebListener; used by Stop/Metrics helpers
+	stopAuthzIndex = func() {}        // unregisters the Context index filter of StartWebListener
 )
 
+// newAuthzEvaluator returns nil when no authentication is configured. With a
+// Chain, Context ownership is inherited down the Context hierarchy; the index
+// behind that rule is kept current by a pass-through filter, which the
+// returned func unregisters.
+func newAuthzEvaluator(backend model.Model, opts WebListenerOptions) (*authz.Evaluator, func()) {
+	if !opts.TrustAuthHeaders && opts.Authenticator == nil && opts.APIKeys == nil {
+		return nil, func() {}
+	}
+	if opts.AuthzConfig.Tenant == "" {
+		opts.AuthzConfig.Tenant = backendpkg.DefaultTenant
+	}
+	rules := []authz.VisibilityRule{authz.NewBindingRule(backend)}
+	if opts.Chain == nil {
+		return authz.NewEvaluator(opts.AuthzConfig, rules...), func() {}
+	}
+
+	idx := authz.NewContextIndex(backend)
+	id := opts.Chain.RegisterFilter(eventfilter.Filter{
+		DisplayName: "Authorization context index",
+		Description: "Keeps the Context hierarchy used for inherited visibility current; passes every event through.",
+		Fn: func(_ model.Model, ev events.Event) []events.Event {
+			idx.Observe(ev)
+			return []events.Event{ev}
+		},
+	})
+	rules = append(rules, authz.NewContextInheritanceRule(idx))
+	return authz.NewEvaluator(opts.AuthzConfig, rules...), func() { opts.Chain.Unregister(id) }
+}
+
 func ensureLogger(log *zap.SugaredLogger) *zap.SugaredLogger {
 	if log != nil {
 		return log
@@ -55,30 +117,62 @@ func ensureLogger(log *zap.SugaredLogger) *zap.SugaredLogger {
 // http.Server. Use this when embedding modelsrv behind additional middleware
 // (e.g. an auth layer).
 //
+// The returned func unregisters the event filters the handler registered on
+// opts.Chain; call it once the handler is no longer served.
+//
 // Note: StartMetricsListener is not compatible with NewHandler; it only works
 // with StartWebListener which manages its own server lifecycle.
-func NewHandler(backend model.Model, eventMgr events.EventManager, baseURL string, opts WebListenerOptions) http.Handler {
+func NewHandler(backend model.Model, eventMgr events.EventManager, baseURL string, opts WebListenerOptions) (http.Handler, func()) {
 	log := ensureLogger(opts.Logger)
 
-	var authzEval *authz.Evaluator
-	if opts.TrustAuthHeaders {
-		authzEval = authz.NewEvaluator(opts.AuthzConfig)
-	}
-	server := oapi.NewApiServer(backend, eventMgr, baseURL, authzEval)
-	strict := oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: opts.TrustAuthHeaders})
-
 	reg := prometheus.NewRegistry()
 	reg.MustRegister(collectors.NewGoCollector())
 	reg.MustRegister(metrics.NewCollector(backend))
+	reg.MustRegister(metrics.NewFindingCollector(backend))
+	if opts.Chain != nil {
+		reg.MustRegister(metrics.NewFilterCollector(opts.Chain))
+	}
 
-	r := mux.NewRouter()
-	r.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	return newRouter(backend, eventMgr, baseURL, opts, promhttp.HandlerFor(reg, promhttp.HandlerOpts{}), log)
+}
+
+// newRouter serves metrics, the Swagger UI and the API of every tenant. The
+// returned func unregisters the authorization index filters.
+func newRouter(backend model.Model, eventMgr events.EventManager, baseURL string, opts WebListenerOptions, metricsH http.Handler, log *zap.SugaredLogger) (http.Handler, func()) {
+	if opts.Tenants != nil && opts.AuthzConfig.Tenant == "" {
+		opts.AuthzConfig.Tenant = backendpkg.DefaultTenant
+	}
+	api, stop := apiHandler(backend, eventMgr, baseURL, opts, log)
+	if opts.Tenants != nil {
+		api, stop = withTenants(api, stop, baseURL, opts, log)
+	}
 
+	r := mux.NewRouter()
+	r.Handle("/metrics", metricsH)
 	spa := spaHandler{staticPath: "/", indexPath: "/swagger/index.html", log: log}
 	r.PathPrefix("/swagger").Handler(spa)
-	r.HandleFunc("/api/events/history", server.HandleGetEventsHistory).Methods("GET")
+	r.PathPrefix("/api/").Handler(api)
+	return requestIDMiddleware(requestLoggingMiddleware(log)(r)), stop
+}
 
-	return requestLoggingMiddleware(log)(oapi.HandlerFromMuxWithBaseURL(strict, r, "/api"))
+// apiHandler builds the audited API of one landscape. The returned func
+// unregisters the authorization index filter.
+func apiHandler(backend model.Model, eventMgr events.EventManager, baseURL string, opts WebListenerOptions, log *zap.SugaredLogger) (http.Handler, func()) {
+	authzEval, unregister := newAuthzEvaluator(backend, opts)
+	server := oapi.NewApiServer(backend, eventMgr, baseURL, authzEval)
+	server.APIKeys = opts.APIKeys
+	handlerOpts := opts.handlerOptions()
+	strict := oapi.NewApiHandler(server, handlerOpts)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/events/history", oapi.WithAuthentication(server.HandleGetEventsHistory, handlerOpts)).Methods("GET")
+	h := oapi.HandlerFromMuxWithBaseURL(strict, r, "/api")
+
+	tenant := ""
+	if opts.Tenants != nil {
+		tenant = opts.AuthzConfig.Tenant
+	}
+	return auditMiddleware(opts.Audit, opts.AuditLevel, backend, authzEval != nil, tenant, log)(h), unregister
 }
 
 // StartWebListener starts the web endpoint serving the Swagger-UI and API
@@ -96,34 +190,26 @@ func StartWebListener(backend model.Model, eventMgr events.EventManager, addr st
 	webListener = ln
 
 	baseURL := fmt.Sprintf("http://%s/api", ln.Addr().String())
-	var authzEval *authz.Evaluator
-	if opts.TrustAuthHeaders {
-		authzEval = authz.NewEvaluator(opts.AuthzConfig)
-	}
-	server := oapi.NewApiServer(backend, eventMgr, baseURL, authzEval)
-	strict := oapi.NewApiHandler(server, oapi.ApiHandlerOptions{TrustAuthHeaders: opts.TrustAuthHeaders})
 
 	metricsReg = prometheus.NewRegistry()
 	metricsReg.MustRegister(collectors.NewGoCollector())
 	metricsReg.MustRegister(metrics.NewCollector(backend))
+	metricsReg.MustRegister(metrics.NewFindingCollector(backend))
+	if opts.Chain != nil {
+		metricsReg.MustRegister(metrics.NewFilterCollector(opts.Chain))
+	}
 
-	r := mux.NewRouter()
 	// Indirection: metricsHandler can be swapped to a redirect by StartMetricsListener.
 	metricsHandler = promhttp.HandlerFor(metricsReg, promhttp.HandlerOpts{})
-	r.Handle("/metrics", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	h, unregister := newRouter(backend, eventMgr, baseURL, opts, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		metricsHandler.ServeHTTP(w, req)
-	}))
-
-	spa := spaHandler{staticPath: "/", indexPath: "/swagger/index.html", log: log}
-	r.PathPrefix("/swagger").Handler(spa)
-	r.HandleFunc("/api/events/history", server.HandleGetEventsHistory).Methods("GET")
-
-	h := oapi.HandlerFromMuxWithBaseURL(strict, r, "/api")
+	}), log)
+	stopAuthzIndex = unregister
 
 	log.Infow("starting web endpoint", "address", ln.Addr().String())
 
 	webServer = &http.Server{
-		Handler: requestLoggingMiddleware(log)(h),
+		Handler: h,
 	}
 
 	srv := webServer
@@ -143,6 +229,8 @@ func StopWebListener() {
 			log.Errorw("error shutting down metrics server", "error", err)
 		}
 	}
+	stopAuthzIndex()
+	stopAuthzIndex = func() {}
 	if webServer == nil {
 		return
 	}
@@ -234,8 +322,8 @@ func (h spaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // requestLoggingMiddleware logs each HTTP request with method, path, status
-// code, and duration. API requests log at INFO (5xx at WARN). Infrastructure
-// paths (/metrics, /swagger) log at DEBUG to avoid noise.
+// code, request id and duration. API requests log at INFO (5xx at WARN).
+// Infrastructure paths (/metrics, /swagger) log at DEBUG to avoid noise.
 func requestLoggingMiddleware(log *zap.SugaredLogger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -249,6 +337,9 @@ func requestLoggingMiddleware(log *zap.SugaredLogger) func(http.Handler) http.Ha
 				"status", sw.status,
 				"duration", time.Since(start).String(),
 			}
+			if id := r.Header.Get(HeaderRequestID); id != "" {
+				fields = append(fields, "requestId", id)
+			}
 			switch {
 			case strings.HasPrefix(path, "/metrics") || strings.HasPrefix(path, "/swagger"):
 				log.Debugw("http request", fields...)
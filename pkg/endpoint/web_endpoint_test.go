@@ -3,11 +3,17 @@ package endpoint
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/google/uuid"
 	eventmgr "go.emeland.io/modelsrv/internal/events"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/model"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/system"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
@@ -215,3 +221,81 @@ func TestRequestLogging_SwaggerLogsAtDebug(t *testing.T) {
 	}
 	t.Error("no 'http request' log entry found for /swagger")
 }
+
+func TestNewHandler_ContextOwnershipFollowsModelChanges(t *testing.T) {
+	chain := eventfilter.NewChain(nil)
+	backend, err := model.NewModel(eventfilter.NewFilteringSink(chain, events.NewListSink()))
+	if err != nil {
+		t.Fatalf("failed to create model: %v", err)
+	}
+	chain.SetModel(backend)
+	eventMgr, err := eventmgr.NewEventManager()
+	if err != nil {
+		t.Fatalf("failed to create event manager: %v", err)
+	}
+
+	owned := mdlctx.NewContext(uuid.New())
+	owned.GetAnnotations().Add(authz.OwnerIdentitiesKey, "alice")
+	other := mdlctx.NewContext(uuid.New())
+	si := system.NewSystemInstance(uuid.New())
+	si.SetContextRef(&mdlctx.ContextRef{ContextId: other.GetContextId()})
+	for _, err := range []error{backend.AddContext(owned), backend.AddContext(other), backend.AddSystemInstance(si)} {
+		if err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	h, stop := NewHandler(backend, eventMgr, "http://localhost/api", WebListenerOptions{TrustAuthHeaders: true, Chain: chain})
+	defer stop()
+	canSeeAs := func(subject string) bool {
+		req := httptest.NewRequest("GET", "/api/landscape/system-instances/"+si.GetInstanceId().String(), nil)
+		req.Header.Set(authz.HeaderAuthSubject, subject)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code == http.StatusOK
+	}
+	canSee := func() bool { return canSeeAs("alice") }
+
+	if canSee() {
+		t.Fatal("instance outside the owned Context must be hidden")
+	}
+	other.SetParentById(owned.GetContextId())
+	if !canSee() {
+		t.Fatal("instance must become visible once its Context moves under the owned Context")
+	}
+
+	owned.GetAnnotations().Add(authz.OwnerIdentitiesKey, "bob")
+	if canSee() {
+		t.Fatal("instance must be hidden once alice no longer owns the ancestor Context")
+	}
+	if !canSeeAs("bob") {
+		t.Fatal("instance must become visible to the new owner of the ancestor Context")
+	}
+	owned.GetAnnotations().Delete(authz.OwnerIdentitiesKey)
+	if canSeeAs("bob") {
+		t.Fatal("instance must be hidden once the ancestor Context has no owner")
+	}
+}
+
+func TestNewHandler_StopUnregistersFilters(t *testing.T) {
+	chain := eventfilter.NewChain(nil)
+	backend, err := model.NewModel(eventfilter.NewFilteringSink(chain, events.NewListSink()))
+	if err != nil {
+		t.Fatalf("failed to create model: %v", err)
+	}
+	chain.SetModel(backend)
+	eventMgr, err := eventmgr.NewEventManager()
+	if err != nil {
+		t.Fatalf("failed to create event manager: %v", err)
+	}
+
+	before := len(chain.Stats())
+	_, stop := NewHandler(backend, eventMgr, "http://localhost/api", WebListenerOptions{TrustAuthHeaders: true, Chain: chain})
+	if len(chain.Stats()) == before {
+		t.Fatal("handler must register its Context index filter")
+	}
+	stop()
+	if got := len(chain.Stats()); got != before {
+		t.Fatalf("filters after stop = %d, want %d", got, before)
+	}
+}
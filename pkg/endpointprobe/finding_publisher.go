@@ -76,13 +76,13 @@ func (p *ModelFindingPublisher) TypeID(kind finding.FindingKind) uuid.UUID {
 func ensureFindingType(m model.Model, logger *zap.SugaredLogger, kind finding.FindingKind) uuid.UUID {
 	name := string(kind)
 	if ft := m.GetFindingTypeByName(name); ft != nil {
-		backfillFindingTypeDescription(m, logger, ft, kind)
+		backfillFindingType(m, logger, ft, kind)
 		return ft.GetFindingTypeId()
 	}
 
 	id := finding.TypeIDForKind(kind)
 	if ft := m.GetFindingTypeById(id); ft != nil {
-		backfillFindingTypeDescription(m, logger, ft, kind)
+		backfillFindingType(m, logger, ft, kind)
 		return id
 	}
 
@@ -91,22 +91,31 @@ func ensureFindingType(m model.Model, logger *zap.SugaredLogger, kind finding.Fi
 	if desc := finding.DescriptionForKind(kind); desc != "" {
 		ft.SetDescription(desc)
 	}
+	ft.SetSeverity(finding.SeverityForKind(kind))
 	if err := m.AddFindingType(ft); err != nil {
 		logger.Warnw("AddFindingType failed", "kind", kind, "id", id, "error", err)
 	}
 	return id
 }
 
-func backfillFindingTypeDescription(m model.Model, logger *zap.SugaredLogger, ft finding.FindingType, kind finding.FindingKind) {
-	desc := finding.DescriptionForKind(kind)
-	if desc == "" || ft.GetDescription() != "" {
+func backfillFindingType(m model.Model, logger *zap.SugaredLogger, ft finding.FindingType, kind finding.FindingKind) {
+	desc := ft.GetDescription()
+	if desc == "" {
+		desc = finding.DescriptionForKind(kind)
+	}
+	sev := ft.GetSeverity()
+	if sev == "" {
+		sev = finding.SeverityForKind(kind)
+	}
+	if desc == ft.GetDescription() && sev == ft.GetSeverity() {
 		return
 	}
 	updated := finding.NewFindingType(ft.GetFindingTypeId())
 	updated.SetDisplayName(ft.GetDisplayName())
 	updated.SetDescription(desc)
+	updated.SetSeverity(sev)
 	if err := m.AddFindingType(updated); err != nil {
-		logger.Warnw("backfill FindingType description failed",
+		logger.Warnw("backfill FindingType failed",
 			"kind", kind,
 			"id", ft.GetFindingTypeId(),
 			"error", err,
@@ -3,6 +3,7 @@ package eventfilter
 import (
 	"log"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.emeland.io/modelsrv/pkg/events"
@@ -15,6 +16,8 @@ type entry struct {
 	displayName string
 	description string
 	fn          FilterFunc
+	disabled    bool
+	stats       *filterStats
 }
 
 type chainData struct {
@@ -37,17 +40,55 @@ func NewChain(m model.Model) Chain {
 
 // RegisterFilter implements [Chain].
 func (c *chainData) RegisterFilter(f Filter) FilterID {
-	id := FilterID(uuid.New())
+	e := entry{
+		id:          FilterID(uuid.New()),
+		displayName: f.DisplayName,
+		description: f.Description,
+		fn:          f.Fn,
+		stats:       newFilterStats(),
+	}
 	c.mu.Lock()
-	c.filters = append(c.filters, entry{
+	c.filters = append(c.filters, e)
+	c.mu.Unlock()
+	c.syncFilterRuleToModel(e)
+	return e.id
+}
+
+// RegisterFilterWithID implements [Chain].
+func (c *chainData) RegisterFilterWithID(id FilterID, f Filter) {
+	e := entry{
 		id:          id,
 		displayName: f.DisplayName,
 		description: f.Description,
 		fn:          f.Fn,
-	})
+		stats:       newFilterStats(),
+	}
+	c.mu.Lock()
+	replaced := false
+	for i := range c.filters {
+		if c.filters[i].id == id {
+			// Keep the runtime state of the previous version.
+			e.disabled = c.filters[i].disabled
+			e.stats = c.filters[i].stats
+			c.filters[i] = e
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		if c.model != nil {
+			if fr := c.model.GetFilterRuleById(uuid.UUID(id)); fr != nil {
+				e.disabled = fr.GetDisabled()
+			}
+		}
+		c.filters = append(c.filters, e)
+	}
+	m := c.model
 	c.mu.Unlock()
-	c.syncFilterRuleToModel(id, f.DisplayName, f.Description)
-	return id
+	if replaced {
+		clearFilterFailed(m, id)
+	}
+	c.syncFilterRuleToModel(e)
 }
 
 // Register implements [Chain].
@@ -60,8 +101,59 @@ func (c *chainData) Unregister(id FilterID) {
 	if !c.removeFilter(id) {
 		return // unknown ID — no model work
 	}
+	c.mu.RLock()
+	m := c.model
+	c.mu.RUnlock()
+	clearFilterFailed(m, id)
 	c.removeFilterRuleFromModel(id)
 }
+
+// SetEnabled implements [Chain].
+func (c *chainData) SetEnabled(id FilterID, enabled bool) bool {
+	if !c.setDisabled(id, !enabled) {
+		return false
+	}
+	c.mu.RLock()
+	m := c.model
+	c.mu.RUnlock()
+	if m == nil {
+		return true
+	}
+	if fr := m.GetFilterRuleById(uuid.UUID(id)); fr != nil && fr.GetDisabled() == enabled {
+		fr.SetDisabled(!enabled)
+	}
+	return true
+}
+
+func (c *chainData) setDisabled(id FilterID, disabled bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.filters {
+		if c.filters[i].id == id {
+			c.filters[i].disabled = disabled
+			return true
+		}
+	}
+	return false
+}
+
+// Stats implements [Chain].
+func (c *chainData) Stats() []FilterStats {
+	c.mu.RLock()
+	snapshot := append([]entry(nil), c.filters...)
+	c.mu.RUnlock()
+
+	out := make([]FilterStats, 0, len(snapshot))
+	for _, e := range snapshot {
+		st := e.stats.snapshot()
+		st.ID = e.id
+		st.DisplayName = e.displayName
+		st.Disabled = e.disabled
+		out = append(out, st)
+	}
+	return out
+}
+
 func (c *chainData) removeFilter(id FilterID) bool {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -78,7 +170,7 @@ func (c *chainData) removeFilter(id FilterID) bool {
 func (c *chainData) SetModel(m model.Model) {
 	snapshot := c.swapModel(m)
 	for _, e := range snapshot {
-		c.syncFilterRuleToModel(e.id, e.displayName, e.description)
+		c.syncFilterRuleToModel(e)
 	}
 }
 
@@ -96,7 +188,11 @@ func (c *chainData) swapModel(m model.Model) []entry {
 // It snapshots the current filter slice and model reference, then flat-maps
 // each FilterFunc over the event batch: every filter receives each event in
 // the current batch and its outputs replace the batch for the next filter.
+// Disabled filters are skipped. A FilterRule event first updates the disabled
+// flag of the filter it documents, so the change applies to that event.
 func (c *chainData) Apply(ev events.Event) []events.Event {
+	c.observeFilterRule(ev)
+
 	c.mu.RLock()
 	snapshot := make([]entry, len(c.filters))
 	copy(snapshot, c.filters)
@@ -109,16 +205,52 @@ func (c *chainData) Apply(ev events.Event) []events.Event {
 
 	current := []events.Event{ev}
 	for _, e := range snapshot {
+		if e.disabled {
+			continue
+		}
 		var next []events.Event
 		for _, inEv := range current {
-			next = append(next, e.fn(m, inEv)...)
+			next = append(next, c.run(m, e, inEv)...)
 		}
 		current = next
 	}
 	return current
 }
 
-func (c *chainData) syncFilterRuleToModel(id FilterID, displayName, description string) {
+// run calls the filter of e on ev, recording its latency and event counts. A
+// panicking filter passes ev on unchanged and raises a FilterFailed finding
+// on its FilterRule.
+func (c *chainData) run(m model.Model, e entry, ev events.Event) (out []events.Event) {
+	start := time.Now()
+	defer func() {
+		r := recover()
+		if r != nil {
+			out = []events.Event{ev}
+		}
+		e.stats.record(len(out), time.Since(start), r != nil)
+		if r != nil {
+			log.Printf("eventfilter: filter %q (%s) panicked on %s %s: %v", e.displayName, uuid.UUID(e.id), ev.ResourceType, ev.ResourceId, r)
+			raiseFilterFailed(m, e, r)
+		}
+	}()
+	return e.fn(m, ev)
+}
+
+// observeFilterRule mirrors the disabled flag of a created or updated
+// FilterRule onto the filter registered under the rule's id.
+func (c *chainData) observeFilterRule(ev events.Event) {
+	if ev.ResourceType != events.FilterRuleResource || len(ev.Objects) == 0 {
+		return
+	}
+	if ev.Operation != events.CreateOperation && ev.Operation != events.UpdateOperation {
+		return
+	}
+	if fr, ok := ev.Objects[0].(mdlfilterrule.FilterRule); ok {
+		c.setDisabled(FilterID(fr.GetRuleId()), fr.GetDisabled())
+	}
+}
+
+func (c *chainData) syncFilterRuleToModel(e entry) {
 	c.mu.RLock()
 	m := c.model
 	c.mu.RUnlock()
@@ -126,14 +258,15 @@ func (c *chainData) syncFilterRuleToModel(id FilterID, displayName, description
 		return
 	}
 
-	ruleID := uuid.UUID(id)
+	ruleID := uuid.UUID(e.id)
 	if m.GetFilterRuleById(ruleID) != nil {
 		return
 	}
 
 	fr := mdlfilterrule.NewFilterRule(ruleID)
-	fr.SetDisplayName(displayName)
-	fr.SetDescription(description)
+	fr.SetDisplayName(e.displayName)
+	fr.SetDescription(e.description)
+	fr.SetDisabled(e.disabled)
 	if err := m.AddFilterRule(fr); err != nil {
 		log.Printf("eventfilter: AddFilterRule id=%s: %v", ruleID, err)
 	}
@@ -148,6 +281,9 @@ func (c *chainData) removeFilterRuleFromModel(id FilterID) {
 	}
 
 	ruleID := uuid.UUID(id)
+	if m.GetFilterRuleById(ruleID) == nil {
+		return // already deleted, e.g. a declarative rule removed from the model
+	}
 	if err := m.DeleteFilterRuleById(ruleID); err != nil {
 		log.Printf("eventfilter: DeleteFilterRuleById id=%s: %v", ruleID, err)
 	}
@@ -0,0 +1,135 @@
+package eventfilter_test
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("Chain isolation and observability", func() {
+	var (
+		chain      eventfilter.Chain
+		m          model.Model
+		downstream *events.ListSink
+	)
+
+	BeforeEach(func() {
+		var err error
+		downstream = events.NewListSink()
+		chain = eventfilter.NewChain(nil)
+		m, err = model.NewModel(eventfilter.NewFilteringSink(chain, downstream))
+		Expect(err).NotTo(HaveOccurred())
+		chain.SetModel(m)
+	})
+
+	addSystem := func(name string) system.System {
+		sys := system.NewSystem(uuid.New())
+		sys.SetDisplayName(name)
+		Expect(m.AddSystem(sys)).To(Succeed())
+		return sys
+	}
+
+	statsOf := func(id eventfilter.FilterID) eventfilter.FilterStats {
+		for _, st := range chain.Stats() {
+			if st.ID == id {
+				return st
+			}
+		}
+		Fail("no stats for filter")
+		return eventfilter.FilterStats{}
+	}
+
+	It("recovers a panicking filter and raises a FilterFailed finding", func() {
+		var after int
+		id := chain.RegisterFilter(eventfilter.Filter{
+			DisplayName: "Exploding filter",
+			Fn: func(_ model.Model, ev events.Event) []events.Event {
+				if ev.ResourceType == events.SystemResource {
+					panic("boom")
+				}
+				return []events.Event{ev}
+			},
+		})
+		chain.Register(func(_ model.Model, ev events.Event) []events.Event {
+			if ev.ResourceType == events.SystemResource {
+				after++
+			}
+			return []events.Event{ev}
+		})
+
+		sys := addSystem("billing")
+		Expect(m.GetSystemById(sys.GetSystemId())).NotTo(BeNil())
+		Expect(after).To(Equal(1), "later filters still see the event")
+
+		f := m.GetFindingById(eventfilter.FilterFailedFindingID(id))
+		Expect(f).NotTo(BeNil())
+		Expect(f.GetFindingTypeId()).To(Equal(finding.TypeIDForKind(finding.FilterFailed)))
+		Expect(f.GetDescription()).To(ContainSubstring("boom"))
+		Expect(f.GetResources()[0].ResourceId).To(Equal(uuid.UUID(id)))
+		Expect(statsOf(id).Panics).To(Equal(uint64(1)))
+
+		chain.Unregister(id)
+		Expect(m.GetFindingById(eventfilter.FilterFailedFindingID(id))).To(BeNil())
+	})
+
+	It("counts events in and out and records latency", func() {
+		id := chain.RegisterFilter(eventfilter.Filter{
+			DisplayName: "Drop systems",
+			Fn: func(_ model.Model, ev events.Event) []events.Event {
+				if ev.ResourceType == events.SystemResource {
+					return nil
+				}
+				return []events.Event{ev}
+			},
+		})
+		before := statsOf(id)
+		addSystem("a")
+		addSystem("b")
+
+		st := statsOf(id)
+		Expect(st.DisplayName).To(Equal("Drop systems"))
+		Expect(st.EventsIn - before.EventsIn).To(Equal(uint64(2)))
+		Expect(st.EventsOut - before.EventsOut).To(Equal(uint64(0)))
+		Expect(st.LatencyBucketCounts).To(HaveLen(len(eventfilter.LatencyBuckets)))
+		Expect(st.LatencyBucketCounts[len(st.LatencyBucketCounts)-1]).To(Equal(st.EventsIn))
+	})
+
+	It("skips disabled filters and reflects the state on the FilterRule", func() {
+		id := chain.RegisterFilter(eventfilter.Filter{
+			DisplayName: "Drop everything",
+			Fn:          func(_ model.Model, _ events.Event) []events.Event { return nil },
+		})
+		Expect(chain.SetEnabled(id, false)).To(BeTrue())
+		Expect(m.GetFilterRuleById(uuid.UUID(id)).GetDisabled()).To(BeTrue())
+		Expect(statsOf(id).Disabled).To(BeTrue())
+
+		addSystem("visible")
+		Expect(downstream.GetEvents()).NotTo(BeEmpty())
+
+		// Flipping the flag on the resource re-enables the filter.
+		m.GetFilterRuleById(uuid.UUID(id)).SetDisabled(false)
+		Expect(statsOf(id).Disabled).To(BeFalse())
+		n := len(downstream.GetEvents())
+		addSystem("hidden")
+		Expect(downstream.GetEvents()).To(HaveLen(n))
+
+		Expect(chain.SetEnabled(eventfilter.FilterID(uuid.New()), true)).To(BeFalse())
+	})
+
+	It("keeps the disabled state when a filter is replaced under its id", func() {
+		id := eventfilter.FilterID(uuid.New())
+		drop := eventfilter.Filter{DisplayName: "v1", Fn: func(_ model.Model, _ events.Event) []events.Event { return nil }}
+		chain.RegisterFilterWithID(id, drop)
+		chain.SetEnabled(id, false)
+
+		drop.DisplayName = "v2"
+		chain.RegisterFilterWithID(id, drop)
+		Expect(statsOf(id).Disabled).To(BeTrue())
+	})
+})
@@ -42,6 +42,7 @@ var _ = Describe("Chain", func() {
 		mockModel.EXPECT().GetFilterRuleById(gomock.Any()).Return(nil).AnyTimes()
 		mockModel.EXPECT().AddFilterRule(gomock.Any()).Return(nil).AnyTimes()
 		mockModel.EXPECT().DeleteFilterRuleById(gomock.Any()).Return(nil).AnyTimes()
+		mockModel.EXPECT().GetFindingById(gomock.Any()).Return(nil).AnyTimes()
 		chain = eventfilter.NewChain(mockModel)
 	})
 
@@ -261,6 +262,7 @@ var _ = Describe("FilteringSink", func() {
 		mockModel.EXPECT().GetFilterRuleById(gomock.Any()).Return(nil).AnyTimes()
 		mockModel.EXPECT().AddFilterRule(gomock.Any()).Return(nil).AnyTimes()
 		mockModel.EXPECT().DeleteFilterRuleById(gomock.Any()).Return(nil).AnyTimes()
+		mockModel.EXPECT().GetFindingById(gomock.Any()).Return(nil).AnyTimes()
 		downstream = events.NewListSink()
 		chain = eventfilter.NewChain(mockModel)
 		sink = eventfilter.NewFilteringSink(chain, downstream)
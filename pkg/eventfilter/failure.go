@@ -0,0 +1,69 @@
+package eventfilter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// SHA-1 namespace for the FilterFailed finding raised on a filter's rule.
+var filterFailedNamespace = uuid.MustParse("4c2a9e71-6b3d-4f08-9a5e-1d7c3b2f8e60")
+
+// FilterFailedFindingID returns the id of the FilterFailed finding raised for
+// the filter registered under id.
+func FilterFailedFindingID(id FilterID) uuid.UUID {
+	ruleID := uuid.UUID(id)
+	return uuid.NewSHA1(filterFailedNamespace, ruleID[:])
+}
+
+// raiseFilterFailed records a panic of e's filter as a FilterFailed finding on
+// its FilterRule. The description only names the panic value, so repeated
+// panics with the same cause do not rewrite the finding.
+func raiseFilterFailed(m model.Model, e entry, cause any) {
+	if m == nil || !e.stats.beginRaise() {
+		return
+	}
+	defer e.stats.endRaise()
+
+	id := FilterFailedFindingID(e.id)
+	description := fmt.Sprintf("FilterFailed: filter %q panicked: %v", e.displayName, cause)
+	if cur := m.GetFindingById(id); cur != nil && cur.GetDescription() == description {
+		return
+	}
+
+	typeID, err := finding.EnsureType(m, finding.FilterFailed)
+	if err != nil {
+		log.Printf("eventfilter: %v", err)
+	}
+	f := finding.NewFinding(id)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName("Filter chain check")
+	f.SetDescription(description)
+	f.SetResources([]*common.ResourceRef{
+		{ResourceId: uuid.UUID(e.id), ResourceType: events.FilterRuleResource},
+	})
+	if err := m.AddFinding(f); err != nil {
+		log.Printf("eventfilter: AddFinding id=%s: %v", id, err)
+	}
+}
+
+// clearFilterFailed deletes the FilterFailed finding of the filter with id, if
+// any. It runs when the filter is replaced or unregistered.
+func clearFilterFailed(m model.Model, id FilterID) {
+	if m == nil {
+		return
+	}
+	fid := FilterFailedFindingID(id)
+	if m.GetFindingById(fid) == nil {
+		return
+	}
+	if err := m.DeleteFindingById(fid); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+		log.Printf("eventfilter: DeleteFindingById id=%s: %v", fid, err)
+	}
+}
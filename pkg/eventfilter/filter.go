@@ -36,17 +36,35 @@ type Chain interface {
 	// with a model, a corresponding FilterRule resource is created.
 	RegisterFilter(f Filter) FilterID
 
+	// RegisterFilterWithID registers f under a caller-chosen id. When a filter
+	// with that id is already registered it is replaced in place, keeping its
+	// position in the chain. A FilterRule is only created when none exists
+	// under id, so declarative rules keep their user-authored resource.
+	RegisterFilterWithID(id FilterID, f Filter)
+
 	// Register appends fn to the chain without metadata. Prefer [RegisterFilter]
 	// so filter rules are discoverable in the landscape model.
 	Register(fn FilterFunc) FilterID
 
-	// Unregister removes the filter identified by id from the chain.
+	// Unregister removes the filter identified by id from the chain together
+	// with its FilterRule, if that is still in the model.
 	// It is a no-op if id was never registered or was already removed.
 	Unregister(id FilterID)
 
-	// Apply runs ev through every registered FilterFunc in order,
+	// SetEnabled enables or disables the filter identified by id at runtime
+	// and records the state in the disabled flag of its FilterRule. A disabled
+	// filter is skipped: events pass it unchanged. Updating that flag on the
+	// FilterRule resource has the same effect. Returns false for unknown ids.
+	SetEnabled(id FilterID, enabled bool) bool
+
+	// Stats returns per-filter event counters and latency in chain order.
+	Stats() []FilterStats
+
+	// Apply runs ev through every enabled FilterFunc in order,
 	// flat-mapping the outputs so that one incoming event may produce
-	// 0, 1, or many outgoing events.
+	// 0, 1, or many outgoing events. A FilterFunc that panics is treated as
+	// passing its event through unchanged and gets a FilterFailed finding
+	// on its FilterRule.
 	Apply(ev events.Event) []events.Event
 
 	// SetModel replaces the model reference passed to every FilterFunc.
@@ -0,0 +1,291 @@
+// Package lifecycle provides an [eventfilter.FilterFunc] that raises product
+// lifecycle findings on [artifact.ArtifactInstance] resources.
+//
+// An ArtifactInstance is linked to a [product.ProductionVersion] when the
+// artefact it deploys (its ArtifactRef) is listed in that version's Artefacts.
+// The version's dates decide which finding applies, most severe first:
+//
+//	TerminatedFrom ≤ now   → [finding.ProductVersionTerminated]
+//	DeprecatedFrom ≤ now   → [finding.ProductVersionDeprecated]
+//	AvailableFrom  > now   → [finding.ProductVersionNotYetAvailable]
+//
+// Findings are re-evaluated when ArtifactInstances or Products change, and by
+// [Run] on a clock tick so that a finding appears once a date passes even
+// though no model change happened. The incoming event is always returned as-is.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/artifact"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	mdlprod "go.emeland.io/modelsrv/pkg/model/product"
+)
+
+// SHA-1 namespace so each (artifact instance id, kind) maps to one finding id.
+var lifecycleNamespace = uuid.MustParse("5d0c9b8a-7e6f-4a3b-b2c1-d0e9f8a7b6c5")
+
+const lifecycleFindingDisplayName = "Product lifecycle check"
+
+// lifecycleKinds lists the lifecycle finding kinds in decreasing severity.
+var lifecycleKinds = []finding.FindingKind{
+	finding.ProductVersionTerminated,
+	finding.ProductVersionDeprecated,
+	finding.ProductVersionNotYetAvailable,
+}
+
+func findingID(instanceID uuid.UUID, kind finding.FindingKind) uuid.UUID {
+	key := append(instanceID[:], []byte(kind)...)
+	return uuid.NewSHA1(lifecycleNamespace, key)
+}
+
+// New returns the lifecycle filter evaluated against the wall clock.
+func New() eventfilter.Filter {
+	return NewWithClock(time.Now)
+}
+
+// NewWithClock returns the lifecycle filter evaluated against now, which lets
+// tests pin the current time.
+func NewWithClock(now func() time.Time) eventfilter.Filter {
+	return eventfilter.Filter{
+		DisplayName: "Product lifecycle",
+		Description: "Raises findings on ArtifactInstances whose product version is deprecated, terminated, or not yet available.",
+		Fn:          filterFunc(now),
+	}
+}
+
+// NewFilterFunc returns the lifecycle filter function using the wall clock.
+func NewFilterFunc() eventfilter.FilterFunc {
+	return New().Fn
+}
+
+// EnsureWellKnownFindingTypes registers the lifecycle FindingType resources
+// missing from the model.
+func EnsureWellKnownFindingTypes(m model.Model) {
+	for _, kind := range lifecycleKinds {
+		if _, err := finding.EnsureType(m, kind); err != nil {
+			log.Printf("lifecycle: %v", err)
+		}
+	}
+}
+
+// ReconcileAll re-evaluates every ArtifactInstance in the model at now.
+func ReconcileAll(m model.Model, now time.Time) {
+	instances, err := m.GetArtifactInstances()
+	if err != nil {
+		log.Printf("lifecycle: ReconcileAll GetArtifactInstances: %v", err)
+		return
+	}
+	products := productsOf(m)
+	for _, ai := range instances {
+		if cur := m.GetArtifactInstanceById(ai.GetArtifactInstanceId()); cur != nil {
+			checkInstance(m, products, cur, now)
+		}
+	}
+}
+
+// Run calls [ReconcileAll] every interval until ctx is cancelled. A
+// non-positive interval disables the tick and returns immediately.
+func Run(ctx context.Context, m model.Model, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t := <-ticker.C:
+			ReconcileAll(m, t)
+		}
+	}
+}
+
+func filterFunc(now func() time.Time) eventfilter.FilterFunc {
+	return func(m model.Model, ev events.Event) []events.Event {
+		switch ev.ResourceType {
+		case events.ArtifactInstanceResource:
+			switch ev.Operation {
+			case events.CreateOperation, events.UpdateOperation:
+				if len(ev.Objects) > 0 {
+					if ai, ok := ev.Objects[0].(artifact.ArtifactInstance); ok {
+						checkInstance(m, productsOf(m), ai, now())
+					}
+				}
+			case events.DeleteOperation:
+				deleteKinds(m, ev.ResourceId, lifecycleKinds...)
+			}
+		case events.ProductResource:
+			switch ev.Operation {
+			case events.CreateOperation, events.UpdateOperation, events.DeleteOperation:
+				ReconcileAll(m, now())
+			}
+		}
+		return []events.Event{ev}
+	}
+}
+
+func productsOf(m model.Model) []mdlprod.Product {
+	products, err := m.GetProducts()
+	if err != nil {
+		log.Printf("lifecycle: GetProducts: %v", err)
+	}
+	return products
+}
+
+// verdict is the most severe lifecycle state found for one ArtifactInstance.
+type verdict struct {
+	kind    finding.FindingKind
+	product mdlprod.Product
+	since   time.Time
+}
+
+func checkInstance(m model.Model, products []mdlprod.Product, ai artifact.ArtifactInstance, now time.Time) {
+	instanceID := ai.GetArtifactInstanceId()
+	artifactID := artifactIDOf(ai)
+	if artifactID == uuid.Nil {
+		deleteKinds(m, instanceID, lifecycleKinds...)
+		return
+	}
+
+	v, ok := evaluate(products, artifactID, now)
+	if !ok {
+		deleteKinds(m, instanceID, lifecycleKinds...)
+		return
+	}
+
+	upsertFinding(m, instanceID, v)
+	for _, kind := range lifecycleKinds {
+		if kind != v.kind {
+			deleteKinds(m, instanceID, kind)
+		}
+	}
+}
+
+func artifactIDOf(ai artifact.ArtifactInstance) uuid.UUID {
+	ref := ai.GetArtifactRef()
+	if ref == nil {
+		return uuid.Nil
+	}
+	if ref.ArtifactId != uuid.Nil {
+		return ref.ArtifactId
+	}
+	if a := ref.ResolvedArtifact(); a != nil {
+		return a.GetArtifactId()
+	}
+	return uuid.Nil
+}
+
+// evaluate returns the most severe lifecycle state of any ProductionVersion
+// that lists artifactID among its Artefacts.
+func evaluate(products []mdlprod.Product, artifactID uuid.UUID, now time.Time) (verdict, bool) {
+	var best verdict
+	found := false
+	for _, p := range products {
+		for _, pv := range p.GetVersions() {
+			if !listsArtefact(pv, artifactID) {
+				continue
+			}
+			v, ok := versionState(pv, now)
+			if !ok {
+				continue
+			}
+			v.product = p
+			if !found || severity(v.kind) < severity(best.kind) {
+				best = v
+				found = true
+			}
+		}
+	}
+	return best, found
+}
+
+func listsArtefact(pv mdlprod.ProductionVersion, artifactID uuid.UUID) bool {
+	for _, id := range pv.Artefacts {
+		if id == artifactID {
+			return true
+		}
+	}
+	return false
+}
+
+func versionState(pv mdlprod.ProductionVersion, now time.Time) (verdict, bool) {
+	switch {
+	case pv.TerminatedFrom != nil && !now.Before(*pv.TerminatedFrom):
+		return verdict{kind: finding.ProductVersionTerminated, since: *pv.TerminatedFrom}, true
+	case pv.DeprecatedFrom != nil && !now.Before(*pv.DeprecatedFrom):
+		return verdict{kind: finding.ProductVersionDeprecated, since: *pv.DeprecatedFrom}, true
+	case pv.AvailableFrom != nil && now.Before(*pv.AvailableFrom):
+		return verdict{kind: finding.ProductVersionNotYetAvailable, since: *pv.AvailableFrom}, true
+	default:
+		return verdict{}, false
+	}
+}
+
+func severity(kind finding.FindingKind) int {
+	for i, k := range lifecycleKinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(lifecycleKinds)
+}
+
+func describe(instanceID uuid.UUID, v verdict) string {
+	product := fmt.Sprintf("%s (%s)", v.product.GetDisplayName(), v.product.GetProductId())
+	date := v.since.UTC().Format(time.RFC3339)
+	switch v.kind {
+	case finding.ProductVersionTerminated:
+		return fmt.Sprintf("ProductVersionTerminated: artifact instance %s runs a version of product %s terminated since %s", instanceID, product, date)
+	case finding.ProductVersionDeprecated:
+		return fmt.Sprintf("ProductVersionDeprecated: artifact instance %s runs a version of product %s deprecated since %s", instanceID, product, date)
+	default:
+		return fmt.Sprintf("ProductVersionNotYetAvailable: artifact instance %s runs a version of product %s not available before %s", instanceID, product, date)
+	}
+}
+
+func upsertFinding(m model.Model, instanceID uuid.UUID, v verdict) {
+	id := findingID(instanceID, v.kind)
+	description := describe(instanceID, v)
+	if cur := m.GetFindingById(id); cur != nil && cur.GetDescription() == description {
+		return // unchanged; avoid an update event on every tick
+	}
+
+	typeID, err := finding.EnsureType(m, v.kind)
+	if err != nil {
+		log.Printf("lifecycle: %v", err)
+	}
+	f := finding.NewFinding(id)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName(lifecycleFindingDisplayName)
+	f.SetDescription(description)
+	f.SetResources([]*common.ResourceRef{
+		{ResourceId: instanceID, ResourceType: events.ArtifactInstanceResource},
+	})
+
+	if err := m.AddFinding(f); err != nil {
+		log.Printf("lifecycle: AddFinding id=%s kind=%s: %v", id, v.kind, err)
+	}
+}
+
+func deleteKinds(m model.Model, instanceID uuid.UUID, kinds ...finding.FindingKind) {
+	for _, kind := range kinds {
+		id := findingID(instanceID, kind)
+		if m.GetFindingById(id) == nil {
+			continue
+		}
+		if err := m.DeleteFindingById(id); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+			log.Printf("lifecycle: DeleteFindingById id=%s kind=%s: %v", id, kind, err)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package lifecycle_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/eventfilter/lifecycle"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/artifact"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	mdlprod "go.emeland.io/modelsrv/pkg/model/product"
+)
+
+var (
+	t0         = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	deprecated = t0.AddDate(0, 6, 0)
+	terminated = t0.AddDate(1, 0, 0)
+)
+
+// newModelWithLifecycleChain returns a model whose sink runs the lifecycle
+// filter against the clock returned by now.
+func newModelWithLifecycleChain(now *time.Time) model.Model {
+	chain := eventfilter.NewChain(nil)
+	m, err := model.NewModel(eventfilter.NewFilteringSink(chain, events.NewDummySink()))
+	Expect(err).NotTo(HaveOccurred())
+	chain.SetModel(m)
+	chain.RegisterFilter(lifecycle.NewWithClock(func() time.Time { return *now }))
+	return m
+}
+
+func addProduct(m model.Model, artifactID uuid.UUID) mdlprod.Product {
+	p := mdlprod.NewProduct(uuid.New())
+	p.SetDisplayName("payments-runtime")
+	p.SetVersions([]mdlprod.ProductionVersion{{
+		AvailableFrom:  &t0,
+		DeprecatedFrom: &deprecated,
+		TerminatedFrom: &terminated,
+		Artefacts:      []uuid.UUID{artifactID},
+	}})
+	Expect(m.AddProduct(p)).To(Succeed())
+	return p
+}
+
+func addInstance(m model.Model, artifactID uuid.UUID) artifact.ArtifactInstance {
+	ai := artifact.NewArtifactInstance(uuid.New())
+	ai.SetArtifactRef(&artifact.ArtifactRef{ArtifactId: artifactID})
+	Expect(m.AddArtifactInstance(ai)).To(Succeed())
+	return ai
+}
+
+func lifecycleKindsOf(m model.Model, instanceID uuid.UUID) []finding.FindingKind {
+	var out []finding.FindingKind
+	for _, f := range m.GetFindingsReferencingResource(instanceID) {
+		for _, kind := range []finding.FindingKind{
+			finding.ProductVersionTerminated,
+			finding.ProductVersionDeprecated,
+			finding.ProductVersionNotYetAvailable,
+		} {
+			if f.GetFindingTypeId() == finding.TypeIDForKind(kind) {
+				out = append(out, kind)
+			}
+		}
+	}
+	return out
+}
+
+var _ = Describe("lifecycle filter", func() {
+	var (
+		now        time.Time
+		m          model.Model
+		artifactID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		now = t0.AddDate(0, 1, 0)
+		m = newModelWithLifecycleChain(&now)
+		artifactID = uuid.New()
+	})
+
+	It("raises no finding while the version is available", func() {
+		addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(BeEmpty())
+	})
+
+	It("raises ProductVersionNotYetAvailable before the availability date", func() {
+		now = t0.AddDate(0, -1, 0)
+		addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(ConsistOf(finding.ProductVersionNotYetAvailable))
+	})
+
+	It("raises ProductVersionTerminated when the instance is added after termination", func() {
+		now = terminated.Add(time.Hour)
+		addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(ConsistOf(finding.ProductVersionTerminated))
+	})
+
+	It("evaluates instances when the product arrives after them", func() {
+		now = deprecated.Add(time.Hour)
+		ai := addInstance(m, artifactID)
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(BeEmpty())
+
+		addProduct(m, artifactID)
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(ConsistOf(finding.ProductVersionDeprecated))
+	})
+
+	It("replaces the finding kind on a clock tick without model changes", func() {
+		addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(BeEmpty())
+
+		lifecycle.ReconcileAll(m, deprecated.Add(time.Minute))
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(ConsistOf(finding.ProductVersionDeprecated))
+
+		lifecycle.ReconcileAll(m, terminated.Add(time.Minute))
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(ConsistOf(finding.ProductVersionTerminated))
+	})
+
+	It("removes findings when the product is deleted", func() {
+		now = terminated.Add(time.Hour)
+		p := addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).NotTo(BeEmpty())
+
+		Expect(m.DeleteProductById(p.GetProductId())).To(Succeed())
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(BeEmpty())
+	})
+
+	It("removes findings when the artifact instance is deleted", func() {
+		now = terminated.Add(time.Hour)
+		addProduct(m, artifactID)
+		ai := addInstance(m, artifactID)
+		Expect(m.GetFindings()).NotTo(BeEmpty())
+
+		Expect(m.DeleteArtifactInstanceById(ai.GetArtifactInstanceId())).To(Succeed())
+
+		Expect(m.GetFindings()).To(BeEmpty())
+	})
+
+	It("ignores instances of artefacts not listed by any product", func() {
+		now = terminated.Add(time.Hour)
+		addProduct(m, artifactID)
+		ai := addInstance(m, uuid.New())
+
+		Expect(lifecycleKindsOf(m, ai.GetArtifactInstanceId())).To(BeEmpty())
+	})
+})
+
+var _ = Describe("EnsureWellKnownFindingTypes", func() {
+	It("registers the lifecycle FindingTypes with descriptions", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+
+		lifecycle.EnsureWellKnownFindingTypes(m)
+
+		ft := m.GetFindingTypeById(finding.TypeIDForKind(finding.ProductVersionTerminated))
+		Expect(ft).NotTo(BeNil())
+		Expect(ft.GetDescription()).To(Equal(finding.DescriptionForKind(finding.ProductVersionTerminated)))
+	})
+})
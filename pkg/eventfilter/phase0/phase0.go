@@ -38,13 +38,13 @@ func findingID(subjectID uuid.UUID, kind finding.FindingKind) uuid.UUID {
 func ensureFindingType(m model.Model, kind finding.FindingKind) uuid.UUID {
 	name := string(kind)
 	if ft := m.GetFindingTypeByName(name); ft != nil {
-		backfillFindingTypeDescription(m, ft, kind)
+		backfillFindingType(m, ft, kind)
 		return ft.GetFindingTypeId()
 	}
 
 	id := finding.TypeIDForKind(kind)
 	if ft := m.GetFindingTypeById(id); ft != nil {
-		backfillFindingTypeDescription(m, ft, kind)
+		backfillFindingType(m, ft, kind)
 		return id
 	}
 
@@ -53,22 +53,31 @@ func ensureFindingType(m model.Model, kind finding.FindingKind) uuid.UUID {
 	if desc := finding.DescriptionForKind(kind); desc != "" {
 		ft.SetDescription(desc)
 	}
+	ft.SetSeverity(finding.SeverityForKind(kind))
 	if err := m.AddFindingType(ft); err != nil {
 		log.Printf("phase0: AddFindingType kind=%s id=%s: %v", kind, id, err)
 	}
 	return id
 }
 
-func backfillFindingTypeDescription(m model.Model, ft finding.FindingType, kind finding.FindingKind) {
-	desc := finding.DescriptionForKind(kind)
-	if desc == "" || ft.GetDescription() != "" {
+func backfillFindingType(m model.Model, ft finding.FindingType, kind finding.FindingKind) {
+	desc := ft.GetDescription()
+	if desc == "" {
+		desc = finding.DescriptionForKind(kind)
+	}
+	sev := ft.GetSeverity()
+	if sev == "" {
+		sev = finding.SeverityForKind(kind)
+	}
+	if desc == ft.GetDescription() && sev == ft.GetSeverity() {
 		return
 	}
 	updated := finding.NewFindingType(ft.GetFindingTypeId())
 	updated.SetDisplayName(ft.GetDisplayName())
 	updated.SetDescription(desc)
+	updated.SetSeverity(sev)
 	if err := m.AddFindingType(updated); err != nil {
-		log.Printf("phase0: backfill FindingType description kind=%s id=%s: %v", kind, ft.GetFindingTypeId(), err)
+		log.Printf("phase0: backfill FindingType kind=%s id=%s: %v", kind, ft.GetFindingTypeId(), err)
 	}
 }
 
@@ -0,0 +1,103 @@
+package eventfilter
+
+import (
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds, in seconds, of the per-filter latency
+// histogram kept by a [Chain].
+var LatencyBuckets = []float64{0.00001, 0.0001, 0.001, 0.01, 0.1, 1, 10}
+
+// FilterStats is a snapshot of what one registered filter did since it was
+// first registered. Counters survive [Chain.RegisterFilterWithID] replacing
+// the filter under the same id.
+type FilterStats struct {
+	ID          FilterID
+	DisplayName string
+	Disabled    bool
+
+	// EventsIn counts the events passed to the filter, EventsOut the events
+	// it returned. A suppressing filter has EventsOut < EventsIn.
+	EventsIn  uint64
+	EventsOut uint64
+	// Panics counts calls that panicked; their event was passed on unchanged.
+	Panics uint64
+
+	// LatencyBucketCounts holds the cumulative number of calls that took at
+	// most the matching bound in [LatencyBuckets]. LatencySum is the total
+	// time spent in the filter in seconds; the call count is EventsIn.
+	LatencyBucketCounts []uint64
+	LatencySum          float64
+}
+
+type filterStats struct {
+	mu      sync.Mutex
+	in      uint64
+	out     uint64
+	panics  uint64
+	buckets []uint64 // per bound, not cumulative
+	sum     float64
+
+	// raising guards against recursion while the FilterFailed finding of
+	// this filter is written, since that write runs through the chain again.
+	raising bool
+}
+
+func newFilterStats() *filterStats {
+	return &filterStats{buckets: make([]uint64, len(LatencyBuckets))}
+}
+
+func (s *filterStats) record(out int, d time.Duration, panicked bool) {
+	secs := d.Seconds()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.in++
+	s.out += uint64(out)
+	if panicked {
+		s.panics++
+	}
+	s.sum += secs
+	for i, bound := range LatencyBuckets {
+		if secs <= bound {
+			s.buckets[i]++
+			break
+		}
+	}
+}
+
+func (s *filterStats) snapshot() FilterStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := FilterStats{
+		EventsIn:            s.in,
+		EventsOut:           s.out,
+		Panics:              s.panics,
+		LatencyBucketCounts: make([]uint64, len(s.buckets)),
+		LatencySum:          s.sum,
+	}
+	var cumulative uint64
+	for i, n := range s.buckets {
+		cumulative += n
+		st.LatencyBucketCounts[i] = cumulative
+	}
+	return st
+}
+
+// beginRaise reports whether the caller may write the FilterFailed finding;
+// it returns false while another write for this filter is in progress.
+func (s *filterStats) beginRaise() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.raising {
+		return false
+	}
+	s.raising = true
+	return true
+}
+
+func (s *filterStats) endRaise() {
+	s.mu.Lock()
+	s.raising = false
+	s.mu.Unlock()
+}
@@ -19,13 +19,34 @@ type Config struct {
 	Sources []SourceConfig `yaml:"sources"`
 }
 
-// SourceConfig describes one origin (local, HTTP, or S3) and optional per-glob parser options.
+// SourceConfig describes one origin (local, HTTP, S3 or git) and optional per-glob parser options.
 type SourceConfig struct {
 	URI     string                  `yaml:"uri"`
 	Watch   bool                    `yaml:"watch"`
 	Poll    time.Duration           `yaml:"poll"`
 	Timeout time.Duration           `yaml:"timeout"` // HTTP client timeout; default [DefaultHTTPTimeout]
 	Files   map[string]FileParseCfg `yaml:"files"`
+	// Tenant names the tenant whose landscape the source feeds; empty is the
+	// default tenant.
+	Tenant string `yaml:"tenant"`
+	// Principal is the subject the source writes as. When set, documents the
+	// principal may not write are refused and recorded as findings; when
+	// empty the source is trusted.
+	Principal string `yaml:"principal"`
+	// Ref is the branch or tag a git source reads; empty is the remote HEAD.
+	Ref string `yaml:"ref"`
+	// Path is the subdirectory of a git source holding the files.
+	Path string `yaml:"path"`
+	// Prune deletes the resources a file produced once the file disappears
+	// or a re-apply no longer defines them.
+	Prune bool `yaml:"prune"`
+	// Atomic applies each file as a whole: if one of its documents is
+	// invalid or refused, none is applied and the file is reported as a
+	// SourceApplyFailed finding.
+	Atomic bool `yaml:"atomic"`
+	// Strict rejects files whose documents carry keys their kind does not
+	// read, e.g. a misspelt spec key (see [ingress.ParseOptions.Strict]).
+	Strict bool `yaml:"strict"`
 }
 
 // FileParseCfg is YAML-friendly parser options for a glob.
@@ -35,6 +56,12 @@ type FileParseCfg struct {
 	Version   string            `yaml:"version"`
 	Delimiter string            `yaml:"delimiter"`
 	Columns   map[string]string `yaml:"columns"`
+	// Kubernetes holds the mapping rules of format kubernetes.
+	Kubernetes ingress.KubernetesOptions `yaml:"kubernetes"`
+	// APISpec holds the options of format apispec.
+	APISpec ingress.APISpecOptions `yaml:"apiSpec"`
+	// SBOM holds the options of formats cyclonedx and spdx.
+	SBOM ingress.SBOMOptions `yaml:"sbom"`
 }
 
 // LoadConfigFile reads a YAML sensor config from path.
@@ -83,6 +110,13 @@ func (sc SourceConfig) Open(ctx context.Context) (Source, ParserConfig, error) {
 		src := NewHTTPSource(uri)
 		src.Client = httpClientWithTimeout(sc.Timeout)
 		return src, parser, nil
+	case strings.HasPrefix(uri, "git+"):
+		src, err := NewGitSource(uri, sc.Ref, sc.Path, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		src.Interval = sc.Poll
+		return src, parser, nil
 	case strings.HasPrefix(uri, "s3://"):
 		src, err := NewS3SourceFromURI(ctx, uri)
 		if err != nil {
@@ -117,7 +151,7 @@ func requireLocalDir(dir string) error {
 
 func (sc SourceConfig) parserConfig() (ParserConfig, error) {
 	if len(sc.Files) == 0 {
-		return StaticParserConfig{}, nil
+		return StaticParserConfig{Opts: ingress.ParseOptions{Strict: sc.Strict}}, nil
 	}
 	rules := make([]GlobRule, 0, len(sc.Files))
 	for pattern, fc := range sc.Files {
@@ -125,10 +159,11 @@ func (sc SourceConfig) parserConfig() (ParserConfig, error) {
 		if err != nil {
 			return nil, fmt.Errorf("files[%q]: %w", pattern, err)
 		}
+		opts.Strict = sc.Strict
 		rules = append(rules, GlobRule{Pattern: pattern, Opts: opts})
 	}
 	sortGlobRules(rules)
-	return GlobParserConfig{Rules: rules}, nil
+	return GlobParserConfig{Rules: rules, Default: ingress.ParseOptions{Strict: sc.Strict}}, nil
 }
 
 // sortGlobRules imposes a stable, specific-first order on rules built from a map,
@@ -153,8 +188,11 @@ func hasWildcard(pattern string) bool {
 
 func (fc FileParseCfg) toParseOptions() (ingress.ParseOptions, error) {
 	opts := ingress.ParseOptions{
-		Version: strings.TrimSpace(fc.Version),
-		Columns: fc.Columns,
+		Version:    strings.TrimSpace(fc.Version),
+		Columns:    fc.Columns,
+		Kubernetes: fc.Kubernetes,
+		APISpec:    fc.APISpec,
+		SBOM:       fc.SBOM,
 	}
 	switch strings.ToLower(strings.TrimSpace(fc.Format)) {
 	case "":
@@ -165,6 +203,16 @@ func (fc FileParseCfg) toParseOptions() (ingress.ParseOptions, error) {
 		opts.Format = ingress.FormatJSON
 	case "csv":
 		opts.Format = ingress.FormatCSV
+	case "backstage":
+		opts.Format = ingress.FormatBackstage
+	case "kubernetes", "k8s":
+		opts.Format = ingress.FormatKubernetes
+	case "apispec", "openapi", "asyncapi":
+		opts.Format = ingress.FormatAPISpec
+	case "cyclonedx":
+		opts.Format = ingress.FormatCycloneDX
+	case "spdx":
+		opts.Format = ingress.FormatSPDX
 	default:
 		return opts, fmt.Errorf("unknown format %q", fc.Format)
 	}
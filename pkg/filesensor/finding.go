@@ -0,0 +1,52 @@
+package filesensor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.uber.org/zap"
+)
+
+// SHA-1 namespace so each (source, file) maps to one SourceApplyFailed finding.
+var sourceApplyFailedNamespace = uuid.MustParse("5d0c7b1e-93a2-4f6e-8b4d-2e7a9c3f1d60")
+
+// SourceApplyFailedFindingID returns the id of the finding recording that file
+// name of the source at origin was rejected.
+func SourceApplyFailedFindingID(origin, name string) uuid.UUID {
+	return uuid.NewSHA1(sourceApplyFailedNamespace, []byte(origin+"\x00"+name))
+}
+
+// raiseApplyFailed records that file name was rejected because of cause.
+func raiseApplyFailed(m model.Model, origin, name string, cause error, log *zap.SugaredLogger) {
+	id := SourceApplyFailedFindingID(origin, name)
+	description := fmt.Sprintf("SourceApplyFailed: %s from %s: %v", name, origin, cause)
+	if cur := m.GetFindingById(id); cur != nil && cur.GetDescription() == description {
+		return
+	}
+	typeID, err := finding.EnsureType(m, finding.SourceApplyFailed)
+	if err != nil {
+		log.Errorw("filesensor: EnsureType", "kind", string(finding.SourceApplyFailed), "error", err.Error())
+	}
+	f := finding.NewFinding(id)
+	f.SetFindingTypeById(typeID)
+	f.SetDisplayName("Source file " + name)
+	f.SetDescription(description)
+	if err := m.AddFinding(f); err != nil {
+		log.Errorw("filesensor: AddFinding", "id", id.String(), "error", err.Error())
+	}
+}
+
+// clearApplyFailed removes the finding of file name once it applied.
+func clearApplyFailed(m model.Model, origin, name string, log *zap.SugaredLogger) {
+	id := SourceApplyFailedFindingID(origin, name)
+	if m.GetFindingById(id) == nil {
+		return
+	}
+	if err := m.DeleteFindingById(id); err != nil && !errors.Is(err, common.ErrFindingNotFound) {
+		log.Errorw("filesensor: DeleteFindingById", "id", id.String(), "error", err.Error())
+	}
+}
@@ -0,0 +1,144 @@
+package filesensor
+
+import (
+	"sort"
+	"sync"
+
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.uber.org/zap"
+)
+
+// ownedResources tracks the resources each file of a Source produced when it
+// was last applied, so resources whose document disappeared can be deleted.
+// The set lives in memory: files removed while the sensor is down are not
+// noticed.
+type ownedResources struct {
+	mu    sync.Mutex
+	files map[string]map[common.ResourceRef]struct{}
+}
+
+func newOwnedResources() *ownedResources {
+	return &ownedResources{files: map[string]map[common.ResourceRef]struct{}{}}
+}
+
+// replace records that file name now produces refs and returns the resources
+// it produced before but no longer does, unless another file of the Source
+// still produces them. A nil refs forgets the file.
+func (o *ownedResources) replace(name string, refs []common.ResourceRef) []common.ResourceRef {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	prev := o.files[name]
+	next := make(map[common.ResourceRef]struct{}, len(refs))
+	for _, ref := range refs {
+		next[ref] = struct{}{}
+	}
+	if len(next) == 0 {
+		delete(o.files, name)
+	} else {
+		o.files[name] = next
+	}
+
+	var gone []common.ResourceRef
+	for ref := range prev {
+		if _, ok := next[ref]; ok || o.ownedElsewhere(name, ref) {
+			continue
+		}
+		gone = append(gone, ref)
+	}
+	sort.Slice(gone, func(i, j int) bool {
+		if gone[i].ResourceType != gone[j].ResourceType {
+			return gone[i].ResourceType < gone[j].ResourceType
+		}
+		return gone[i].ResourceId.String() < gone[j].ResourceId.String()
+	})
+	return gone
+}
+
+// add records refs for file name in addition to what it produced before.
+func (o *ownedResources) add(name string, refs []common.ResourceRef) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	set := o.files[name]
+	if set == nil {
+		set = make(map[common.ResourceRef]struct{}, len(refs))
+		o.files[name] = set
+	}
+	for _, ref := range refs {
+		set[ref] = struct{}{}
+	}
+}
+
+func (o *ownedResources) ownedElsewhere(name string, ref common.ResourceRef) bool {
+	for other, set := range o.files {
+		if other == name {
+			continue
+		}
+		if _, ok := set[ref]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceRun carries what applying the files of one source needs besides the
+// files themselves.
+type sourceRun struct {
+	origin string // URI of the source, recorded as provenance
+	apply  ingress.ApplyOptions
+	// owned tracks the resources of each file when the source prunes; nil
+	// otherwise.
+	owned *ownedResources
+}
+
+// track records the outcome of applying file name and deletes the resources
+// it no longer produces. A file with failed documents prunes nothing, so a
+// half-edited file does not take resources down with it.
+func (r sourceRun) track(name string, res ingress.ProcessResult, m model.Model, log *zap.SugaredLogger) int {
+	if r.owned == nil {
+		return 0
+	}
+	if len(res.Failed) > 0 {
+		r.owned.add(name, res.Resources)
+		log.Warnw("filesensor: prune skipped for file with failed documents", "name", name, "skipped", len(res.Failed))
+		return 0
+	}
+	return r.prune(name, r.owned.replace(name, res.Resources), m, log)
+}
+
+// forget deletes the resources only file name produced, after the file
+// disappeared from the source.
+func (r sourceRun) forget(name string, m model.Model, log *zap.SugaredLogger) int {
+	if r.owned == nil {
+		return 0
+	}
+	return r.prune(name, r.owned.replace(name, nil), m, log)
+}
+
+func (r sourceRun) prune(name string, refs []common.ResourceRef, m model.Model, log *zap.SugaredLogger) int {
+	pruned := 0
+	for _, ref := range refs {
+		current := model.ResourceObject(m, &ref)
+		if current == nil {
+			continue
+		}
+		if r.apply.Authorizer != nil {
+			if err := r.apply.Authorizer.AuthorizeWrite(ref.ResourceType, ref.ResourceId, current, nil); err != nil {
+				log.Errorw("filesensor: prune refused", "name", name, "resourceType", ref.ResourceType.String(), "id", ref.ResourceId.String(), "error", err.Error())
+				continue
+			}
+		}
+		ev := events.Event{ResourceType: ref.ResourceType, Operation: events.DeleteOperation, ResourceId: ref.ResourceId}
+		if err := m.Apply(ev); err != nil {
+			log.Errorw("filesensor: prune failed", "name", name, "resourceType", ref.ResourceType.String(), "id", ref.ResourceId.String(), "error", err.Error())
+			continue
+		}
+		pruned++
+	}
+	if pruned > 0 {
+		log.Infow("filesensor: pruned resources", "name", name, "pruned", pruned)
+	}
+	return pruned
+}
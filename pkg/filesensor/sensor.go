@@ -2,11 +2,15 @@ package filesensor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"go.emeland.io/modelsrv/pkg/eventfilter/phase0"
+	"go.emeland.io/modelsrv/pkg/ingress"
 	"go.emeland.io/modelsrv/pkg/model"
 	"go.uber.org/zap"
 )
@@ -35,11 +39,15 @@ func ApplyExisting(dir string, m model.Model, log *zap.SugaredLogger) {
 func StartWatch(ctx context.Context, dir string, m model.Model, log *zap.SugaredLogger) {
 	log = ensureLog(log)
 	src := NewLocalSource(dir)
-	go runWatch(ctx, src, StaticParserConfig{}, m, log)
+	go runWatch(ctx, src, StaticParserConfig{}, m, sourceRun{}, log)
 }
 
 // ApplySource lists all files from src and applies them once.
 func ApplySource(ctx context.Context, src Source, cfg ParserConfig, m model.Model, log *zap.SugaredLogger) ApplySummary {
+	return applySource(ctx, src, cfg, m, sourceRun{}, log)
+}
+
+func applySource(ctx context.Context, src Source, cfg ParserConfig, m model.Model, run sourceRun, log *zap.SugaredLogger) ApplySummary {
 	log = ensureLog(log)
 	if cfg == nil {
 		cfg = StaticParserConfig{}
@@ -51,7 +59,7 @@ func ApplySource(ctx context.Context, src Source, cfg ParserConfig, m model.Mode
 	}
 	var out ApplySummary
 	for _, meta := range metas {
-		out.add(applyOne(ctx, src, cfg, meta, m, log))
+		out.add(applyOne(ctx, src, cfg, meta, m, run, log))
 	}
 	return out
 }
@@ -67,7 +75,7 @@ func StartSourceWatch(ctx context.Context, src Source, cfg ParserConfig, m model
 		log.Errorw("filesensor: source does not support watch")
 		return
 	}
-	go runWatch(ctx, w, cfg, m, log)
+	go runWatch(ctx, w, cfg, m, sourceRun{}, log)
 }
 
 // StartSourcePoll polls src.List on interval and re-applies changed files (by ETag / LastModified).
@@ -79,7 +87,7 @@ func StartSourcePoll(ctx context.Context, src Source, cfg ParserConfig, interval
 	if interval <= 0 {
 		interval = time.Minute
 	}
-	go runPoll(ctx, src, cfg, interval, m, log, true)
+	go runPoll(ctx, src, cfg, interval, m, sourceRun{}, log, true)
 }
 
 func ensureLog(log *zap.SugaredLogger) *zap.SugaredLogger {
@@ -89,7 +97,7 @@ func ensureLog(log *zap.SugaredLogger) *zap.SugaredLogger {
 	return log
 }
 
-func applyOne(ctx context.Context, src Source, cfg ParserConfig, meta FileMeta, m model.Model, log *zap.SugaredLogger) ApplySummary {
+func applyOne(ctx context.Context, src Source, cfg ParserConfig, meta FileMeta, m model.Model, run sourceRun, log *zap.SugaredLogger) ApplySummary {
 	name := meta.Name
 	opts, err := cfg.OptionsFor(name)
 	if err != nil {
@@ -104,11 +112,30 @@ func applyOne(ctx context.Context, src Source, cfg ParserConfig, meta FileMeta,
 		log.Errorw("filesensor: could not read file", "name", name, "error", err.Error())
 		return ApplySummary{Failed: 1}
 	}
-	res, err := ProcessBytes(name, data, opts, m)
+	apply := run.apply
+	if rv, ok := src.(Revisioner); ok {
+		apply.Annotations = provenance(run.origin, name, rv.Revision(name))
+	} else if run.owned != nil {
+		// Pruning sources record the owning file, so dry runs can tell
+		// which resources pruning would delete.
+		apply.Annotations = provenance(run.origin, name, "")
+	}
+	res, err := processBytes(name, data, opts, m, apply)
 	if err != nil {
 		log.Errorw("filesensor: could not parse file", "name", name, "error", err.Error())
+		if apply.Atomic {
+			raiseApplyFailed(m, run.origin, name, err, log)
+		}
 		return ApplySummary{Failed: 1}
 	}
+	if res.Rejected {
+		log.Errorw("filesensor: file rejected", "name", name, "failed", len(res.Failed))
+		raiseApplyFailed(m, run.origin, name, rejectedError(res.Failed), log)
+		return ApplySummary{Failed: len(res.Failed)}
+	}
+	if apply.Atomic {
+		clearApplyFailed(m, run.origin, name, log)
+	}
 	for _, docErr := range res.Failed {
 		log.Errorw("filesensor: document skipped", "name", name, "document", docErr.Index, "error", docErr.Err.Error())
 	}
@@ -117,10 +144,33 @@ func applyOne(ctx context.Context, src Source, cfg ParserConfig, meta FileMeta,
 	} else if len(res.Failed) > 0 {
 		log.Errorw("filesensor: no documents applied", "name", name, "skipped", len(res.Failed))
 	}
-	return ApplySummary{Applied: res.Applied, Failed: len(res.Failed)}
+	pruned := run.track(name, res, m, log)
+	return ApplySummary{Applied: res.Applied, Failed: len(res.Failed), Pruned: pruned}
+}
+
+// rejectedError summarizes the documents that caused a file to be rejected.
+func rejectedError(failed []ingress.DocumentError) error {
+	msgs := make([]string, len(failed))
+	for i, e := range failed {
+		msgs[i] = e.Error()
+	}
+	return fmt.Errorf("%s", strings.Join(msgs, "; "))
+}
+
+// provenance returns the annotations recording that a resource was read from
+// file name of the source at origin at revision, if known.
+func provenance(origin, name, revision string) map[string]string {
+	out := map[string]string{AnnotationOriginFile: name}
+	if revision != "" {
+		out[AnnotationOriginRevision] = revision
+	}
+	if origin != "" {
+		out[AnnotationOriginURI] = origin
+	}
+	return out
 }
 
-func runWatch(ctx context.Context, w Watcher, cfg ParserConfig, m model.Model, log *zap.SugaredLogger) {
+func runWatch(ctx context.Context, w Watcher, cfg ParserConfig, m model.Model, run sourceRun, log *zap.SugaredLogger) {
 	src, ok := w.(Source)
 	if !ok {
 		log.Errorw("filesensor: watcher is not a Source")
@@ -139,27 +189,34 @@ func runWatch(ctx context.Context, w Watcher, cfg ParserConfig, m model.Model, l
 			if !ok {
 				return
 			}
+			if change.Err != nil {
+				log.Errorw("filesensor: watch check failed", "error", change.Err.Error())
+				continue
+			}
 			if change.Op == OpDelete {
-				continue // v1: ignore deletes
+				run.forget(change.Name, m, log)
+				continue
 			}
-			applyOne(ctx, src, cfg, FileMeta{Name: change.Name}, m, log)
+			applyOne(ctx, src, cfg, FileMeta{Name: change.Name}, m, run, log)
 		}
 	}
 }
 
 // runPoll re-applies files whose ETag (or last-modified time) changed since the
-// previous pass. When applyFirst is false the first pass only records tokens,
-// so a caller that already applied the source does not emit a duplicate round
-// of events at startup.
-func runPoll(ctx context.Context, src Source, cfg ParserConfig, interval time.Duration, m model.Model, log *zap.SugaredLogger, applyFirst bool) {
+// previous pass and forgets files that are no longer listed. When applyFirst
+// is false the first pass only records tokens, so a caller that already
+// applied the source does not emit a duplicate round of events at startup.
+func runPoll(ctx context.Context, src Source, cfg ParserConfig, interval time.Duration, m model.Model, run sourceRun, log *zap.SugaredLogger, applyFirst bool) {
 	seen := map[string]string{} // name -> etag or last-modified token
-	tick := func(apply bool) {
+	tick := func(applyNow bool) {
 		metas, err := src.List(ctx)
 		if err != nil {
 			log.Errorw("filesensor: poll list failed", "error", err.Error())
 			return
 		}
+		listed := make(map[string]bool, len(metas))
 		for _, meta := range metas {
+			listed[meta.Name] = true
 			token := meta.ETag
 			if token == "" && !meta.LastModified.IsZero() {
 				token = meta.LastModified.UTC().Format(time.RFC3339Nano)
@@ -167,8 +224,8 @@ func runPoll(ctx context.Context, src Source, cfg ParserConfig, interval time.Du
 			if prev, ok := seen[meta.Name]; ok && prev == token && token != "" {
 				continue
 			}
-			if apply {
-				applyOne(ctx, src, cfg, meta, m, log)
+			if applyNow {
+				applyOne(ctx, src, cfg, meta, m, run, log)
 			}
 			if token != "" {
 				seen[meta.Name] = token
@@ -176,6 +233,12 @@ func runPoll(ctx context.Context, src Source, cfg ParserConfig, interval time.Du
 				seen[meta.Name] = fmt.Sprintf("seen-%d", time.Now().UnixNano())
 			}
 		}
+		for name := range seen {
+			if !listed[name] {
+				delete(seen, name)
+				run.forget(name, m, log)
+			}
+		}
 	}
 
 	tick(applyFirst)
@@ -196,6 +259,25 @@ type OpenSource struct {
 	Config SourceConfig
 	Source Source
 	Parser ParserConfig
+	// Authorizer, when set, is asked before each document of the source is
+	// applied or pruned, typically an authz.WriteGuard for Config.Principal.
+	Authorizer ingress.Authorizer
+
+	owned *ownedResources // resources per file when Config.Prune is set
+}
+
+func (s OpenSource) run() sourceRun {
+	return sourceRun{origin: s.Config.URI, apply: ingress.ApplyOptions{Authorizer: s.Authorizer, Atomic: s.Config.Atomic}, owned: s.owned}
+}
+
+// trackOwned gives every pruning source its resource tracker, which the
+// sources share between [ApplySources] and [StartSources].
+func trackOwned(sources []OpenSource) {
+	for i := range sources {
+		if sources[i].Config.Prune && sources[i].owned == nil {
+			sources[i].owned = newOwnedResources()
+		}
+	}
 }
 
 // OpenSources builds the backend client and parser config for every source in cfg.
@@ -209,6 +291,7 @@ func OpenSources(ctx context.Context, cfg *Config) ([]OpenSource, error) {
 	for i, sc := range cfg.Sources {
 		src, parser, err := sc.Open(ctx)
 		if err != nil {
+			_ = CloseSources(out)
 			return nil, fmt.Errorf("source[%d] (%s): %w", i, sc.URI, err)
 		}
 		out = append(out, OpenSource{Config: sc, Source: src, Parser: parser})
@@ -216,15 +299,30 @@ func OpenSources(ctx context.Context, cfg *Config) ([]OpenSource, error) {
 	return out, nil
 }
 
+// CloseSources releases the local state of sources that hold any, such as
+// the temporary repository of a git source. Callers close the sources once
+// their watch and poll loops are stopped.
+func CloseSources(sources []OpenSource) error {
+	var errs []error
+	for _, s := range sources {
+		if c, ok := s.Source.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ApplySummary is the aggregate outcome of applying one or more sources.
 type ApplySummary struct {
 	Applied int // documents successfully applied
 	Failed  int // documents that failed to apply, plus unreadable/unparseable files
+	Pruned  int // resources deleted because their document disappeared
 }
 
 func (s *ApplySummary) add(other ApplySummary) {
 	s.Applied += other.Applied
 	s.Failed += other.Failed
+	s.Pruned += other.Pruned
 }
 
 // Err reports a startup failure when nothing was applied and at least one
@@ -243,8 +341,9 @@ func ApplySources(ctx context.Context, sources []OpenSource, m model.Model, log
 	if len(sources) == 0 {
 		return out
 	}
+	trackOwned(sources)
 	for _, s := range sources {
-		out.add(ApplySource(ctx, s.Source, s.Parser, m, log))
+		out.add(applySource(ctx, s.Source, s.Parser, m, s.run(), log))
 	}
 	phase0.ReconcileAll(m)
 	return out
@@ -255,10 +354,11 @@ func ApplySources(ctx context.Context, sources []OpenSource, m model.Model, log
 // re-applying it.
 func StartSources(ctx context.Context, sources []OpenSource, m model.Model, log *zap.SugaredLogger) {
 	log = ensureLog(log)
+	trackOwned(sources)
 	for _, s := range sources {
 		if s.Config.Watch {
-			if _, ok := s.Source.(Watcher); ok {
-				StartSourceWatch(ctx, s.Source, s.Parser, m, log)
+			if w, ok := s.Source.(Watcher); ok {
+				go runWatch(ctx, w, s.Parser, m, s.run(), log)
 				continue
 			}
 			log.Warnw("filesensor: watch requested but source does not support it; falling back to poll", "uri", s.Config.URI)
@@ -269,6 +369,6 @@ func StartSources(ctx context.Context, sources []OpenSource, m model.Model, log
 		if interval <= 0 {
 			interval = time.Minute
 		}
-		go runPoll(ctx, s.Source, s.Parser, interval, m, log, false)
+		go runPoll(ctx, s.Source, s.Parser, interval, m, s.run(), log, false)
 	}
 }
@@ -24,16 +24,21 @@ type Op string
 
 const (
 	OpUpsert Op = "upsert"
-	OpDelete Op = "delete" // v2; ignored by apply path today
+	OpDelete Op = "delete" // the file is gone; prunes its resources when enabled
 )
 
-// Change is a notification that a Source file changed.
+// Change is a notification that a Source file changed. A Change with Err
+// set reports that the watcher could not check for changes; Name and Op are
+// then empty.
 type Change struct {
 	Name string
 	Op   Op
+	Err  error
 }
 
 // Source lists and reads files from a persistence backend (local, HTTP, S3, …).
+// A Source holding local state, such as a git checkout, also implements
+// io.Closer; [CloseSources] releases it.
 type Source interface {
 	List(ctx context.Context) ([]FileMeta, error)
 	Read(ctx context.Context, name string) ([]byte, error)
@@ -64,6 +69,8 @@ func (c StaticParserConfig) OptionsFor(name string) (ingress.ParseOptions, error
 // unordered source must sort them (see [sortGlobRules]).
 type GlobParserConfig struct {
 	Rules []GlobRule
+	// Default applies to names no rule matches.
+	Default ingress.ParseOptions
 }
 
 // GlobRule binds a filepath.Match pattern to parse options.
@@ -92,7 +99,7 @@ func (c GlobParserConfig) OptionsFor(name string) (ingress.ParseOptions, error)
 		}
 	}
 	// Default: auto-detect format; CSV without columns will fail in ingress.
-	return ingress.ParseOptions{}, nil
+	return c.Default, nil
 }
 
 func isSupportedFileName(name string) bool {
@@ -106,11 +113,15 @@ func isSupportedFileName(name string) bool {
 
 // ProcessBytes parses data and applies documents to m.
 func ProcessBytes(name string, data []byte, opts ingress.ParseOptions, m model.Model) (ingress.ProcessResult, error) {
+	return processBytes(name, data, opts, m, ingress.ApplyOptions{})
+}
+
+func processBytes(name string, data []byte, opts ingress.ParseOptions, m model.Model, apply ingress.ApplyOptions) (ingress.ProcessResult, error) {
 	docs, err := ingress.Parse(name, data, opts)
 	if err != nil {
 		return ingress.ProcessResult{}, err
 	}
-	return ingress.ApplyAll(docs, m), nil
+	return ingress.ApplyAllWithOptions(docs, m, apply), nil
 }
 
 // ProcessFile reads a local YAML/JSON/CSV file and applies each document to m.
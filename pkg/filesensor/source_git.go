@@ -0,0 +1,312 @@
+package filesensor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Annotations recording where a resource applied from a [Revisioner] Source,
+// or from a Source with prune: true, came from. The revision is only known
+// for a Revisioner.
+const (
+	AnnotationOriginURI      = "emeland.io/origin.uri"
+	AnnotationOriginFile     = "emeland.io/origin.file"
+	AnnotationOriginRevision = "emeland.io/origin.revision"
+)
+
+// DefaultGitPollInterval is how often a watched [GitSource] fetches when
+// [SourceConfig.Poll] is unset.
+const DefaultGitPollInterval = time.Minute
+
+// Revisioner is implemented by Sources that know the revision a file was
+// last read at, e.g. a git commit. Resources applied from such a Source are
+// annotated with [AnnotationOriginRevision].
+type Revisioner interface {
+	Revision(name string) string
+}
+
+// GitSource reads files of one branch or tag of a git repository. Every List
+// fetches the ref into a local bare repository, so files are read from a
+// single commit; a file's blob id is its ETag, so a new commit only re-applies
+// the files it changed. The git command line tool
+// does the transport, so credentials come from git's own configuration
+// (credential helpers, SSH agent, GIT_* environment).
+type GitSource struct {
+	Remote string // clone URL without the "git+" prefix
+	Ref    string // branch or tag; empty is the remote HEAD
+	Path   string // subdirectory holding the files; empty is the repository root
+	Dir    string // local bare repository, created on first fetch
+	tmpDir bool   // Dir was created by NewGitSource and is removed by Close
+	// Interval is how often Watch fetches; default [DefaultGitPollInterval].
+	Interval time.Duration
+
+	fetchMu sync.Mutex // serializes fetches, which share FETCH_HEAD
+	mu      sync.Mutex
+	commit  string            // commit of the last fetch
+	readAt  map[string]string // name -> commit the file was last read at
+}
+
+// NewGitSource returns a Source for a git+https:// or git+file:// URI. dir
+// holds the local bare repository; empty creates a temporary directory, which
+// [GitSource.Close] removes.
+func NewGitSource(rawURI, ref, subpath, dir string) (*GitSource, error) {
+	remote, ok := strings.CutPrefix(rawURI, "git+")
+	if !ok || !(strings.HasPrefix(remote, "https://") || strings.HasPrefix(remote, "http://") || strings.HasPrefix(remote, "file://")) {
+		return nil, fmt.Errorf("not a git+https or git+file URI: %q", rawURI)
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, fmt.Errorf("git source needs the git command: %w", err)
+	}
+	tmpDir := dir == ""
+	if tmpDir {
+		var err error
+		if dir, err = os.MkdirTemp("", "emeland-git-"); err != nil {
+			return nil, err
+		}
+	}
+	return &GitSource{
+		Remote: remote,
+		Ref:    ref,
+		Path:   strings.Trim(path.Clean("/"+subpath), "/"),
+		Dir:    dir,
+		tmpDir: tmpDir,
+	}, nil
+}
+
+// Close removes the local repository if NewGitSource created it.
+func (s *GitSource) Close() error {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+	if !s.tmpDir {
+		return nil
+	}
+	s.tmpDir = false
+	return os.RemoveAll(s.Dir)
+}
+
+func (s *GitSource) git(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", s.Dir}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// fetch updates the local repository from the remote and returns the commit
+// Ref points to.
+func (s *GitSource) fetch(ctx context.Context) (string, error) {
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+	if _, err := os.Stat(filepath.Join(s.Dir, "HEAD")); os.IsNotExist(err) {
+		if out, err := exec.CommandContext(ctx, "git", "init", "--bare", "--quiet", s.Dir).CombinedOutput(); err != nil {
+			return "", fmt.Errorf("git init: %w: %s", err, strings.TrimSpace(string(out)))
+		}
+	}
+	ref := s.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if _, err := s.git(ctx, "fetch", "--quiet", "--depth", "1", "--no-tags", s.Remote, ref); err != nil {
+		return "", err
+	}
+	out, err := s.git(ctx, "rev-parse", "--verify", "FETCH_HEAD^{commit}")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// blobs lists the supported files below Path at commit with their blob ids.
+func (s *GitSource) blobs(ctx context.Context, commit string) (map[string]string, error) {
+	args := []string{"ls-tree", "-r", "-z", "--full-tree", commit}
+	if s.Path != "" {
+		args = append(args, "--", s.Path+"/")
+	}
+	out, err := s.git(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{}
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	sc.Split(splitNUL)
+	for sc.Scan() {
+		// <mode> SP <type> SP <object> TAB <path>
+		meta, full, ok := strings.Cut(sc.Text(), "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		name := full
+		if s.Path != "" {
+			name = strings.TrimPrefix(full, s.Path+"/")
+		}
+		if isSupportedFileName(name) {
+			files[name] = fields[2]
+		}
+	}
+	return files, sc.Err()
+}
+
+func splitNUL(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// List implements [Source]. It fetches the ref and lists the files of the
+// commit it points to.
+func (s *GitSource) List(ctx context.Context) ([]FileMeta, error) {
+	commit, err := s.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files, err := s.blobs(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.commit = commit
+	s.mu.Unlock()
+	out := make([]FileMeta, 0, len(files))
+	for name, blob := range files {
+		out = append(out, FileMeta{Name: name, ETag: blob})
+	}
+	return out, nil
+}
+
+// Read implements [Source]. It reads name at the commit of the last List,
+// fetching first if there was none.
+func (s *GitSource) Read(ctx context.Context, name string) ([]byte, error) {
+	s.mu.Lock()
+	commit := s.commit
+	s.mu.Unlock()
+	if commit == "" {
+		var err error
+		if commit, err = s.fetch(ctx); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		s.commit = commit
+		s.mu.Unlock()
+	}
+	full := path.Join(s.Path, name)
+	data, err := s.git(ctx, "cat-file", "blob", commit+":"+full)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	if s.readAt == nil {
+		s.readAt = map[string]string{}
+	}
+	s.readAt[name] = commit
+	s.mu.Unlock()
+	return data, nil
+}
+
+// Revision implements [Revisioner].
+func (s *GitSource) Revision(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.readAt[name]
+}
+
+// Watch implements [Watcher] by fetching every Interval and reporting the
+// files whose content changed or that disappeared since the previous fetch.
+// A failed fetch is reported as a [Change] with Err set and retried on the
+// next tick.
+func (s *GitSource) Watch(ctx context.Context) (<-chan Change, error) {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultGitPollInterval
+	}
+	// Start from the commit last listed, so changes pushed since the source
+	// was applied are reported.
+	s.mu.Lock()
+	commit := s.commit
+	s.mu.Unlock()
+	if commit == "" {
+		var err error
+		if commit, err = s.fetch(ctx); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		s.commit = commit
+		s.mu.Unlock()
+	}
+	prev, err := s.blobs(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Change, 16)
+	go func() {
+		defer close(ch)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			commit, err := s.fetch(ctx)
+			var next map[string]string
+			if err == nil {
+				next, err = s.blobs(ctx, commit)
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- Change{Err: err}:
+				}
+				continue
+			}
+			s.mu.Lock()
+			s.commit = commit
+			s.mu.Unlock()
+			for _, c := range diffBlobs(prev, next) {
+				select {
+				case <-ctx.Done():
+					return
+				case ch <- c:
+				}
+			}
+			prev = next
+		}
+	}()
+	return ch, nil
+}
+
+// diffBlobs returns the changes turning file set prev into next.
+func diffBlobs(prev, next map[string]string) []Change {
+	var out []Change
+	for name, blob := range next {
+		if prev[name] != blob {
+			out = append(out, Change{Name: name, Op: OpUpsert})
+		}
+	}
+	for name := range prev {
+		if _, ok := next[name]; !ok {
+			out = append(out, Change{Name: name, Op: OpDelete})
+		}
+	}
+	return out
+}
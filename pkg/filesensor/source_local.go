@@ -56,7 +56,9 @@ func (s *LocalSource) Read(ctx context.Context, name string) ([]byte, error) {
 	return os.ReadFile(filepath.Join(s.Dir, name))
 }
 
-// Watch implements [Watcher] using fsnotify. Only Create/Write/Rename emit upserts.
+// Watch implements [Watcher] using fsnotify. Changes to a file are debounced;
+// the file existing when the change is sent makes it an upsert, otherwise a
+// delete.
 func (s *LocalSource) Watch(ctx context.Context) (<-chan Change, error) {
 	if err := os.MkdirAll(s.Dir, 0755); err != nil {
 		return nil, err
@@ -104,10 +106,14 @@ func (s *LocalSource) Watch(ctx context.Context) (<-chan Change, error) {
 				mu.Lock()
 				delete(timers, base)
 				mu.Unlock()
+				op := OpUpsert
+				if _, err := os.Stat(path); os.IsNotExist(err) {
+					op = OpDelete
+				}
 				select {
 				case <-ctx.Done():
 					return
-				case ch <- Change{Name: base, Op: OpUpsert}:
+				case ch <- Change{Name: base, Op: op}:
 				}
 			})
 			mu.Unlock()
@@ -121,7 +127,7 @@ func (s *LocalSource) Watch(ctx context.Context) (<-chan Change, error) {
 				if !ok {
 					return
 				}
-				if ev.Has(fsnotify.Create) || ev.Has(fsnotify.Write) || ev.Has(fsnotify.Rename) {
+				if ev.Has(fsnotify.Create) || ev.Has(fsnotify.Write) || ev.Has(fsnotify.Rename) || ev.Has(fsnotify.Remove) {
 					schedule(ev.Name)
 				}
 			case _, ok := <-watcher.Errors:
@@ -6,7 +6,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -365,6 +368,7 @@ var _ = Describe("Config", func() {
 sources:
   - uri: file:///tmp/data
     watch: true
+    tenant: payments
     files:
       "*.csv":
         format: csv
@@ -378,6 +382,7 @@ sources:
 		Expect(err).NotTo(HaveOccurred())
 		Expect(cfg.Sources).To(HaveLen(1))
 		Expect(cfg.Sources[0].Watch).To(BeTrue())
+		Expect(cfg.Sources[0].Tenant).To(Equal("payments"))
 		parser := cfg.Sources[0].Files
 		Expect(parser["*.csv"].Format).To(Equal("csv"))
 		Expect(parser["*.csv"].Columns["uuid"]).To(Equal("id"))
@@ -545,3 +550,397 @@ sources:
 		Expect(err).To(MatchError(ContainSubstring("not a directory")))
 	})
 })
+
+// filesSource is a multi-file Source whose files the test replaces at will;
+// the ETag of a file is its content.
+type filesSource struct {
+	mu    sync.Mutex
+	files map[string]string
+	lists int
+}
+
+func (s *filesSource) List(ctx context.Context) ([]filesensor.FileMeta, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lists++
+	out := make([]filesensor.FileMeta, 0, len(s.files))
+	for name, data := range s.files {
+		out = append(out, filesensor.FileMeta{Name: name, ETag: data})
+	}
+	return out, nil
+}
+
+func (s *filesSource) Read(ctx context.Context, name string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(data), nil
+}
+
+func (s *filesSource) listCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lists
+}
+
+func (s *filesSource) set(name, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if data == "" {
+		delete(s.files, name)
+		return
+	}
+	s.files[name] = data
+}
+
+var _ = Describe("Pruning", func() {
+	const (
+		prodID    = "22222222-2222-2222-2222-222222222222"
+		stagingID = "33333333-3333-3333-3333-333333333333"
+	)
+	contextDoc := func(id, name string) string {
+		return "---\nversion: emeland.io/v1\nkind: Context\nspec:\n  contextId: \"" + id + "\"\n  displayName: " + name + "\n"
+	}
+	present := func(m model.Model, id string) func() bool {
+		return func() bool { return m.GetContextById(uuid.MustParse(id)) != nil }
+	}
+
+	var (
+		m   model.Model
+		src *filesSource
+	)
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		src = &filesSource{files: map[string]string{
+			"a.yaml": contextDoc(prodID, "Production") + contextDoc(stagingID, "Staging"),
+		}}
+	})
+
+	// start starts polling and waits for the first pass, which records the
+	// files as already applied.
+	start := func(ctx context.Context, sources []filesensor.OpenSource) {
+		listed := src.listCount()
+		filesensor.StartSources(ctx, sources, m, nil)
+		Eventually(src.listCount).Should(BeNumerically(">", listed))
+	}
+
+	open := func(prune bool) []filesensor.OpenSource {
+		return []filesensor.OpenSource{{
+			Config: filesensor.SourceConfig{URI: "s3://bucket/prefix", Poll: 20 * time.Millisecond, Prune: prune},
+			Source: src,
+			Parser: filesensor.StaticParserConfig{},
+		}}
+	}
+
+	It("deletes resources whose documents or files disappear", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sources := open(true)
+		Expect(filesensor.ApplySources(ctx, sources, m, nil).Applied).To(Equal(2))
+		start(ctx, sources)
+
+		src.set("a.yaml", contextDoc(prodID, "Production"))
+		Eventually(present(m, stagingID)).Should(BeFalse())
+		Expect(present(m, prodID)()).To(BeTrue())
+
+		src.set("a.yaml", "")
+		Eventually(present(m, prodID)).Should(BeFalse())
+	})
+
+	It("keeps resources another file still defines", func() {
+		src.set("b.yaml", contextDoc(prodID, "Production"))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sources := open(true)
+		filesensor.ApplySources(ctx, sources, m, nil)
+		start(ctx, sources)
+
+		src.set("a.yaml", "")
+		Eventually(present(m, stagingID)).Should(BeFalse())
+		Consistently(present(m, prodID), 100*time.Millisecond).Should(BeTrue())
+	})
+
+	It("prunes nothing while a file has failed documents", func() {
+		sources := open(true)
+		filesensor.ApplySources(context.Background(), sources, m, nil)
+
+		src.set("a.yaml", contextDoc(prodID, "Production")+"---\nversion: emeland.io/v1\nkind: Context\nspec:\n  daf: nope\n")
+		summary := filesensor.ApplySources(context.Background(), sources, m, nil)
+		Expect(summary.Failed).To(Equal(1))
+		Expect(summary.Pruned).To(Equal(0))
+		Expect(present(m, stagingID)()).To(BeTrue())
+
+		src.set("a.yaml", contextDoc(prodID, "Production"))
+		Expect(filesensor.ApplySources(context.Background(), sources, m, nil).Pruned).To(Equal(1))
+		Expect(present(m, stagingID)()).To(BeFalse())
+	})
+
+	It("records the owning file of resources from pruning sources", func() {
+		filesensor.ApplySources(context.Background(), open(true), m, nil)
+		prod := m.GetContextById(uuid.MustParse(prodID))
+		Expect(prod).NotTo(BeNil())
+		Expect(prod.GetAnnotations().GetValue(filesensor.AnnotationOriginURI)).To(Equal("s3://bucket/prefix"))
+		Expect(prod.GetAnnotations().GetValue(filesensor.AnnotationOriginFile)).To(Equal("a.yaml"))
+		Expect(slices.Collect(prod.GetAnnotations().GetKeys())).NotTo(ContainElement(filesensor.AnnotationOriginRevision))
+	})
+
+	It("leaves resources in place without prune", func() {
+		sources := open(false)
+		filesensor.ApplySources(context.Background(), sources, m, nil)
+		src.set("a.yaml", contextDoc(prodID, "Production"))
+		Expect(filesensor.ApplySources(context.Background(), sources, m, nil).Pruned).To(Equal(0))
+		Expect(present(m, stagingID)()).To(BeTrue())
+	})
+
+	It("prunes resources of a deleted local file reported by the watcher", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(contextDoc(prodID, "Production")), 0644)).To(Succeed())
+		sources := []filesensor.OpenSource{{
+			Config: filesensor.SourceConfig{URI: "file://" + dir, Watch: true, Prune: true},
+			Source: filesensor.NewLocalSource(dir),
+			Parser: filesensor.StaticParserConfig{},
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		filesensor.ApplySources(ctx, sources, m, nil)
+		filesensor.StartSources(ctx, sources, m, nil)
+		Expect(present(m, prodID)()).To(BeTrue())
+		// The watcher is running once a new file is picked up.
+		Eventually(func() bool {
+			Expect(os.WriteFile(filepath.Join(dir, "b.yaml"), []byte(contextDoc(stagingID, "Staging")), 0644)).To(Succeed())
+			return present(m, stagingID)()
+		}, 2*time.Second, 300*time.Millisecond).Should(BeTrue())
+
+		Expect(os.Remove(filepath.Join(dir, "a.yaml"))).To(Succeed())
+		Eventually(present(m, prodID), 2*time.Second).Should(BeFalse())
+	})
+})
+
+var _ = Describe("Atomic sources", func() {
+	const (
+		prodID    = "22222222-2222-2222-2222-222222222222"
+		stagingID = "33333333-3333-3333-3333-333333333333"
+	)
+	contextDoc := func(id, name string) string {
+		return "---\nversion: emeland.io/v1\nkind: Context\nspec:\n  contextId: \"" + id + "\"\n  displayName: " + name + "\n"
+	}
+	const badDoc = "---\nversion: emeland.io/v1\nkind: Context\nspec:\n  daf: nope\n"
+
+	It("rejects a file with a bad document as a whole and reports it as a finding", func() {
+		m, err := model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		src := &filesSource{files: map[string]string{
+			"a.yaml": contextDoc(prodID, "Production") + badDoc,
+		}}
+		sources := []filesensor.OpenSource{{
+			Config: filesensor.SourceConfig{URI: "s3://bucket/prefix", Atomic: true},
+			Source: src,
+			Parser: filesensor.StaticParserConfig{},
+		}}
+		fid := filesensor.SourceApplyFailedFindingID("s3://bucket/prefix", "a.yaml")
+
+		summary := filesensor.ApplySources(context.Background(), sources, m, nil)
+		Expect(summary.Applied).To(Equal(0))
+		Expect(summary.Failed).To(Equal(1))
+		Expect(m.GetContextById(uuid.MustParse(prodID))).To(BeNil())
+		f := m.GetFindingById(fid)
+		Expect(f).NotTo(BeNil())
+		Expect(f.GetDescription()).To(HavePrefix("SourceApplyFailed: a.yaml from s3://bucket/prefix: document 1:"))
+		Expect(m.GetFindingTypeByName("SourceApplyFailed")).NotTo(BeNil())
+
+		src.set("a.yaml", contextDoc(prodID, "Production")+contextDoc(stagingID, "Staging"))
+		Expect(filesensor.ApplySources(context.Background(), sources, m, nil).Applied).To(Equal(2))
+		Expect(m.GetFindingById(fid)).To(BeNil())
+	})
+
+	It("reports a file that does not parse", func() {
+		m, err := model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		src := &filesSource{files: map[string]string{"a.yaml": "version: [unclosed\n"}}
+		sources := []filesensor.OpenSource{{
+			Config: filesensor.SourceConfig{URI: "s3://bucket/prefix", Atomic: true},
+			Source: src,
+			Parser: filesensor.StaticParserConfig{},
+		}}
+		filesensor.ApplySources(context.Background(), sources, m, nil)
+		Expect(m.GetFindingById(filesensor.SourceApplyFailedFindingID("s3://bucket/prefix", "a.yaml"))).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("GitSource", func() {
+	var (
+		bare string
+		work string
+	)
+
+	run := func(dir string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		Expect(err).NotTo(HaveOccurred(), string(out))
+	}
+	commit := func(files map[string]string) {
+		for name, data := range files {
+			p := filepath.Join(work, name)
+			if data == "" {
+				Expect(os.Remove(p)).To(Succeed())
+				continue
+			}
+			Expect(os.MkdirAll(filepath.Dir(p), 0755)).To(Succeed())
+			Expect(os.WriteFile(p, []byte(data), 0644)).To(Succeed())
+		}
+		run(work, "add", "-A")
+		run(work, "commit", "--quiet", "-m", "update")
+		run(work, "push", "--quiet", "origin", "HEAD:main")
+	}
+	head := func() string {
+		out, err := exec.Command("git", "-C", work, "rev-parse", "HEAD").Output()
+		Expect(err).NotTo(HaveOccurred())
+		return strings.TrimSpace(string(out))
+	}
+	blob := func(name string) string {
+		out, err := exec.Command("git", "-C", work, "rev-parse", "HEAD:"+name).Output()
+		Expect(err).NotTo(HaveOccurred())
+		return strings.TrimSpace(string(out))
+	}
+	contextDoc := func(id, name string) string {
+		return "version: emeland.io/v1\nkind: Context\nspec:\n  contextId: \"" + id + "\"\n  displayName: " + name + "\n"
+	}
+
+	BeforeEach(func() {
+		if _, err := exec.LookPath("git"); err != nil {
+			Skip("git is not installed")
+		}
+		bare = GinkgoT().TempDir()
+		work = GinkgoT().TempDir()
+		run(bare, "init", "--quiet", "--bare", "--initial-branch=main")
+		run(work, "init", "--quiet", "--initial-branch=main")
+		run(work, "remote", "add", "origin", bare)
+		commit(map[string]string{
+			"landscape/prod.yaml": contextDoc("22222222-2222-2222-2222-222222222222", "Production"),
+			"landscape/notes.txt": "not a landscape file",
+			"README.md":           "docs",
+		})
+	})
+
+	It("lists the files of a subpath at the fetched commit", func() {
+		src, err := filesensor.NewGitSource("git+file://"+bare, "main", "landscape", GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+
+		metas, err := src.List(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metas).To(ConsistOf(filesensor.FileMeta{Name: "prod.yaml", ETag: blob("landscape/prod.yaml")}))
+
+		data, err := src.Read(context.Background(), "prod.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("Production"))
+		Expect(src.Revision("prod.yaml")).To(Equal(head()))
+	})
+
+	It("keeps the ETag of files a commit does not change", func() {
+		src, err := filesensor.NewGitSource("git+file://"+bare, "main", "landscape", GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+		before, err := src.List(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+
+		commit(map[string]string{"README.md": "more docs"})
+		after, err := src.List(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(before))
+	})
+
+	It("removes its temporary repository on Close", func() {
+		src, err := filesensor.NewGitSource("git+file://"+bare, "main", "landscape", "")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = src.List(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.Dir).To(BeADirectory())
+
+		Expect(src.Close()).To(Succeed())
+		Expect(src.Dir).NotTo(BeAnExistingFile())
+
+		kept := GinkgoT().TempDir()
+		src, err = filesensor.NewGitSource("git+file://"+bare, "main", "landscape", kept)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.Close()).To(Succeed())
+		Expect(kept).To(BeADirectory(), "a configured directory is kept")
+	})
+
+	It("reports failed fetches while watching", func() {
+		src, err := filesensor.NewGitSource("git+file://"+bare, "main", "landscape", GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+		src.Interval = 20 * time.Millisecond
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch, err := src.Watch(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.RemoveAll(bare)).To(Succeed())
+		var change filesensor.Change
+		Eventually(ch, 5*time.Second).Should(Receive(&change))
+		Expect(change.Err).To(MatchError(ContainSubstring("git fetch")))
+	})
+
+	It("reads tags", func() {
+		run(work, "tag", "v1")
+		run(work, "push", "--quiet", "origin", "v1")
+		commit(map[string]string{"landscape/prod.yaml": contextDoc("22222222-2222-2222-2222-222222222222", "Production v2")})
+
+		src, err := filesensor.NewGitSource("git+file://"+bare, "v1", "landscape", GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = src.List(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		data, err := src.Read(context.Background(), "prod.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("v2"))
+	})
+
+	It("applies, records provenance and follows new commits when watched", func() {
+		cfg, err := filesensor.ParseConfig([]byte(`
+sources:
+  - uri: git+file://` + bare + `
+    ref: main
+    path: landscape
+    watch: true
+    poll: 20ms
+    prune: true
+`))
+		Expect(err).NotTo(HaveOccurred())
+		sources, err := filesensor.OpenSources(context.Background(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		m, err := model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(filesensor.ApplySources(ctx, sources, m, nil).Applied).To(Equal(1))
+		prod := m.GetContextById(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+		Expect(prod).NotTo(BeNil())
+		Expect(prod.GetAnnotations().GetValue(filesensor.AnnotationOriginRevision)).To(Equal(head()))
+		Expect(prod.GetAnnotations().GetValue(filesensor.AnnotationOriginFile)).To(Equal("prod.yaml"))
+		Expect(prod.GetAnnotations().GetValue(filesensor.AnnotationOriginURI)).To(Equal("git+file://" + bare))
+
+		filesensor.StartSources(ctx, sources, m, nil)
+		commit(map[string]string{
+			"landscape/prod.yaml":    "",
+			"landscape/staging.yaml": contextDoc("33333333-3333-3333-3333-333333333333", "Staging"),
+		})
+		Eventually(func() bool {
+			return m.GetContextById(uuid.MustParse("33333333-3333-3333-3333-333333333333")) != nil
+		}, 5*time.Second).Should(BeTrue())
+		Eventually(func() bool {
+			return m.GetContextById(uuid.MustParse("22222222-2222-2222-2222-222222222222")) != nil
+		}, 5*time.Second).Should(BeFalse())
+	})
+
+	It("rejects URIs that are not git+https or git+file", func() {
+		_, err := filesensor.NewGitSource("git+ssh://example.com/repo.git", "", "", "")
+		Expect(err).To(MatchError(ContainSubstring("not a git+https or git+file URI")))
+	})
+})
@@ -0,0 +1,72 @@
+package findingsummary
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// Health is the finding picture of a single resource.
+type Health struct {
+	ResourceId   uuid.UUID
+	ResourceType events.ResourceType
+	// DisplayName is empty when the resource is not registered in the model,
+	// e.g. a dangling reference cited by a finding.
+	DisplayName string
+	// Healthy is true when no active (open or acknowledged) finding cites the
+	// resource.
+	Healthy bool
+	// WorstSeverity is the highest severity among the active findings.
+	WorstSeverity finding.Severity
+	// Active counts the open and acknowledged findings.
+	Active   int
+	Findings []finding.Finding
+}
+
+// HealthOf builds the health of the resource with id from the findings citing
+// it, typically the visible subset of [model.FindingModel.GetFindingsReferencingResource].
+// It returns nil when the resource is neither registered nor cited.
+func HealthOf(m model.Model, id uuid.UUID, findings []finding.Finding, now time.Time) *Health {
+	ref := model.FindResource(m, id)
+	if ref == nil {
+		ref = citedAs(findings, id)
+	}
+	if ref == nil {
+		return nil
+	}
+
+	h := &Health{
+		ResourceId:   id,
+		ResourceType: ref.ResourceType,
+		DisplayName:  model.ResourceDisplayName(m, ref),
+		Healthy:      true,
+		Findings:     findings,
+	}
+	for _, f := range findings {
+		if !finding.StatusOf(f, now).Active() {
+			continue
+		}
+		h.Active++
+		h.Healthy = false
+		if ft := m.GetFindingTypeById(f.GetFindingTypeId()); ft != nil && ft.GetSeverity().Rank() > h.WorstSeverity.Rank() {
+			h.WorstSeverity = ft.GetSeverity()
+		}
+	}
+	return h
+}
+
+// citedAs returns the reference under which findings cite id.
+func citedAs(findings []finding.Finding, id uuid.UUID) *common.ResourceRef {
+	for _, f := range findings {
+		for _, ref := range f.GetResources() {
+			if ref != nil && ref.ResourceId == id {
+				return ref
+			}
+		}
+	}
+	return nil
+}
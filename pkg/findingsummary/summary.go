@@ -0,0 +1,202 @@
+// Package findingsummary aggregates findings for dashboards and alerting.
+//
+// [Summarize] counts findings by status, FindingType, severity, owner group,
+// Context subtree and subject resource type. [HealthOf] collects the findings
+// citing a single resource. Both are shared by the REST API and the Prometheus
+// collector in pkg/metrics so the two always report the same numbers.
+//
+// The subject of a finding is its first resource reference; built-in filters
+// always put the offending resource first. Owner groups are read from the
+// subject's emeland.io/owner-groups annotation, and the Context of a subject
+// is the Context itself or the Context it is placed in (SystemInstance, Role,
+// Capacity). A finding counts towards its Context and every ancestor.
+package findingsummary
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/annotations"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// Bucket is the number of findings sharing one value of a dimension.
+type Bucket struct {
+	// Key identifies the value: a status, severity or resource kind, or the
+	// id of a FindingType, Group or Context. Empty for findings without one
+	// (no severity, unowned, outside any Context).
+	Key string
+	// Name is the display name of the resource Key refers to, when known.
+	Name  string
+	Count int
+}
+
+// Summary holds finding counts broken down by several dimensions. Each
+// breakdown is sorted by descending count, then by key.
+type Summary struct {
+	Total          int
+	ByStatus       []Bucket
+	ByFindingType  []Bucket
+	BySeverity     []Bucket
+	ByOwnerGroup   []Bucket
+	ByContext      []Bucket
+	ByResourceType []Bucket
+}
+
+// counter accumulates buckets for one dimension.
+type counter map[string]*Bucket
+
+func (c counter) add(key, name string) {
+	b, ok := c[key]
+	if !ok {
+		b = &Bucket{Key: key, Name: name}
+		c[key] = b
+	}
+	b.Count++
+}
+
+func (c counter) sorted() []Bucket {
+	out := make([]Bucket, 0, len(c))
+	for _, b := range c {
+		out = append(out, *b)
+	}
+	slices.SortFunc(out, func(a, b Bucket) int {
+		if n := cmp.Compare(b.Count, a.Count); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return out
+}
+
+// Summarize counts findings at now. Callers pass the findings they want
+// counted, e.g. only those visible to a principal or only active ones.
+func Summarize(m model.Model, findings []finding.Finding, now time.Time) Summary {
+	byStatus, byType, bySeverity := counter{}, counter{}, counter{}
+	byOwner, byContext, byKind := counter{}, counter{}, counter{}
+	total := 0
+
+	for _, f := range findings {
+		if f == nil {
+			continue
+		}
+		total++
+		byStatus.add(string(finding.StatusOf(f, now)), "")
+
+		typeID := f.GetFindingTypeId()
+		var typeName string
+		var severity finding.Severity
+		if ft := m.GetFindingTypeById(typeID); ft != nil {
+			typeName = ft.GetDisplayName()
+			severity = ft.GetSeverity()
+		}
+		typeKey := ""
+		if typeID != uuid.Nil {
+			typeKey = typeID.String()
+		}
+		byType.add(typeKey, typeName)
+		bySeverity.add(string(severity), "")
+
+		subject := Subject(f)
+		if subject == nil {
+			byOwner.add("", "")
+			continue
+		}
+		byKind.add(subject.ResourceType.WireKind(), "")
+
+		obj := model.ResourceObject(m, subject)
+		groups := ownerGroups(obj)
+		if len(groups) == 0 {
+			byOwner.add("", "")
+		}
+		for _, g := range groups {
+			byOwner.add(g, groupName(m, g))
+		}
+		for _, ctx := range contextChain(m, obj) {
+			byContext.add(ctx.GetContextId().String(), ctx.GetDisplayName())
+		}
+	}
+
+	return Summary{
+		Total:          total,
+		ByStatus:       byStatus.sorted(),
+		ByFindingType:  byType.sorted(),
+		BySeverity:     bySeverity.sorted(),
+		ByOwnerGroup:   byOwner.sorted(),
+		ByContext:      byContext.sorted(),
+		ByResourceType: byKind.sorted(),
+	}
+}
+
+// Subject returns the resource a finding is about, or nil when it cites none.
+func Subject(f finding.Finding) *common.ResourceRef {
+	for _, ref := range f.GetResources() {
+		if ref != nil && ref.ResourceId != uuid.Nil {
+			return ref
+		}
+	}
+	return nil
+}
+
+// Accessors implemented by the model types that carry annotations or are
+// placed in a Context.
+type (
+	annotated interface {
+		GetAnnotations() annotations.Annotations
+	}
+	contextRefHolder interface{ GetContextRef() *mdlctx.ContextRef }
+	contextIDHolder  interface{ GetContextId() uuid.UUID }
+)
+
+func ownerGroups(obj any) []string {
+	a, ok := obj.(annotated)
+	if !ok {
+		return nil
+	}
+	return authz.OwnerGroups(a.GetAnnotations())
+}
+
+// groupName resolves owner group ids that name a Group resource.
+func groupName(m model.Model, group string) string {
+	id, err := uuid.Parse(group)
+	if err != nil {
+		return ""
+	}
+	return model.ResourceDisplayName(m, &common.ResourceRef{ResourceId: id, ResourceType: events.GroupResource})
+}
+
+// contextChain returns the Context the subject belongs to followed by its
+// ancestors. Unregistered Contexts end the chain.
+func contextChain(m model.Model, obj any) []mdlctx.Context {
+	var id uuid.UUID
+	switch v := obj.(type) {
+	case mdlctx.Context:
+		id = v.GetContextId()
+	case contextRefHolder:
+		if ref := v.GetContextRef(); ref != nil {
+			id = ref.EffectiveParentContextID()
+		}
+	case contextIDHolder:
+		id = v.GetContextId()
+	}
+
+	var chain []mdlctx.Context
+	seen := map[uuid.UUID]bool{}
+	for id != uuid.Nil && !seen[id] {
+		seen[id] = true
+		ctx := m.GetContextById(id)
+		if ctx == nil {
+			break
+		}
+		chain = append(chain, ctx)
+		id = ctx.GetParentId()
+	}
+	return chain
+}
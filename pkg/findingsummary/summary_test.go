@@ -0,0 +1,173 @@
+package findingsummary_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/findingsummary"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.emeland.io/modelsrv/pkg/model/iam"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+type fixture struct {
+	m           model.Model
+	root, child mdlctx.Context
+	group       iam.Group
+	instance    system.SystemInstance
+	system      system.System
+	ownerType   finding.FindingType
+	driftType   finding.FindingType
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+	m, err := model.NewModel(events.NewListSink())
+	require.NoError(t, err)
+	f := fixture{m: m}
+
+	f.root = mdlctx.NewContext(uuid.New())
+	f.root.SetDisplayName("Company")
+	require.NoError(t, m.AddContext(f.root))
+	f.child = mdlctx.NewContext(uuid.New())
+	f.child.SetDisplayName("Payments")
+	f.child.SetParentById(f.root.GetContextId())
+	require.NoError(t, m.AddContext(f.child))
+
+	f.group = iam.NewGroup(uuid.New())
+	f.group.SetDisplayName("Team Payments")
+	require.NoError(t, m.AddGroup(f.group))
+
+	f.instance = system.NewSystemInstance(uuid.New())
+	f.instance.SetDisplayName("payments-prod")
+	f.instance.SetContextRef(&mdlctx.ContextRef{ContextId: f.child.GetContextId()})
+	f.instance.GetAnnotations().Add(authz.OwnerGroupsKey, f.group.GetGroupId().String()+", ops")
+	require.NoError(t, m.AddSystemInstance(f.instance))
+
+	f.system = system.NewSystem(uuid.New())
+	f.system.SetDisplayName("payments")
+	require.NoError(t, m.AddSystem(f.system))
+
+	f.ownerType = finding.NewFindingType(uuid.New())
+	f.ownerType.SetDisplayName("OwnerMissing")
+	f.ownerType.SetSeverity(finding.SeverityHigh)
+	require.NoError(t, m.AddFindingType(f.ownerType))
+	f.driftType = finding.NewFindingType(uuid.New())
+	f.driftType.SetDisplayName("Drift")
+	f.driftType.SetSeverity(finding.SeverityLow)
+	require.NoError(t, m.AddFindingType(f.driftType))
+	return f
+}
+
+func (f fixture) addFinding(t *testing.T, ft finding.FindingType, rt events.ResourceType, id uuid.UUID) finding.Finding {
+	t.Helper()
+	fd := finding.NewFinding(uuid.New())
+	fd.SetDisplayName("check")
+	fd.SetFindingTypeById(ft.GetFindingTypeId())
+	fd.SetResources([]*common.ResourceRef{{ResourceId: id, ResourceType: rt}})
+	require.NoError(t, f.m.AddFinding(fd))
+	return fd
+}
+
+func bucket(buckets []findingsummary.Bucket, key string) findingsummary.Bucket {
+	for _, b := range buckets {
+		if b.Key == key {
+			return b
+		}
+	}
+	return findingsummary.Bucket{}
+}
+
+func count(buckets []findingsummary.Bucket, key string) int {
+	return bucket(buckets, key).Count
+}
+
+func TestSummarize(t *testing.T) {
+	f := newFixture(t)
+	f.addFinding(t, f.ownerType, events.SystemInstanceResource, f.instance.GetInstanceId())
+	f.addFinding(t, f.driftType, events.SystemInstanceResource, f.instance.GetInstanceId())
+	acked := f.addFinding(t, f.ownerType, events.SystemResource, f.system.GetSystemId())
+	acked.SetTriage(&finding.Triage{Status: finding.StatusAcknowledged, ChangedAt: time.Now()})
+	f.addFinding(t, f.driftType, events.ContextResource, f.root.GetContextId())
+
+	all, err := f.m.GetFindings()
+	require.NoError(t, err)
+	s := findingsummary.Summarize(f.m, all, time.Now())
+
+	assert.Equal(t, 4, s.Total)
+	assert.Equal(t, 3, count(s.ByStatus, "open"))
+	assert.Equal(t, 1, count(s.ByStatus, "acknowledged"))
+	assert.Equal(t, 2, count(s.BySeverity, "high"))
+	assert.Equal(t, 2, count(s.ByFindingType, f.driftType.GetFindingTypeId().String()))
+	assert.Equal(t, 2, count(s.ByResourceType, "SystemInstance"))
+	assert.Equal(t, 1, count(s.ByResourceType, "Context"))
+
+	assert.Equal(t, 2, count(s.ByOwnerGroup, f.group.GetGroupId().String()))
+	assert.Equal(t, 2, count(s.ByOwnerGroup, "ops"))
+	assert.Equal(t, 2, count(s.ByOwnerGroup, ""), "unowned System and Context")
+
+	// Context counts cover the whole subtree.
+	assert.Equal(t, 3, count(s.ByContext, f.root.GetContextId().String()))
+	assert.Equal(t, 2, count(s.ByContext, f.child.GetContextId().String()))
+	assert.Equal(t, "Payments", bucket(s.ByContext, f.child.GetContextId().String()).Name)
+	assert.Equal(t, "Team Payments", bucket(s.ByOwnerGroup, f.group.GetGroupId().String()).Name)
+}
+
+func TestSummarizeSortsByCount(t *testing.T) {
+	f := newFixture(t)
+	f.addFinding(t, f.driftType, events.SystemResource, f.system.GetSystemId())
+	f.addFinding(t, f.ownerType, events.SystemResource, f.system.GetSystemId())
+	f.addFinding(t, f.ownerType, events.SystemResource, f.system.GetSystemId())
+
+	all, err := f.m.GetFindings()
+	require.NoError(t, err)
+	s := findingsummary.Summarize(f.m, all, time.Now())
+	require.Len(t, s.ByFindingType, 2)
+	assert.Equal(t, "OwnerMissing", s.ByFindingType[0].Name)
+	assert.Equal(t, 2, s.ByFindingType[0].Count)
+	assert.Empty(t, s.ByContext)
+}
+
+func TestHealthOf(t *testing.T) {
+	f := newFixture(t)
+	sysID := f.system.GetSystemId()
+
+	h := findingsummary.HealthOf(f.m, sysID, f.m.GetFindingsReferencingResource(sysID), time.Now())
+	require.NotNil(t, h)
+	assert.True(t, h.Healthy)
+	assert.Equal(t, events.SystemResource, h.ResourceType)
+	assert.Equal(t, "payments", h.DisplayName)
+
+	f.addFinding(t, f.driftType, events.SystemResource, sysID)
+	resolved := f.addFinding(t, f.ownerType, events.SystemResource, sysID)
+	resolved.SetTriage(&finding.Triage{Status: finding.StatusResolved, ChangedAt: time.Now()})
+
+	h = findingsummary.HealthOf(f.m, sysID, f.m.GetFindingsReferencingResource(sysID), time.Now())
+	require.NotNil(t, h)
+	assert.False(t, h.Healthy)
+	assert.Equal(t, 1, h.Active)
+	assert.Equal(t, finding.SeverityLow, h.WorstSeverity)
+	assert.Len(t, h.Findings, 2)
+}
+
+func TestHealthOfDanglingReference(t *testing.T) {
+	f := newFixture(t)
+	missing := uuid.New()
+	assert.Nil(t, findingsummary.HealthOf(f.m, missing, nil, time.Now()))
+
+	f.addFinding(t, f.driftType, events.NodeResource, missing)
+	h := findingsummary.HealthOf(f.m, missing, f.m.GetFindingsReferencingResource(missing), time.Now())
+	require.NotNil(t, h)
+	assert.Equal(t, events.NodeResource, h.ResourceType)
+	assert.Empty(t, h.DisplayName)
+	assert.False(t, h.Healthy)
+}
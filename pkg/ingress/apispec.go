@@ -0,0 +1,121 @@
+package ingress
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.emeland.io/modelsrv/pkg/events"
+	"gopkg.in/yaml.v3"
+)
+
+// Annotations of APIs imported from spec files.
+const (
+	// APISpecHashKey holds "sha256:<hex>" of the spec file, so a changed spec
+	// shows up as a changed annotation.
+	APISpecHashKey = "emeland.io/api.spec-hash"
+	// APISpecFormatKey holds the spec format and its version, e.g.
+	// "openapi 3.1.0", "swagger 2.0" or "asyncapi 2.6.0".
+	APISpecFormatKey = "emeland.io/api.spec-format"
+)
+
+// Extensions of spec files read by [DecodeAPISpecDocuments], at the top level
+// or under info.
+const (
+	apiSpecSystemExt = "x-emeland-system"
+	apiSpecIDExt     = "x-emeland-id"
+)
+
+// APISpecOptions are the options of [DecodeAPISpecDocuments].
+type APISpecOptions struct {
+	// System is the System (UUID or name) of specs without an
+	// x-emeland-system extension.
+	System string `yaml:"system"`
+}
+
+// DecodeAPISpecDocuments decodes an OpenAPI 3, Swagger 2 or AsyncAPI file
+// (YAML or JSON) into one API document: info.title is the display name,
+// info.version the version and info.description the description. OpenAPI
+// and Swagger specs are APIs of type OpenAPI, AsyncAPI specs of type Other.
+//
+// The System is the x-emeland-system extension (UUID or name, see
+// [ResolveNames]) or else opts.System; x-emeland-id sets the id, which is
+// otherwise generated from title and System. The API carries the spec's
+// hash ([APISpecHashKey]) and format ([APISpecFormatKey]).
+func DecodeAPISpecDocuments(data []byte, opts APISpecOptions) ([]Document, error) {
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	// Versions are read as written: decoded into any, an unquoted 2.10
+	// would become the float 2.1.
+	var versions struct {
+		OpenAPI  string `yaml:"openapi"`
+		Swagger  string `yaml:"swagger"`
+		AsyncAPI string `yaml:"asyncapi"`
+		Info     struct {
+			Version string `yaml:"version"`
+		} `yaml:"info"`
+	}
+	if err := yaml.Unmarshal(data, &versions); err != nil {
+		return nil, err
+	}
+	format, apiType := "", ""
+	for _, f := range []struct{ key, version string }{
+		{"openapi", versions.OpenAPI},
+		{"swagger", versions.Swagger},
+		{"asyncapi", versions.AsyncAPI},
+	} {
+		if _, ok := raw[f.key]; ok {
+			format = strings.TrimSpace(f.key + " " + strings.TrimSpace(f.version))
+			apiType = "OpenAPI"
+			if f.key == "asyncapi" {
+				apiType = "Other"
+			}
+			break
+		}
+	}
+	if format == "" {
+		return nil, fmt.Errorf("not an OpenAPI, Swagger or AsyncAPI document (no openapi, swagger or asyncapi field)")
+	}
+	info, _ := raw["info"].(map[string]any)
+	title := stringValue(info, "title")
+	if title == "" {
+		return nil, fmt.Errorf("%s: info.title is required", format)
+	}
+	extension := func(key string) any {
+		if v, ok := raw[key]; ok {
+			return v
+		}
+		return info[key]
+	}
+
+	sum := sha256.Sum256(data)
+	spec := map[string]any{
+		"displayName": title,
+		"type":        apiType,
+		"annotations": map[string]any{
+			APISpecHashKey:   "sha256:" + hex.EncodeToString(sum[:]),
+			APISpecFormatKey: format,
+		},
+	}
+	if d := stringValue(info, "description"); d != "" {
+		spec["description"] = d
+	}
+	if v := strings.TrimSpace(versions.Info.Version); v != "" {
+		spec["version"] = map[string]any{"version": v}
+	}
+	if id := extension(apiSpecIDExt); id != nil {
+		spec["apiId"] = fmt.Sprint(id)
+	}
+	switch sys := extension(apiSpecSystemExt); {
+	case sys != nil:
+		spec["system"] = sys
+	case strings.TrimSpace(opts.System) != "":
+		spec["system"] = strings.TrimSpace(opts.System)
+	default:
+		return nil, fmt.Errorf("%s %q: no %s extension and no default system", format, title, apiSpecSystemExt)
+	}
+	return []Document{{Version: DefaultCSVVersion, Kind: DocumentKind(events.APIResource), Spec: spec}}, nil
+}
@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -211,6 +212,37 @@ func parseProductionVersionMap(m map[string]any) (mdlprod.ProductionVersion, err
 	return out, nil
 }
 
+// mergeProductVersions adds next to the versions prev of a Product. A version
+// listing the same artefacts as one of prev replaces it, the others are
+// appended.
+func mergeProductVersions(prev, next []mdlprod.ProductionVersion) []mdlprod.ProductionVersion {
+	out := slices.Clone(prev)
+	for _, v := range next {
+		i := slices.IndexFunc(out, func(o mdlprod.ProductionVersion) bool {
+			return sameArtefacts(o.Artefacts, v.Artefacts)
+		})
+		if i >= 0 {
+			out[i] = v
+		} else {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// sameArtefacts reports whether a and b hold the same ids in any order.
+func sameArtefacts(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
 func applyProduct(spec map[string]any, m model.Model) error {
 	id, err := parseUUIDField(spec, "productId")
 	if err != nil {
@@ -248,6 +280,15 @@ func applyProduct(spec map[string]any, m model.Model) error {
 			}
 			vers = append(vers, pv)
 		}
+		if v, ok := spec["mergeVersions"]; ok {
+			merge, ok := v.(bool)
+			if !ok {
+				return fmt.Errorf("mergeVersions must be a boolean")
+			}
+			if old := m.GetProductById(id); merge && old != nil {
+				vers = mergeProductVersions(old.GetVersions(), vers)
+			}
+		}
 		p.SetVersions(vers)
 	}
 	if err := applyAnnotations(p.GetAnnotations(), spec); err != nil {
@@ -297,6 +338,12 @@ func ApplyDocument(doc Document, m model.Model) error {
 		return applyArtifactInstance(doc.Spec, m)
 	case events.ProductResource:
 		return applyProduct(doc.Spec, m)
+	case events.OrgUnitResource:
+		return applyOrgUnit(doc.Spec, m)
+	case events.GroupResource:
+		return applyGroup(doc.Spec, m)
+	case events.IdentityResource:
+		return applyIdentity(doc.Spec, m)
 	case events.PermissionSpecResource:
 		return applyPermissionSpec(doc.Spec, m)
 	case events.RoleSpecResource:
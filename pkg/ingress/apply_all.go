@@ -1,9 +1,13 @@
 package ingress
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
 )
 
 // DocumentError records a single document that could not be applied.
@@ -24,18 +28,200 @@ func (e DocumentError) Unwrap() error {
 type ProcessResult struct {
 	Applied int             // documents successfully applied
 	Failed  []DocumentError // documents skipped (logged by caller)
+	// Resources lists the resources the applied documents wrote, in document
+	// order.
+	Resources []common.ResourceRef
+	// Rejected reports that an atomic apply (see [ApplyOptions.Atomic]) was
+	// rolled back; Failed lists the documents that caused it.
+	Rejected bool
+}
+
+// Authorizer decides whether a document may change the model. id is the
+// resource the document writes, current the resource it replaces, nil for a
+// new one, and next the resource as the document defines it. A non-nil error
+// refuses the document.
+type Authorizer interface {
+	AuthorizeWrite(rt events.ResourceType, id uuid.UUID, current, next any) error
+}
+
+// ApplyOptions tune [ApplyAllWithOptions].
+type ApplyOptions struct {
+	// Authorizer, when set, is consulted before each document is applied.
+	// Refused documents are reported in [ProcessResult.Failed] with the
+	// Authorizer's error.
+	Authorizer Authorizer
+	// Annotations are added to every document before it is applied, replacing
+	// values the document sets for the same keys, e.g. to record provenance.
+	Annotations map[string]string
+	// Atomic applies the documents as one [model.Batch]: if any document
+	// fails, none is applied and no event is emitted.
+	Atomic bool
 }
 
 // ApplyAll applies each document to m in order.
 // Invalid documents are skipped; processing continues for the rest.
 func ApplyAll(docs []Document, m model.Model) ProcessResult {
+	return ApplyAllWithOptions(docs, m, ApplyOptions{})
+}
+
+// ApplyAllWithOptions applies each document to m in order as [ApplyAll] does,
+// adding opts.Annotations and asking opts.Authorizer first. Name references
+// are resolved first (see [ResolveNames]).
+func ApplyAllWithOptions(docs []Document, m model.Model, opts ApplyOptions) ProcessResult {
+	docs, unresolved := ResolveNames(docs, m)
+	if opts.Atomic {
+		return applyAtomic(docs, unresolved, m, opts)
+	}
 	var out ProcessResult
-	for i := range docs {
-		if err := ApplyDocument(docs[i], m); err != nil {
+	a := applier{m: m, opts: opts}
+	skip := failedIndexes(unresolved)
+	for i, doc := range docs {
+		if err, ok := skip[i]; ok {
+			out.Failed = append(out.Failed, DocumentError{Index: i, Err: err})
+			continue
+		}
+		ref, err := a.apply(doc)
+		if err != nil {
 			out.Failed = append(out.Failed, DocumentError{Index: i, Err: err})
 			continue
 		}
 		out.Applied++
+		if ref != nil {
+			out.Resources = append(out.Resources, *ref)
+		}
+	}
+	return out
+}
+
+// errBatchRejected makes [model.Batch] roll back an atomic apply.
+var errBatchRejected = errors.New("batch rejected")
+
+// failedIndexes maps the index of each failed document to its error.
+func failedIndexes(failed []DocumentError) map[int]error {
+	out := make(map[int]error, len(failed))
+	for _, f := range failed {
+		out[f.Index] = f.Err
+	}
+	return out
+}
+
+// applyAtomic applies all documents within one batch. Every document is
+// tried, so Failed lists all bad documents of a rejected batch, not just the
+// first. Documents whose names did not resolve reject the batch as well.
+func applyAtomic(docs []Document, unresolved []DocumentError, m model.Model, opts ApplyOptions) ProcessResult {
+	var out ProcessResult
+	a := applier{m: m, opts: opts}
+	skip := failedIndexes(unresolved)
+	err := model.Batch(m, func(tx *model.Tx) error {
+		for i, doc := range docs {
+			if err, ok := skip[i]; ok {
+				out.Failed = append(out.Failed, DocumentError{Index: i, Err: err})
+				continue
+			}
+			if ref, err := documentRef(doc); err == nil {
+				tx.Save(ref)
+			}
+			ref, err := a.apply(doc)
+			if err != nil {
+				out.Failed = append(out.Failed, DocumentError{Index: i, Err: err})
+				continue
+			}
+			out.Applied++
+			if ref != nil {
+				out.Resources = append(out.Resources, *ref)
+			}
+		}
+		if len(out.Failed) > 0 {
+			return errBatchRejected
+		}
+		return nil
+	})
+	if err != nil {
+		out.Applied = 0
+		out.Resources = nil
+		out.Rejected = true
 	}
 	return out
 }
+
+// applier applies single documents with [ApplyOptions].
+type applier struct {
+	m       model.Model
+	opts    ApplyOptions
+	scratch model.Model // created for the first document to authorize
+}
+
+// apply applies doc and returns the resource it wrote, nil if the document
+// names none.
+func (a *applier) apply(doc Document) (*common.ResourceRef, error) {
+	if len(a.opts.Annotations) > 0 {
+		doc = withAnnotations(doc, a.opts.Annotations)
+	}
+	if a.opts.Authorizer != nil {
+		if a.scratch == nil {
+			var err error
+			if a.scratch, err = model.NewModel(events.NewDummySink()); err != nil {
+				return nil, err
+			}
+		}
+		if err := authorize(doc, a.m, a.scratch, a.opts.Authorizer); err != nil {
+			return nil, err
+		}
+	}
+	if err := ApplyDocument(doc, a.m); err != nil {
+		return nil, err
+	}
+	if ref, err := documentRef(doc); err == nil {
+		return &ref, nil
+	}
+	return nil, nil
+}
+
+// authorize builds the resource doc defines by applying it to the scratch
+// model, so the Authorizer judges exactly what would be written to m.
+func authorize(doc Document, m, scratch model.Model, auth Authorizer) error {
+	if err := ApplyDocument(doc, scratch); err != nil {
+		return err
+	}
+	ref, err := documentRef(doc)
+	if err != nil {
+		return err
+	}
+	return auth.AuthorizeWrite(ref.ResourceType, ref.ResourceId, model.ResourceObject(m, &ref), model.ResourceObject(scratch, &ref))
+}
+
+// withAnnotations returns doc with extra merged into its annotations. The
+// spec is copied so the caller's document is left alone.
+func withAnnotations(doc Document, extra map[string]string) Document {
+	spec := make(map[string]any, len(doc.Spec)+1)
+	for k, v := range doc.Spec {
+		spec[k] = v
+	}
+	ann := map[string]any{}
+	if cur, ok := spec["annotations"].(map[string]any); ok {
+		for k, v := range cur {
+			ann[k] = v
+		}
+	}
+	for k, v := range extra {
+		ann[k] = v
+	}
+	spec["annotations"] = ann
+	doc.Spec = spec
+	return doc
+}
+
+// documentRef returns the resource doc writes, identified by its kind's
+// primary id field.
+func documentRef(doc Document) (common.ResourceRef, error) {
+	rt := doc.Kind.ResourceType()
+	field, ok := primaryIDField[rt]
+	if !ok {
+		return common.ResourceRef{}, fmt.Errorf("no primary id field for kind %s", rt)
+	}
+	id, err := parseUUIDField(doc.Spec, field)
+	if err != nil {
+		return common.ResourceRef{}, err
+	}
+	return common.ResourceRef{ResourceId: id, ResourceType: rt}, nil
+}
@@ -38,6 +38,87 @@ func parseUUIDStringList(spec map[string]any, key string) ([]uuid.UUID, error) {
 	return out, nil
 }
 
+func applyOrgUnit(spec map[string]any, m model.Model) error {
+	id, err := parseUUIDField(spec, "orgUnitId")
+	if err != nil {
+		return err
+	}
+	name, err := displayName(spec)
+	if err != nil {
+		return err
+	}
+	ou := iam.NewOrgUnit(id)
+	ou.SetDisplayName(name)
+	if desc, ok := stringField(spec, "description"); ok {
+		ou.SetDescription(desc)
+	}
+	if err := applyAnnotations(ou.GetAnnotations(), spec); err != nil {
+		return err
+	}
+	return m.AddOrgUnit(ou)
+}
+
+func applyGroup(spec map[string]any, m model.Model) error {
+	id, err := parseUUIDField(spec, "groupId")
+	if err != nil {
+		return err
+	}
+	name, err := displayName(spec)
+	if err != nil {
+		return err
+	}
+	g := iam.NewGroup(id)
+	g.SetDisplayName(name)
+	if desc, ok := stringField(spec, "description"); ok {
+		g.SetDescription(desc)
+	}
+	members, err := parseUUIDStringList(spec, "members")
+	if err != nil {
+		return err
+	}
+	if len(members) > 0 {
+		refs := make([]*iam.IdentityRef, 0, len(members))
+		for _, mid := range members {
+			refs = append(refs, &iam.IdentityRef{IdentityId: mid, Identity: m.GetIdentityById(mid)})
+		}
+		g.SetMembers(refs)
+	}
+	if oid, has, err := optionalUUIDRef(spec, "orgUnit"); err != nil {
+		return err
+	} else if has {
+		g.SetOrgUnit(&iam.OrgUnitRef{OrgUnitId: oid, OrgUnit: m.GetOrgUnitById(oid)})
+	}
+	if err := applyAnnotations(g.GetAnnotations(), spec); err != nil {
+		return err
+	}
+	return m.AddGroup(g)
+}
+
+func applyIdentity(spec map[string]any, m model.Model) error {
+	id, err := parseUUIDField(spec, "identityId")
+	if err != nil {
+		return err
+	}
+	name, err := displayName(spec)
+	if err != nil {
+		return err
+	}
+	ident := iam.NewIdentity(id)
+	ident.SetDisplayName(name)
+	if desc, ok := stringField(spec, "description"); ok {
+		ident.SetDescription(desc)
+	}
+	if oid, has, err := optionalUUIDRef(spec, "orgUnit"); err != nil {
+		return err
+	} else if has {
+		ident.SetOrgUnit(&iam.OrgUnitRef{OrgUnitId: oid, OrgUnit: m.GetOrgUnitById(oid)})
+	}
+	if err := applyAnnotations(ident.GetAnnotations(), spec); err != nil {
+		return err
+	}
+	return m.AddIdentity(ident)
+}
+
 func applyPermissionSpec(spec map[string]any, m model.Model) error {
 	id, err := parseUUIDField(spec, "permissionSpecId")
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/internal/celrule"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/model"
 	mdlapi "go.emeland.io/modelsrv/pkg/model/api"
@@ -358,6 +359,13 @@ func applyFindingType(spec map[string]any, m model.Model) error {
 	if desc, ok := stringField(spec, "description"); ok {
 		ft.SetDescription(desc)
 	}
+	if raw, ok := stringField(spec, "severity"); ok {
+		sev, err := finding.ParseSeverity(raw)
+		if err != nil {
+			return err
+		}
+		ft.SetSeverity(sev)
+	}
 	if err := applyAnnotations(ft.GetAnnotations(), spec); err != nil {
 		return err
 	}
@@ -387,6 +395,11 @@ func applyArtifact(spec map[string]any, m model.Model) error {
 	return m.AddArtifact(a)
 }
 
+// ArtifactComponentInstanceKey is the annotation an ArtifactInstance keeps
+// the id of the ComponentInstance running it in; the model has no such
+// reference, so the spec key componentInstance is stored here.
+const ArtifactComponentInstanceKey = "emeland.io/artifact.component-instance"
+
 func applyArtifactInstance(spec map[string]any, m model.Model) error {
 	id, err := parseUUIDField(spec, "artifactInstanceId")
 	if err != nil {
@@ -410,6 +423,11 @@ func applyArtifactInstance(spec map[string]any, m model.Model) error {
 	if err := applyAnnotations(ai.GetAnnotations(), spec); err != nil {
 		return err
 	}
+	if ciID, has, err := optionalUUIDRef(spec, "componentInstance"); err != nil {
+		return err
+	} else if has {
+		ai.GetAnnotations().Add(ArtifactComponentInstanceKey, ciID.String())
+	}
 	return m.AddArtifactInstance(ai)
 }
 
@@ -427,6 +445,37 @@ func applyFilterRule(spec map[string]any, m model.Model) error {
 	if desc, ok := stringField(spec, "description"); ok {
 		fr.SetDescription(desc)
 	}
+	if rt, ok := stringField(spec, "resourceType"); ok {
+		fr.SetResourceType(strings.TrimSpace(rt))
+	}
+	if expr, ok := stringField(spec, "expression"); ok {
+		fr.SetExpression(expr)
+	}
+	if raw, ok := stringField(spec, "action"); ok {
+		action, err := mdlfilterrule.ParseAction(raw)
+		if err != nil {
+			return err
+		}
+		fr.SetAction(action)
+	}
+	if ft, ok := stringField(spec, "findingType"); ok {
+		fr.SetFindingType(strings.TrimSpace(ft))
+	}
+	if msg, ok := stringField(spec, "message"); ok {
+		fr.SetMessage(msg)
+	}
+	if v, ok := spec["disabled"]; ok {
+		b, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("disabled must be a boolean")
+		}
+		fr.SetDisabled(b)
+	}
+	if mdlfilterrule.IsDeclarative(fr) {
+		if _, err := celrule.Compile(fr); err != nil {
+			return fmt.Errorf("filter rule %s: %w", id, err)
+		}
+	}
 	return m.AddFilterRule(fr)
 }
 
@@ -0,0 +1,377 @@
+package ingress
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/authz"
+	"go.emeland.io/modelsrv/pkg/events"
+	"gopkg.in/yaml.v3"
+)
+
+// BackstageEntityRefKey is the annotation recording the Backstage entity ref
+// ("component:default/payments") a resource was imported from.
+const BackstageEntityRefKey = "backstage.io/entity-ref"
+
+// backstageEntity is a Backstage catalog entity (catalog-info.yaml).
+type backstageEntity struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Title       string            `yaml:"title"`
+		Description string            `yaml:"description"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec      map[string]any `yaml:"spec"`
+	Relations []struct {
+		Type      string `yaml:"type"`
+		TargetRef string `yaml:"targetRef"`
+	} `yaml:"relations"`
+}
+
+// backstageKinds maps the imported Backstage kinds to the kind they become
+// and their place in the output, so referenced resources apply first.
+// Other kinds (Location, Template, custom kinds) are skipped.
+var backstageKinds = map[string]struct {
+	rt    events.ResourceType
+	order int
+}{
+	"user":      {events.IdentityResource, 0},
+	"group":     {events.GroupResource, 1},
+	"domain":    {events.SystemResource, 2},
+	"system":    {events.SystemResource, 3},
+	"api":       {events.APIResource, 4},
+	"component": {events.ComponentResource, 5},
+	"resource":  {events.ComponentResource, 5},
+}
+
+// BackstageID returns the id of the resource imported from the Backstage
+// entity ref "kind:namespace/name"; refs are case-insensitive, so re-imports
+// update the same resources.
+func BackstageID(ref string) uuid.UUID {
+	return uuid.NewSHA1(NameNamespace, []byte("backstage:"+strings.ToLower(ref)))
+}
+
+// backstageRef completes a possibly partial entity ref ("name",
+// "namespace/name", "kind:name") to "kind:namespace/name", lowercased.
+func backstageRef(ref, defaultKind, defaultNamespace string) string {
+	ref = strings.TrimSpace(ref)
+	kind := defaultKind
+	if k, rest, ok := strings.Cut(ref, ":"); ok {
+		kind, ref = k, rest
+	}
+	namespace := defaultNamespace
+	if ns, name, ok := strings.Cut(ref, "/"); ok {
+		namespace, ref = ns, name
+	}
+	return strings.ToLower(kind + ":" + namespace + "/" + ref)
+}
+
+func (e *backstageEntity) namespace() string {
+	if ns := strings.TrimSpace(e.Metadata.Namespace); ns != "" {
+		return ns
+	}
+	return "default"
+}
+
+func (e *backstageEntity) ref() string {
+	return backstageRef(e.Metadata.Name, e.Kind, e.namespace())
+}
+
+// refs returns the entity refs of the spec key (a string or a list of
+// strings) followed by the targets of relations of type relation.
+func (e *backstageEntity) refs(key, relation, defaultKind string) []string {
+	var out []string
+	switch v := e.Spec[key].(type) {
+	case string:
+		out = append(out, backstageRef(v, defaultKind, e.namespace()))
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, backstageRef(s, defaultKind, e.namespace()))
+			}
+		}
+	}
+	for _, r := range e.Relations {
+		if strings.EqualFold(r.Type, relation) {
+			out = append(out, backstageRef(r.TargetRef, defaultKind, e.namespace()))
+		}
+	}
+	return uniqueStrings(out)
+}
+
+// refOfKind returns the first entity ref of key or relation whose kind is kind.
+func (e *backstageEntity) refOfKind(key, relation, kind string) (string, bool) {
+	for _, ref := range e.refs(key, relation, kind) {
+		if strings.HasPrefix(ref, kind+":") {
+			return ref, true
+		}
+	}
+	return "", false
+}
+
+func (e *backstageEntity) displayName() string {
+	if t := strings.TrimSpace(e.Metadata.Title); t != "" {
+		return t
+	}
+	if profile, ok := e.Spec["profile"].(map[string]any); ok {
+		if s, ok := profile["displayName"].(string); ok && strings.TrimSpace(s) != "" {
+			return strings.TrimSpace(s)
+		}
+	}
+	return e.Metadata.Name
+}
+
+// backstageName returns the name of the entity ref "kind:namespace/name".
+func backstageName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// principals returns the token subjects or group claim values a User or Group
+// stands for: its name and, for a User, its profile email.
+func (e *backstageEntity) principals() []string {
+	out := []string{backstageName(e.ref())}
+	if profile, ok := e.Spec["profile"].(map[string]any); ok && strings.EqualFold(e.Kind, "user") {
+		if s, ok := profile["email"].(string); ok && strings.TrimSpace(s) != "" {
+			out = append(out, strings.TrimSpace(s))
+		}
+	}
+	return uniqueStrings(out)
+}
+
+// annotations copies the entity's annotations and adds its ref and owner.
+// A group owner becomes an owner group and a user owner an owner identity,
+// both by name, which Users and Groups list as their principal; annotations
+// the entity sets win.
+func (e *backstageEntity) annotations() map[string]any {
+	out := map[string]any{}
+	for k, v := range e.Metadata.Annotations {
+		out[k] = v
+	}
+	out[BackstageEntityRefKey] = e.ref()
+	switch strings.ToLower(e.Kind) {
+	case "user", "group":
+		if _, ok := out[authz.PrincipalKey]; !ok {
+			out[authz.PrincipalKey] = strings.Join(e.principals(), ",")
+		}
+	}
+	var groups, identities []string
+	for _, ref := range e.refs("owner", "ownedBy", "group") {
+		switch {
+		case strings.HasPrefix(ref, "group:"):
+			groups = append(groups, backstageName(ref))
+		case strings.HasPrefix(ref, "user:"):
+			identities = append(identities, backstageName(ref))
+		}
+	}
+	if _, ok := out[authz.OwnerGroupsKey]; !ok && len(groups) > 0 {
+		out[authz.OwnerGroupsKey] = strings.Join(groups, ",")
+	}
+	if _, ok := out[authz.OwnerIdentitiesKey]; !ok && len(identities) > 0 {
+		out[authz.OwnerIdentitiesKey] = strings.Join(identities, ",")
+	}
+	return out
+}
+
+// backstageAPIType maps a Backstage API spec.type onto an API type name.
+func backstageAPIType(t string) string {
+	switch strings.ToLower(strings.TrimSpace(t)) {
+	case "openapi":
+		return "OpenAPI"
+	case "graphql":
+		return "GraphQL"
+	case "grpc":
+		return "GRPC"
+	default:
+		return "Other"
+	}
+}
+
+// DecodeBackstageDocuments decodes Backstage catalog entities (a YAML stream
+// of catalog-info.yaml entities, a list of entities or a catalog API response
+// with items) into landscape [Document] values:
+//
+//   - Domain and System become Systems; a Domain is abstract and the parent of its Systems.
+//   - Component and Resource become Components of their system (spec.system or a partOf relation),
+//     providing and consuming the APIs of providesApis and consumesApis.
+//   - API becomes an API of its system.
+//   - Group becomes a Group whose members are the Users naming it in memberOf, and User an Identity.
+//
+// Ids are [BackstageID] of the entity refs, so re-imports are idempotent; the
+// owner becomes owner annotations naming the Group or User, and Groups and
+// Identities list their name (and a User's profile email) as principal. Other
+// kinds are skipped.
+func DecodeBackstageDocuments(data []byte) ([]Document, error) {
+	entities, err := decodeBackstageEntities(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Memberships are declared on either side; Groups list them all.
+	members := map[string][]string{}
+	for _, e := range entities {
+		switch strings.ToLower(e.Kind) {
+		case "user":
+			for _, g := range e.refs("memberOf", "memberOf", "group") {
+				members[g] = append(members[g], BackstageID(e.ref()).String())
+			}
+		case "group":
+			for _, u := range e.refs("members", "hasMember", "user") {
+				members[e.ref()] = append(members[e.ref()], BackstageID(u).String())
+			}
+		}
+	}
+
+	type ordered struct {
+		doc   Document
+		order int
+	}
+	var out []ordered
+	for _, e := range entities {
+		kind, ok := backstageKinds[strings.ToLower(e.Kind)]
+		if !ok {
+			continue
+		}
+		ref := e.ref()
+		spec := map[string]any{
+			primaryIDField[kind.rt]: BackstageID(ref).String(),
+			"displayName":           e.displayName(),
+			"annotations":           e.annotations(),
+		}
+		if d := strings.TrimSpace(e.Metadata.Description); d != "" {
+			spec["description"] = d
+		}
+		switch strings.ToLower(e.Kind) {
+		case "group":
+			if ids := uniqueStrings(members[ref]); len(ids) > 0 {
+				spec["members"] = toAnySlice(ids)
+			}
+		case "domain":
+			spec["abstract"] = true
+		case "system":
+			if domain, ok := e.refOfKind("domain", "partOf", "domain"); ok {
+				spec["parent"] = BackstageID(domain).String()
+			}
+		case "api":
+			spec["type"] = backstageAPIType(fmt.Sprint(e.Spec["type"]))
+			if sys, ok := e.refOfKind("system", "partOf", "system"); ok {
+				spec["system"] = BackstageID(sys).String()
+			}
+		case "component", "resource":
+			if sys, ok := e.refOfKind("system", "partOf", "system"); ok {
+				spec["system"] = BackstageID(sys).String()
+			}
+			for _, f := range [][2]string{{"provides", "providesApi"}, {"consumes", "consumesApi"}} {
+				var ids []any
+				for _, api := range e.refs(f[1]+"s", f[1], "api") {
+					ids = append(ids, BackstageID(api).String())
+				}
+				if len(ids) > 0 {
+					spec[f[0]] = ids
+				}
+			}
+		}
+		out = append(out, ordered{
+			doc:   Document{Version: DefaultCSVVersion, Kind: DocumentKind(kind.rt), Spec: spec},
+			order: kind.order,
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no Backstage entities of an imported kind found")
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].order < out[j].order })
+	docs := make([]Document, len(out))
+	for i, o := range out {
+		docs[i] = o.doc
+	}
+	return docs, nil
+}
+
+// decodeBackstageEntities reads the entities of a YAML (or JSON) stream whose
+// documents are entities, lists of entities or {items: [...]}.
+func decodeBackstageEntities(data []byte) ([]backstageEntity, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var entities []backstageEntity
+	for i := 0; ; i++ {
+		var node yaml.Node
+		if err := dec.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(node.Content) == 0 {
+			continue
+		}
+		var batch []backstageEntity
+		root := node.Content[0]
+		switch {
+		case root.Kind == yaml.SequenceNode:
+			if err := root.Decode(&batch); err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+		case root.Kind == yaml.MappingNode && mappingHas(root, "items") && !mappingHas(root, "kind"):
+			var list struct {
+				Items []backstageEntity `yaml:"items"`
+			}
+			if err := root.Decode(&list); err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			batch = list.Items
+		case root.Kind == yaml.MappingNode:
+			var e backstageEntity
+			if err := root.Decode(&e); err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			batch = []backstageEntity{e}
+		default:
+			return nil, fmt.Errorf("document %d: not a Backstage entity", i)
+		}
+		for _, e := range batch {
+			if !strings.HasPrefix(strings.TrimSpace(e.APIVersion), "backstage.io/") {
+				return nil, fmt.Errorf("document %d: apiVersion %q is not a Backstage entity version", i, e.APIVersion)
+			}
+			if strings.TrimSpace(e.Kind) == "" || strings.TrimSpace(e.Metadata.Name) == "" {
+				return nil, fmt.Errorf("document %d: entity needs kind and metadata.name", i)
+			}
+		}
+		entities = append(entities, batch...)
+	}
+	return entities, nil
+}
+
+func mappingHas(n *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueStrings(in []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, s := range in {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func toAnySlice(in []string) []any {
+	out := make([]any, len(in))
+	for i, s := range in {
+		out[i] = s
+	}
+	return out
+}
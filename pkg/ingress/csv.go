@@ -73,7 +73,7 @@ var primaryIDField = map[events.ResourceType]string{
 func ValidateCSVOptions(opts ParseOptions) error {
 	if opts.Kind != events.UnknownResourceType {
 		if _, ok := documentKinds[opts.Kind]; !ok {
-			return fmt.Errorf("CSV unsupported kind %q", opts.Kind)
+			return fmt.Errorf("CSV %w %q", ErrUnsupportedKind, opts.Kind)
 		}
 		return nil
 	}
@@ -179,10 +179,10 @@ func DecodeCSVDocuments(data []byte, opts ParseOptions) ([]Document, error) {
 			if s != "" {
 				rt := events.ParseResourceType(s)
 				if rt == events.UnknownResourceType {
-					return nil, fmt.Errorf("row %d: unsupported kind %q", rowIdx+1, s)
+					return nil, fmt.Errorf("row %d: %w %q", rowIdx+1, ErrUnsupportedKind, s)
 				}
 				if _, ok := documentKinds[rt]; !ok {
-					return nil, fmt.Errorf("row %d: unsupported kind %q", rowIdx+1, s)
+					return nil, fmt.Errorf("row %d: %w %q", rowIdx+1, ErrUnsupportedKind, s)
 				}
 				kind = rt
 			}
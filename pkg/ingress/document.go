@@ -1,12 +1,17 @@
 package ingress
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"go.emeland.io/modelsrv/pkg/events"
 )
 
+// ErrUnsupportedKind is wrapped by the errors of documents, rows and columns
+// naming a kind that is not a landscape document kind.
+var ErrUnsupportedKind = errors.New("unsupported kind")
+
 // DocumentKind is the resource discriminator for a [Document]; values are [events.ResourceType]
 // and match the on-wire `kind` field (YAML/JSON) or CSV resourcetype column.
 type DocumentKind events.ResourceType
@@ -61,10 +66,10 @@ func parseDocumentKind(s string) (DocumentKind, error) {
 	}
 	rt := events.ParseResourceType(s)
 	if rt == events.UnknownResourceType {
-		return 0, fmt.Errorf("unsupported kind %q", s)
+		return 0, fmt.Errorf("%w %q", ErrUnsupportedKind, s)
 	}
 	if _, ok := documentKinds[rt]; !ok {
-		return 0, fmt.Errorf("unsupported kind %q", s)
+		return 0, fmt.Errorf("%w %q", ErrUnsupportedKind, s)
 	}
 	return DocumentKind(rt), nil
 }
@@ -74,6 +79,29 @@ type Document struct {
 	Version string         `yaml:"version" json:"version"`
 	Kind    DocumentKind   `yaml:"kind" json:"kind"`
 	Spec    map[string]any `yaml:"spec" json:"spec"`
+	// Aliases map short names to a UUID or a resource name for the name
+	// references of every document of the file (see [ResolveNames]). A
+	// document holding only aliases sets them for the whole file.
+	Aliases map[string]string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+}
+
+// withFileAliases adds the aliases of alias-only documents to every
+// document of the file, keeping the documents' own.
+func withFileAliases(docs []Document, aliases map[string]string) []Document {
+	if len(aliases) == 0 {
+		return docs
+	}
+	for i := range docs {
+		merged := make(map[string]string, len(aliases)+len(docs[i].Aliases))
+		for k, v := range aliases {
+			merged[k] = v
+		}
+		for k, v := range docs[i].Aliases {
+			merged[k] = v
+		}
+		docs[i].Aliases = merged
+	}
+	return docs
 }
 
 // ValidVersion reports whether v uses an accepted emeland.io API version prefix.
@@ -0,0 +1,39 @@
+// Code generated by tools/gen. DO NOT EDIT.
+
+package ingress
+
+import "go.emeland.io/modelsrv/pkg/events"
+
+// documentSpecKeys lists the spec keys each document kind reads; strict
+// parsing rejects any other key.
+var documentSpecKeys = map[events.ResourceType][]string{
+	events.ContextTypeResource:          {"contextTypeId", "displayName", "name", "description", "annotations"},
+	events.ContextResource:              {"contextId", "displayName", "name", "description", "parent", "type", "annotations"},
+	events.NodeTypeResource:             {"nodeTypeId", "displayName", "name", "description", "annotations"},
+	events.NodeResource:                 {"nodeId", "displayName", "name", "description", "nodeTypeId", "nodeType", "annotations"},
+	events.SystemResource:               {"systemId", "displayName", "name", "description", "abstract", "version", "parent", "annotations"},
+	events.APIResource:                  {"apiId", "displayName", "name", "description", "system", "systemId", "type", "version", "annotations"},
+	events.ComponentResource:            {"componentId", "displayName", "name", "description", "version", "system", "systemId", "consumes", "provides", "annotations"},
+	events.SystemInstanceResource:       {"instanceId", "systemInstanceId", "displayName", "name", "system", "systemId", "context", "contextId", "annotations"},
+	events.APIInstanceResource:          {"instanceId", "apiInstanceId", "displayName", "name", "api", "apiId", "systemInstance", "systemInstanceId", "annotations"},
+	events.ComponentInstanceResource:    {"instanceId", "componentInstanceId", "displayName", "name", "component", "componentId", "systemInstance", "systemInstanceId", "annotations"},
+	events.FindingResource:              {"findingId", "displayName", "summary", "name", "description", "resources", "annotations"},
+	events.FindingTypeResource:          {"findingTypeId", "displayName", "name", "description", "severity", "annotations"},
+	events.ArtifactResource:             {"artifactId", "displayName", "name", "description", "hash", "annotations"},
+	events.ArtifactInstanceResource:     {"artifactInstanceId", "displayName", "name", "description", "artifact", "componentInstance", "annotations"},
+	events.ProductResource:              {"productId", "displayName", "name", "description", "vendor", "versions", "mergeVersions", "annotations"},
+	events.OrgUnitResource:              {"orgUnitId", "displayName", "name", "description", "annotations"},
+	events.GroupResource:                {"groupId", "displayName", "name", "description", "members", "orgUnit", "annotations"},
+	events.IdentityResource:             {"identityId", "displayName", "name", "description", "orgUnit", "annotations"},
+	events.PermissionSpecResource:       {"permissionSpecId", "displayName", "name", "description", "annotations"},
+	events.RoleSpecResource:             {"roleSpecId", "displayName", "name", "description", "permissions", "annotations"},
+	events.PermissionResource:           {"permissionId", "displayName", "name", "description", "spec", "annotations"},
+	events.RoleResource:                 {"roleId", "displayName", "name", "description", "spec", "roleSpecId", "context", "contextId", "permissions", "resources", "annotations"},
+	events.BindingResource:              {"bindingId", "displayName", "name", "description", "role", "roleId", "subject", "annotations"},
+	events.FilterRuleResource:           {"ruleId", "displayName", "name", "description", "resourceType", "expression", "action", "findingType", "message", "disabled"},
+	events.MergeRuleResource:            {"ruleId", "displayName", "name", "description"},
+	events.CapabilityResource:           {"capabilityId", "displayName", "name", "versions", "annotations"},
+	events.ParameterResource:            {"parameterId", "displayName", "name", "values", "annotations"},
+	events.CapacityResourceTypeResource: {"capacityResourceTypeId", "displayName", "name", "description", "unit", "annotations"},
+	events.CapacityResource:             {"capacityId", "displayName", "name", "description", "resourceTypeRef", "contextRef", "category", "amount", "annotations"},
+}
@@ -15,6 +15,19 @@ const (
 	FormatYAML Format = "yaml"
 	FormatJSON Format = "json"
 	FormatCSV  Format = "csv"
+	// FormatBackstage is Backstage catalog entities (catalog-info.yaml). It
+	// is never detected; set it explicitly (see [DecodeBackstageDocuments]).
+	FormatBackstage Format = "backstage"
+	// FormatKubernetes is exported Kubernetes manifests. Like FormatBackstage
+	// it must be set explicitly (see [DecodeKubernetesDocuments]).
+	FormatKubernetes Format = "kubernetes"
+	// FormatAPISpec is an OpenAPI, Swagger or AsyncAPI file; set explicitly
+	// (see [DecodeAPISpecDocuments]).
+	FormatAPISpec Format = "apispec"
+	// FormatCycloneDX and FormatSPDX are CycloneDX and SPDX JSON SBOMs; set
+	// explicitly (see [DecodeCycloneDXDocuments], [DecodeSPDXDocuments]).
+	FormatCycloneDX Format = "cyclonedx"
+	FormatSPDX      Format = "spdx"
 )
 
 // ParseOptions controls how [Parse] interprets bytes.
@@ -30,11 +43,23 @@ type ParseOptions struct {
 	// fallback for names without a known extension, never an override.
 	ContentType string
 
+	// Strict rejects document keys and spec keys the document's kind does not
+	// read (see [ErrUnknownField]) instead of ignoring them, so typos surface.
+	// The accepted keys are those of the kind's JSON Schema (package schemas).
+	Strict bool
+
 	// CSV (and any non-self-describing format)
 	Kind      events.ResourceType // optional default when no per-row kind column
 	Version   string              // optional; defaults to DefaultCSVVersion
 	Delimiter rune
 	Columns   map[string]string // header -> spec path; "kind" / "version" / "id" are special
+
+	// Kubernetes holds the mapping rules of [FormatKubernetes].
+	Kubernetes KubernetesOptions
+	// APISpec holds the options of [FormatAPISpec].
+	APISpec APISpecOptions
+	// SBOM holds the options of [FormatCycloneDX] and [FormatSPDX].
+	SBOM SBOMOptions
 }
 
 // DetectFormat returns a format from a file name or path extension.
@@ -92,14 +117,34 @@ func Parse(name string, data []byte, opts ParseOptions) ([]Document, error) {
 	if err != nil {
 		return nil, err
 	}
+	var docs []Document
 	switch format {
 	case FormatYAML:
-		return DecodeDocuments(data)
+		docs, err = DecodeDocuments(data)
 	case FormatJSON:
-		return DecodeJSONDocuments(data)
+		docs, err = DecodeJSONDocuments(data)
 	case FormatCSV:
-		return DecodeCSVDocuments(data, opts)
+		docs, err = DecodeCSVDocuments(data, opts)
+	case FormatBackstage:
+		docs, err = DecodeBackstageDocuments(data)
+	case FormatKubernetes:
+		docs, err = DecodeKubernetesDocuments(data, opts.Kubernetes)
+	case FormatAPISpec:
+		docs, err = DecodeAPISpecDocuments(data, opts.APISpec)
+	case FormatCycloneDX:
+		docs, err = DecodeCycloneDXDocuments(data, opts.SBOM)
+	case FormatSPDX:
+		docs, err = DecodeSPDXDocuments(data, opts.SBOM)
 	default:
 		return nil, fmt.Errorf("unsupported format %q", format)
 	}
+	if err != nil {
+		return nil, err
+	}
+	if opts.Strict {
+		if err := checkStrict(format, data, docs); err != nil {
+			return nil, err
+		}
+	}
+	return docs, nil
 }
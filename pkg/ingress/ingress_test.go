@@ -2,13 +2,21 @@ package ingress_test
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.emeland.io/modelsrv/pkg/authz"
 	"go.emeland.io/modelsrv/pkg/events"
 	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/ingress/schemas"
 	"go.emeland.io/modelsrv/pkg/model"
+	mdlapi "go.emeland.io/modelsrv/pkg/model/api"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/component"
+	mdlctx "go.emeland.io/modelsrv/pkg/model/context"
+	"go.emeland.io/modelsrv/pkg/model/system"
 )
 
 var _ = Describe("Parse YAML", func() {
@@ -166,6 +174,65 @@ var _ = Describe("Parse CSV", func() {
 	})
 })
 
+var _ = Describe("Strict parsing", func() {
+	yamlDoc := []byte(`version: emeland.io/v1
+kind: Context
+spec:
+  displayName: prod
+  parnet: root
+`)
+
+	It("ignores unknown spec keys by default", func() {
+		docs, err := ingress.Parse("ctx.yaml", yamlDoc, ingress.ParseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(1))
+	})
+
+	It("rejects unknown spec keys", func() {
+		_, err := ingress.Parse("ctx.yaml", yamlDoc, ingress.ParseOptions{Strict: true})
+		Expect(errors.Is(err, ingress.ErrUnknownField)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`"parnet"`))
+	})
+
+	It("rejects unknown top-level keys", func() {
+		data := []byte(`[{"version":"emeland.io/v1","kind":"Context","metadata":{},"spec":{"displayName":"prod"}}]`)
+		_, err := ingress.Parse("ctx.json", data, ingress.ParseOptions{Strict: true})
+		Expect(errors.Is(err, ingress.ErrUnknownField)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`"metadata"`))
+	})
+
+	It("accepts the keys of the kind's schema and file aliases", func() {
+		data := []byte(`aliases:
+  pay: payments
+---
+version: emeland.io/v1
+kind: SystemInstance
+spec:
+  displayName: payments-eu
+  system: pay
+  context: prod
+  annotations:
+    team: payments
+`)
+		_, err := ingress.Parse("si.yaml", data, ingress.ParseOptions{Strict: true})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("embeds a schema per document kind", func() {
+		data, ok := schemas.Get("context")
+		Expect(ok).To(BeTrue())
+		var schema map[string]any
+		Expect(json.Unmarshal(data, &schema)).To(Succeed())
+		spec := schema["properties"].(map[string]any)["spec"].(map[string]any)
+		Expect(spec["properties"]).To(HaveKey("parent"))
+		Expect(spec["additionalProperties"]).To(BeFalse())
+		Expect(schemas.Kinds()).To(ContainElements("API", "Context", "Capacity"))
+
+		_, ok = schemas.Get("Nonsense")
+		Expect(ok).To(BeFalse())
+	})
+})
+
 var _ = Describe("DetectFormat", func() {
 	It("detects extensions", func() {
 		Expect(ingress.DetectFormat("a.yaml")).To(Equal(ingress.FormatYAML))
@@ -208,3 +275,623 @@ var _ = Describe("ResolveFormat", func() {
 		Expect(err).To(MatchError(ContainSubstring("cannot detect format")))
 	})
 })
+
+type authorizerFunc func(rt events.ResourceType, id uuid.UUID, current, next any) error
+
+func (f authorizerFunc) AuthorizeWrite(rt events.ResourceType, id uuid.UUID, current, next any) error {
+	return f(rt, id, current, next)
+}
+
+var _ = Describe("ApplyAllWithOptions", func() {
+	It("asks the Authorizer with the current and next resource and skips refused documents", func() {
+		data := []byte(`---
+version: emeland.io/v1
+kind: Context
+spec:
+  contextId: "22222222-2222-2222-2222-222222222222"
+  displayName: "Production v2"
+---
+version: emeland.io/v1
+kind: Context
+spec:
+  contextId: "33333333-3333-3333-3333-333333333333"
+  displayName: "Staging"
+`)
+		m, err := model.NewModel(events.NewListSink())
+		Expect(err).NotTo(HaveOccurred())
+		prod := mdlctx.NewContext(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+		prod.SetDisplayName("Production")
+		Expect(m.AddContext(prod)).To(Succeed())
+
+		denied := errors.New("denied")
+		var seen []string
+		auth := authorizerFunc(func(rt events.ResourceType, id uuid.UUID, current, next any) error {
+			Expect(rt).To(Equal(events.ContextResource))
+			Expect(next.(mdlctx.Context).GetContextId()).To(Equal(id))
+			if current == nil {
+				seen = append(seen, "new "+next.(mdlctx.Context).GetDisplayName())
+				return denied
+			}
+			seen = append(seen, current.(mdlctx.Context).GetDisplayName()+" -> "+next.(mdlctx.Context).GetDisplayName())
+			return nil
+		})
+
+		docs, err := ingress.Parse("ctx.yaml", data, ingress.ParseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		res := ingress.ApplyAllWithOptions(docs, m, ingress.ApplyOptions{Authorizer: auth})
+		Expect(seen).To(Equal([]string{"Production -> Production v2", "new Staging"}))
+		Expect(res.Applied).To(Equal(1))
+		Expect(res.Failed).To(HaveLen(1))
+		Expect(res.Failed[0].Index).To(Equal(1))
+		Expect(res.Failed[0]).To(MatchError(denied))
+		Expect(res.Resources).To(Equal([]common.ResourceRef{{ResourceId: prod.GetContextId(), ResourceType: events.ContextResource}}))
+		Expect(m.GetContextById(prod.GetContextId()).GetDisplayName()).To(Equal("Production v2"))
+		Expect(m.GetContextById(uuid.MustParse("33333333-3333-3333-3333-333333333333"))).To(BeNil())
+	})
+
+	It("applies nothing and emits no event when an atomic batch has a bad document", func() {
+		data := []byte(`---
+version: emeland.io/v1
+kind: Context
+spec:
+  contextId: "22222222-2222-2222-2222-222222222222"
+  displayName: "Production v2"
+---
+version: emeland.io/v1
+kind: Context
+spec:
+  contextId: "33333333-3333-3333-3333-333333333333"
+  displayName: "Staging"
+---
+version: emeland.io/v1
+kind: Context
+spec:
+  description: "No id or name"
+`)
+		sink := events.NewListSink()
+		m, err := model.NewModel(sink)
+		Expect(err).NotTo(HaveOccurred())
+		prod := mdlctx.NewContext(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+		prod.SetDisplayName("Production")
+		Expect(m.AddContext(prod)).To(Succeed())
+		before := len(sink.GetEvents())
+
+		docs, err := ingress.Parse("ctx.yaml", data, ingress.ParseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		res := ingress.ApplyAllWithOptions(docs, m, ingress.ApplyOptions{Atomic: true})
+		Expect(res.Rejected).To(BeTrue())
+		Expect(res.Applied).To(Equal(0))
+		Expect(res.Resources).To(BeEmpty())
+		Expect(res.Failed).To(HaveLen(1))
+		Expect(res.Failed[0].Index).To(Equal(2))
+		Expect(m.GetContextById(prod.GetContextId()).GetDisplayName()).To(Equal("Production"))
+		Expect(m.GetContextById(uuid.MustParse("33333333-3333-3333-3333-333333333333"))).To(BeNil())
+		Expect(sink.GetEvents()).To(HaveLen(before))
+
+		res = ingress.ApplyAllWithOptions(docs[:2], m, ingress.ApplyOptions{Atomic: true})
+		Expect(res.Rejected).To(BeFalse())
+		Expect(res.Applied).To(Equal(2))
+		Expect(sink.GetEvents()).To(HaveLen(before + 2))
+		Expect(m.GetContextById(prod.GetContextId()).GetDisplayName()).To(Equal("Production v2"))
+	})
+})
+
+var _ = Describe("Backstage import", func() {
+	catalog := []byte(`apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: payments-backend
+  title: Payments Backend
+  annotations:
+    github.com/project-slug: acme/payments
+spec:
+  type: service
+  owner: team-payments
+  system: payments
+  providesApis: [payments-api]
+  consumesApis: [api:default/ledger-api]
+---
+apiVersion: backstage.io/v1alpha1
+kind: API
+metadata:
+  name: payments-api
+spec:
+  type: openapi
+  owner: user:jdoe
+  system: payments
+---
+apiVersion: backstage.io/v1alpha1
+kind: System
+metadata:
+  name: payments
+spec:
+  owner: team-payments
+  domain: finance
+---
+apiVersion: backstage.io/v1alpha1
+kind: Domain
+metadata:
+  name: finance
+spec:
+  owner: team-payments
+---
+apiVersion: backstage.io/v1alpha1
+kind: Group
+metadata:
+  name: team-payments
+spec:
+  type: team
+  profile:
+    displayName: Payments Team
+---
+apiVersion: backstage.io/v1alpha1
+kind: User
+metadata:
+  name: jdoe
+spec:
+  profile:
+    email: jdoe@acme.example
+  memberOf: [team-payments]
+---
+apiVersion: backstage.io/v1alpha1
+kind: Location
+metadata:
+  name: catalog
+spec:
+  targets: [./other.yaml]
+`)
+
+	It("maps entities and relations onto the landscape with stable ids", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+		docs, err := ingress.Parse("catalog-info.yaml", catalog, ingress.ParseOptions{Format: ingress.FormatBackstage, Strict: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(6))
+		res := ingress.ApplyAll(docs, m)
+		Expect(res.Failed).To(BeEmpty())
+
+		compID := ingress.BackstageID("component:default/payments-backend")
+		sysID := ingress.BackstageID("system:default/payments")
+		groupID := ingress.BackstageID("group:default/team-payments")
+		comp := m.GetComponentById(compID)
+		Expect(comp).NotTo(BeNil())
+		Expect(comp.GetDisplayName()).To(Equal("Payments Backend"))
+		Expect(comp.GetSystem().SystemId).To(Equal(sysID))
+		Expect(comp.GetProvides()).To(HaveLen(1))
+		Expect(comp.GetProvides()[0].ApiID).To(Equal(ingress.BackstageID("api:default/payments-api")))
+		Expect(comp.GetConsumes()[0].ApiID).To(Equal(ingress.BackstageID("api:default/ledger-api")))
+		Expect(comp.GetAnnotations().GetValue(authz.OwnerGroupsKey)).To(Equal("team-payments"))
+		Expect(comp.GetAnnotations().GetValue(ingress.BackstageEntityRefKey)).To(Equal("component:default/payments-backend"))
+		Expect(comp.GetAnnotations().GetValue("github.com/project-slug")).To(Equal("acme/payments"))
+
+		Expect(m.GetApiById(ingress.BackstageID("api:default/payments-api")).GetAnnotations().GetValue("emeland.io/owner-identities")).To(Equal("jdoe"))
+		domainID := ingress.BackstageID("domain:default/finance")
+		Expect(m.GetSystemById(domainID).GetAbstract()).To(BeTrue())
+		Expect(docs).To(ContainElement(HaveField("Spec", HaveKeyWithValue("parent", domainID.String()))))
+
+		group := m.GetGroupById(groupID)
+		Expect(group.GetDisplayName()).To(Equal("Payments Team"))
+		Expect(group.GetMembers()).To(HaveLen(1))
+		Expect(group.GetMembers()[0].IdentityId).To(Equal(ingress.BackstageID("user:default/jdoe")))
+		Expect(group.GetAnnotations().GetValue(authz.PrincipalKey)).To(Equal("team-payments"))
+		Expect(m.GetIdentityById(ingress.BackstageID("user:default/jdoe")).GetAnnotations().GetValue(authz.PrincipalKey)).To(Equal("jdoe,jdoe@acme.example"))
+
+		eval := authz.NewEvaluator(authz.Config{})
+		Expect(eval.CanSee(authz.Principal{Subject: "asmith", Groups: []string{"team-payments"}}, events.ComponentResource, comp)).To(BeTrue())
+		Expect(eval.CanSee(authz.Principal{Subject: "asmith", Groups: []string{"team-ledger"}}, events.ComponentResource, comp)).To(BeFalse())
+		api := m.GetApiById(ingress.BackstageID("api:default/payments-api"))
+		Expect(eval.CanSee(authz.Principal{Subject: "jdoe"}, events.APIResource, api)).To(BeTrue())
+
+		again, err := ingress.Parse("catalog-info.yaml", catalog, ingress.ParseOptions{Format: ingress.FormatBackstage})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(docs))
+	})
+
+	It("reads catalog API responses", func() {
+		data := []byte(`{"items":[{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"payments","namespace":"Prod"},
+  "relations":[{"type":"partOf","targetRef":"domain:prod/finance"}]}]}`)
+		docs, err := ingress.DecodeBackstageDocuments(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(1))
+		Expect(docs[0].Spec).To(HaveKeyWithValue("systemId", ingress.BackstageID("system:prod/payments").String()))
+		Expect(docs[0].Spec).To(HaveKeyWithValue("parent", ingress.BackstageID("domain:prod/finance").String()))
+	})
+
+	It("rejects documents that are not Backstage entities", func() {
+		_, err := ingress.DecodeBackstageDocuments([]byte("version: emeland.io/v1\nkind: Context\nspec: {}\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Kubernetes import", func() {
+	manifests := []byte(`apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: payments
+  - apiVersion: v1
+    kind: Node
+    metadata:
+      name: worker-1
+  - apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: payments-backend
+      namespace: payments
+      labels:
+        app.kubernetes.io/name: payments
+  - apiVersion: apps/v1
+    kind: Deployment
+    metadata:
+      name: unlabelled
+      namespace: payments
+  - apiVersion: v1
+    kind: Service
+    metadata:
+      name: payments-api
+      namespace: payments
+      labels:
+        emeland.io/api: payments-api
+    spec:
+      ports:
+        - name: http
+          port: 8080
+  - apiVersion: networking.k8s.io/v1
+    kind: Ingress
+    metadata:
+      name: payments
+      namespace: payments
+    spec:
+      tls:
+        - hosts: [pay.example.com]
+      rules:
+        - host: pay.example.com
+          http:
+            paths:
+              - path: /v1
+                backend:
+                  service:
+                    name: payments-api
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: settings
+      namespace: payments
+`)
+	landscape := []byte(`version: emeland.io/v1
+kind: System
+spec:
+  displayName: payments
+---
+version: emeland.io/v1
+kind: Component
+spec:
+  displayName: payments
+  system: payments
+---
+version: emeland.io/v1
+kind: API
+spec:
+  displayName: payments-api
+  system: payments
+`)
+
+	It("maps namespaces, nodes, workloads and endpoints onto the landscape", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+		docs, err := ingress.Parse("landscape.yaml", landscape, ingress.ParseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ingress.ApplyAll(docs, m).Failed).To(BeEmpty())
+
+		opts := ingress.ParseOptions{Format: ingress.FormatKubernetes, Strict: true, Kubernetes: ingress.KubernetesOptions{Cluster: "prod-eu"}}
+		docs, err = ingress.Parse("cluster.yaml", manifests, opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(6))
+		res := ingress.ApplyAll(docs, m)
+		Expect(res.Failed).To(BeEmpty())
+
+		ns := m.GetContextById(ingress.KubernetesID("prod-eu", "Namespace", "", "payments"))
+		Expect(ns).NotTo(BeNil())
+		Expect(ns.GetParentId()).To(Equal(ingress.KubernetesID("prod-eu", "Cluster", "", "prod-eu")))
+		Expect(m.GetNodeById(ingress.KubernetesID("prod-eu", "Node", "", "worker-1"))).NotTo(BeNil())
+
+		ci := m.GetComponentInstanceById(ingress.KubernetesID("prod-eu", "Deployment", "payments", "payments-backend"))
+		Expect(ci).NotTo(BeNil())
+		Expect(ci.GetComponentRef().ComponentId).To(Equal(ingress.GeneratedID(events.ComponentResource, "payments", ingress.GeneratedID(events.SystemResource, "payments"))))
+		Expect(ci.GetAnnotations().GetValue(ingress.KubernetesNamespaceKey)).To(Equal("payments"))
+
+		svc := m.GetApiInstanceById(ingress.KubernetesID("prod-eu", "Service", "payments", "payments-api"))
+		Expect(svc).NotTo(BeNil())
+		Expect(svc.GetAnnotations().GetValue("emeland.io/endpoint.host")).To(Equal("payments-api.payments.svc.cluster.local"))
+		Expect(svc.GetAnnotations().GetValue("emeland.io/endpoint.port")).To(Equal("8080"))
+		Expect(svc.GetAnnotations().GetValue("emeland.io/endpoint.protocol")).To(Equal("http"))
+
+		ing := m.GetApiInstanceById(ingress.KubernetesID("prod-eu", "Ingress", "payments", "payments/pay.example.com/v1"))
+		Expect(ing).NotTo(BeNil())
+		Expect(ing.GetApiRef().ApiID).To(Equal(svc.GetApiRef().ApiID))
+		Expect(ing.GetAnnotations().GetValue("emeland.io/endpoint.protocol")).To(Equal("https"))
+		Expect(ing.GetAnnotations().GetValue("emeland.io/endpoint.path")).To(Equal("/v1"))
+	})
+
+	It("rejects documents that are not Kubernetes objects", func() {
+		_, err := ingress.DecodeKubernetesDocuments([]byte("metadata:\n  name: x\n"), ingress.KubernetesOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("API spec import", func() {
+	openapi := []byte(`openapi: 3.0.3
+info:
+  title: Payments API
+  version: 1.4.0
+  description: Accepts and settles payments.
+  x-emeland-system: payments
+paths: {}
+`)
+
+	It("creates an API of the spec's System with its hash", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+		sysID := uuid.New()
+		sys := system.NewSystem(sysID)
+		sys.SetDisplayName("payments")
+		Expect(m.AddSystem(sys)).To(Succeed())
+
+		docs, err := ingress.Parse("payments.yaml", openapi, ingress.ParseOptions{Format: ingress.FormatAPISpec, Strict: true})
+		Expect(err).NotTo(HaveOccurred())
+		res := ingress.ApplyAll(docs, m)
+		Expect(res.Failed).To(BeEmpty())
+		Expect(res.Resources).To(HaveLen(1))
+
+		api := m.GetApiById(res.Resources[0].ResourceId)
+		Expect(api.GetDisplayName()).To(Equal("Payments API"))
+		Expect(api.GetDescription()).To(Equal("Accepts and settles payments."))
+		Expect(api.GetVersion().Version).To(Equal("1.4.0"))
+		Expect(api.GetType()).To(Equal(mdlapi.OpenAPI))
+		Expect(api.GetSystem().SystemId).To(Equal(sysID))
+		Expect(api.GetAnnotations().GetValue(ingress.APISpecHashKey)).To(HavePrefix("sha256:"))
+		Expect(api.GetAnnotations().GetValue(ingress.APISpecFormatKey)).To(Equal("openapi 3.0.3"))
+	})
+
+	It("falls back to the default System and maps AsyncAPI to Other", func() {
+		data := []byte(`{"asyncapi": "2.6.0", "info": {"title": "Payment events", "version": "2"}}`)
+		docs, err := ingress.DecodeAPISpecDocuments(data, ingress.APISpecOptions{System: "payments"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs[0].Spec).To(HaveKeyWithValue("system", "payments"))
+		Expect(docs[0].Spec).To(HaveKeyWithValue("type", "Other"))
+
+		_, err = ingress.DecodeAPISpecDocuments(data, ingress.APISpecOptions{})
+		Expect(err).To(MatchError(ContainSubstring("x-emeland-system")))
+	})
+
+	It("keeps unquoted versions of Swagger 2 specs as written", func() {
+		data := []byte(`swagger: 2.0
+info:
+  title: Legacy Payments
+  version: 1.0
+x-emeland-system: payments
+`)
+		docs, err := ingress.DecodeAPISpecDocuments(data, ingress.APISpecOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs[0].Spec).To(HaveKeyWithValue("type", "OpenAPI"))
+		Expect(docs[0].Spec).To(HaveKeyWithValue("version", map[string]any{"version": "1.0"}))
+		Expect(docs[0].Spec["annotations"]).To(HaveKeyWithValue(ingress.APISpecFormatKey, "swagger 2.0"))
+
+		docs, err = ingress.DecodeAPISpecDocuments([]byte("openapi: 3.1.0\ninfo:\n  title: Ledger\n  version: 2.10\n"), ingress.APISpecOptions{System: "s"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs[0].Spec).To(HaveKeyWithValue("version", map[string]any{"version": "2.10"}))
+	})
+
+	It("rejects files that are no API specs", func() {
+		_, err := ingress.DecodeAPISpecDocuments([]byte("info:\n  title: x\n"), ingress.APISpecOptions{System: "s"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("SBOM import", func() {
+	cyclonedx := []byte(`{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "metadata": {
+    "component": {"type": "application", "name": "payments", "version": "2.1.0",
+      "supplier": {"name": "ACME"}, "purl": "pkg:oci/payments@sha256%3Aabc"}
+  },
+  "components": [
+    {"type": "library", "name": "lodash", "version": "4.17.21", "publisher": "OpenJS Foundation",
+     "purl": "pkg:npm/lodash@4.17.21",
+     "hashes": [{"alg": "SHA-1", "content": "AAAA"}, {"alg": "SHA-256", "content": "BBBB"}]},
+    {"type": "library", "name": "lodash", "version": "4.17.20", "publisher": "OpenJS Foundation",
+     "purl": "pkg:npm/lodash@4.17.20"},
+    {"type": "library", "name": "openssl", "version": "3.0.13",
+     "cpe": "cpe:2.3:a:openssl:openssl:3.0.13:*:*:*:*:*:*:*"}
+  ]
+}`)
+
+	It("creates Artifacts, Products and vendors linked to the ComponentInstance", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+		ciID := uuid.New()
+		ci := component.NewComponentInstance(ciID)
+		ci.SetDisplayName("payments-prod")
+		Expect(m.AddComponentInstance(ci)).To(Succeed())
+
+		opts := ingress.ParseOptions{Format: ingress.FormatCycloneDX, Strict: true, SBOM: ingress.SBOMOptions{ComponentInstance: "payments-prod"}}
+		docs, err := ingress.Parse("payments.cdx.json", cyclonedx, opts)
+		Expect(err).NotTo(HaveOccurred())
+		res := ingress.ApplyAll(docs, m)
+		Expect(res.Failed).To(BeEmpty())
+
+		lodash := m.GetArtifactById(ingress.SBOMID("artifact:pkg:npm/lodash@4.17.21"))
+		Expect(lodash).NotTo(BeNil())
+		Expect(lodash.GetDisplayName()).To(Equal("lodash@4.17.21"))
+		Expect(lodash.GetHash()).To(Equal("sha256:bbbb"))
+		Expect(lodash.GetAnnotations().GetValue(ingress.SBOMPurlKey)).To(Equal("pkg:npm/lodash@4.17.21"))
+
+		prod := m.GetProductById(ingress.SBOMID("product:pkg:npm/lodash"))
+		Expect(prod).NotTo(BeNil())
+		Expect(prod.GetVersions()).To(HaveLen(2))
+		Expect(prod.GetVersions()[0].Artefacts).To(Equal([]uuid.UUID{lodash.GetArtifactId()}))
+		vendor := m.GetOrgUnitById(prod.GetVendor().OrgUnitId)
+		Expect(vendor).NotTo(BeNil())
+		Expect(vendor.GetDisplayName()).To(Equal("OpenJS Foundation"))
+
+		openssl := m.GetProductById(ingress.SBOMID("product:cpe:openssl:openssl"))
+		Expect(openssl).NotTo(BeNil())
+		Expect(m.GetOrgUnitById(openssl.GetVendor().OrgUnitId).GetDisplayName()).To(Equal("openssl"))
+
+		instances, err := m.GetArtifactInstances()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(HaveLen(4))
+		for _, ai := range instances {
+			Expect(ai.GetAnnotations().GetValue(ingress.ArtifactComponentInstanceKey)).To(Equal(ciID.String()))
+		}
+	})
+
+	It("merges the Product versions of SBOMs sharing a package", func() {
+		m, err := model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+		shop := []byte(`{
+  "bomFormat": "CycloneDX",
+  "components": [
+    {"type": "library", "name": "lodash", "version": "4.17.15", "purl": "pkg:npm/lodash@4.17.15"},
+    {"type": "library", "name": "lodash", "version": "4.17.21", "purl": "pkg:npm/lodash@4.17.21"}
+  ]
+}`)
+		apply := func(name string, data []byte) {
+			docs, err := ingress.Parse(name, data, ingress.ParseOptions{Format: ingress.FormatCycloneDX, Strict: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ingress.ApplyAll(docs, m).Failed).To(BeEmpty())
+		}
+		apply("payments.cdx.json", cyclonedx)
+		apply("shop.cdx.json", shop)
+		apply("payments.cdx.json", cyclonedx)
+
+		artefacts := func(version string) []uuid.UUID {
+			return []uuid.UUID{ingress.SBOMID("artifact:pkg:npm/lodash@" + version)}
+		}
+		prod := m.GetProductById(ingress.SBOMID("product:pkg:npm/lodash"))
+		Expect(prod).NotTo(BeNil())
+		Expect(prod.GetVersions()).To(ConsistOf(
+			HaveField("Artefacts", artefacts("4.17.21")),
+			HaveField("Artefacts", artefacts("4.17.20")),
+			HaveField("Artefacts", artefacts("4.17.15")),
+		))
+	})
+
+	It("imports SPDX packages with purl, CPE and checksums", func() {
+		data := []byte(`{
+  "spdxVersion": "SPDX-2.3",
+  "packages": [
+    {"name": "zlib", "versionInfo": "1.3.1", "supplier": "Organization: zlib project (info@zlib.example)",
+     "checksums": [{"algorithm": "SHA256", "checksumValue": "CAFE"}],
+     "externalRefs": [
+       {"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:generic/zlib@1.3.1"},
+       {"referenceCategory": "SECURITY", "referenceType": "cpe23Type", "referenceLocator": "cpe:2.3:a:zlib:zlib:1.3.1:*:*:*:*:*:*:*"}
+     ]}
+  ]
+}`)
+		docs, err := ingress.DecodeSPDXDocuments(data, ingress.SBOMOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(3))
+		Expect(docs[0].Kind.ResourceType()).To(Equal(events.OrgUnitResource))
+		Expect(docs[0].Spec).To(HaveKeyWithValue("displayName", "zlib project"))
+		Expect(docs[1].Spec).To(HaveKeyWithValue("hash", "sha256:cafe"))
+		Expect(docs[1].Spec["annotations"]).To(HaveKeyWithValue(ingress.SBOMCPEKey, "cpe:2.3:a:zlib:zlib:1.3.1:*:*:*:*:*:*:*"))
+		Expect(docs[2].Spec).To(HaveKeyWithValue("productId", ingress.SBOMID("product:pkg:generic/zlib").String()))
+	})
+
+	It("rejects files of the other SBOM format", func() {
+		_, err := ingress.DecodeSPDXDocuments(cyclonedx, ingress.SBOMOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not an SPDX")))
+		_, err = ingress.DecodeCycloneDXDocuments([]byte(`{"spdxVersion": "SPDX-2.3"}`), ingress.SBOMOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not a CycloneDX")))
+	})
+})
+
+var _ = Describe("Name references", func() {
+	var m model.Model
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("resolves names, paths and aliases and generates stable ids", func() {
+		data := []byte(`---
+aliases:
+  pay: payments
+---
+version: emeland.io/v1
+kind: Context
+spec:
+  displayName: prod
+---
+version: emeland.io/v1
+kind: Context
+spec:
+  displayName: eu
+  parent: prod
+---
+version: emeland.io/v1
+kind: SystemInstance
+spec:
+  displayName: payments-eu
+  system: pay
+  context: prod/eu
+---
+version: emeland.io/v1
+kind: System
+spec:
+  displayName: payments
+`)
+		docs, err := ingress.Parse("landscape.yaml", data, ingress.ParseOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docs).To(HaveLen(4))
+		res := ingress.ApplyAll(docs, m)
+		Expect(res.Failed).To(BeEmpty())
+		Expect(res.Applied).To(Equal(4))
+
+		prodID := ingress.GeneratedID(events.ContextResource, "prod")
+		euID := ingress.GeneratedID(events.ContextResource, "eu", prodID)
+		sysID := ingress.GeneratedID(events.SystemResource, "payments")
+		instID := ingress.GeneratedID(events.SystemInstanceResource, "payments-eu", sysID, euID)
+		Expect(m.GetContextById(euID).GetParentId()).To(Equal(prodID))
+		inst := m.GetSystemInstanceById(instID)
+		Expect(inst).NotTo(BeNil())
+		Expect(inst.GetSystemRef().SystemId).To(Equal(sysID))
+		Expect(inst.GetContextRef().ContextId).To(Equal(euID))
+		Expect(docs[1].Spec).NotTo(HaveKey("contextId"), "the caller's documents are left alone")
+
+		again := ingress.ApplyAll(docs, m)
+		Expect(again.Resources).To(Equal(res.Resources))
+		systems, err := m.GetSystems()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(systems).To(HaveLen(1))
+	})
+
+	It("resolves names against the model and reports ambiguous and unknown ones", func() {
+		for _, name := range []string{"billing", "dup", "dup"} {
+			s := system.NewSystem(uuid.New())
+			s.SetDisplayName(name)
+			Expect(m.AddSystem(s)).To(Succeed())
+		}
+		api := func(systemName string) ingress.Document {
+			return ingress.Document{
+				Version: "emeland.io/v1",
+				Kind:    ingress.DocumentKind(events.APIResource),
+				Spec:    map[string]any{"displayName": "api-" + systemName, "system": map[string]any{"name": systemName}},
+			}
+		}
+
+		res := ingress.ApplyAll([]ingress.Document{api("billing"), api("dup"), api("nowhere")}, m)
+		Expect(res.Applied).To(Equal(1))
+		Expect(res.Failed).To(HaveLen(2))
+		Expect(res.Failed[0].Index).To(Equal(1))
+		Expect(errors.Is(res.Failed[0], ingress.ErrAmbiguousName)).To(BeTrue())
+		Expect(res.Failed[1].Index).To(Equal(2))
+		Expect(errors.Is(res.Failed[1], ingress.ErrNameNotFound)).To(BeTrue())
+	})
+})
@@ -26,9 +26,15 @@ func (k *DocumentKind) UnmarshalJSON(data []byte) error {
 
 // jsonDocument is the on-wire shape for JSON landscape documents.
 type jsonDocument struct {
-	Version string         `json:"version"`
-	Kind    string         `json:"kind"`
-	Spec    map[string]any `json:"spec"`
+	Version string            `json:"version"`
+	Kind    string            `json:"kind"`
+	Spec    map[string]any    `json:"spec"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+// aliasOnly reports whether r holds nothing but aliases for the file.
+func (r jsonDocument) aliasOnly() bool {
+	return len(r.Aliases) > 0 && strings.TrimSpace(r.Version) == "" && strings.TrimSpace(r.Kind) == "" && r.Spec == nil
 }
 
 // DecodeJSONDocuments decodes one JSON object, an array of objects, or JSONL into [Document] values.
@@ -67,6 +73,7 @@ func DecodeJSONDocuments(data []byte) ([]Document, error) {
 func decodeJSONL(data []byte) ([]Document, error) {
 	dec := json.NewDecoder(bytes.NewReader(data))
 	var docs []Document
+	aliases := map[string]string{}
 	for i := 0; ; i++ {
 		var r jsonDocument
 		err := dec.Decode(&r)
@@ -76,6 +83,12 @@ func decodeJSONL(data []byte) ([]Document, error) {
 			}
 			return nil, err
 		}
+		if r.aliasOnly() {
+			for k, v := range r.Aliases {
+				aliases[k] = v
+			}
+			continue
+		}
 		doc, err := jsonDocToDocument(r, i)
 		if err != nil {
 			return nil, err
@@ -85,12 +98,19 @@ func decodeJSONL(data []byte) ([]Document, error) {
 	if len(docs) == 0 {
 		return nil, fmt.Errorf("no JSON documents found")
 	}
-	return docs, nil
+	return withFileAliases(docs, aliases), nil
 }
 
 func jsonDocsToDocuments(raw []jsonDocument) ([]Document, error) {
 	docs := make([]Document, 0, len(raw))
+	aliases := map[string]string{}
 	for i, r := range raw {
+		if r.aliasOnly() {
+			for k, v := range r.Aliases {
+				aliases[k] = v
+			}
+			continue
+		}
 		doc, err := jsonDocToDocument(r, i)
 		if err != nil {
 			return nil, err
@@ -100,7 +120,7 @@ func jsonDocsToDocuments(raw []jsonDocument) ([]Document, error) {
 	if len(docs) == 0 {
 		return nil, fmt.Errorf("no JSON documents found")
 	}
-	return docs, nil
+	return withFileAliases(docs, aliases), nil
 }
 
 func jsonDocToDocument(r jsonDocument, index int) (Document, error) {
@@ -113,10 +133,10 @@ func jsonDocToDocument(r jsonDocument, index int) (Document, error) {
 	}
 	rt := events.ParseResourceType(kindStr)
 	if rt == events.UnknownResourceType {
-		return Document{}, fmt.Errorf("document %d: unsupported kind %q", index, kindStr)
+		return Document{}, fmt.Errorf("document %d: %w %q", index, ErrUnsupportedKind, kindStr)
 	}
 	if _, ok := documentKinds[rt]; !ok {
-		return Document{}, fmt.Errorf("document %d: unsupported kind %q", index, kindStr)
+		return Document{}, fmt.Errorf("document %d: %w %q", index, ErrUnsupportedKind, kindStr)
 	}
 	if r.Spec == nil {
 		return Document{}, fmt.Errorf("document %d: missing spec", index)
@@ -125,5 +145,6 @@ func jsonDocToDocument(r jsonDocument, index int) (Document, error) {
 		Version: r.Version,
 		Kind:    DocumentKind(rt),
 		Spec:    r.Spec,
+		Aliases: r.Aliases,
 	}, nil
 }
@@ -0,0 +1,364 @@
+package ingress
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults of [KubernetesOptions].
+const (
+	DefaultKubernetesComponentLabel = "app.kubernetes.io/name"
+	DefaultKubernetesAPILabel       = "emeland.io/api"
+	DefaultKubernetesServiceDomain  = "svc.cluster.local"
+)
+
+// Annotations recording the Kubernetes object a resource was imported from.
+const (
+	KubernetesClusterKey   = "emeland.io/kubernetes.cluster"
+	KubernetesKindKey      = "emeland.io/kubernetes.kind"
+	KubernetesNamespaceKey = "emeland.io/kubernetes.namespace"
+	KubernetesNameKey      = "emeland.io/kubernetes.name"
+)
+
+// Endpoint annotations of ApiInstances (see docs/endpoint-annotations.md).
+const (
+	endpointProtocolKey = "emeland.io/endpoint.protocol"
+	endpointHostKey     = "emeland.io/endpoint.host"
+	endpointPortKey     = "emeland.io/endpoint.port"
+	endpointPathKey     = "emeland.io/endpoint.path"
+)
+
+// KubernetesOptions are the mapping rules of [DecodeKubernetesDocuments].
+type KubernetesOptions struct {
+	// Cluster names the cluster the manifests were exported from. It scopes
+	// the ids, so equal names in different clusters differ, and when set
+	// becomes a Context that is the parent of the namespace Contexts.
+	Cluster string `yaml:"cluster"`
+	// ComponentLabel is the label (or annotation) of a workload naming the
+	// Component it instantiates; default [DefaultKubernetesComponentLabel].
+	ComponentLabel string `yaml:"componentLabel"`
+	// APILabel is the label (or annotation) of a Service or Ingress naming the
+	// API it serves; default [DefaultKubernetesAPILabel]. An Ingress without
+	// it serves the API of its backend Service.
+	APILabel string `yaml:"apiLabel"`
+	// NodeType names the NodeType of the imported Nodes; none when empty.
+	NodeType string `yaml:"nodeType"`
+	// ServiceDomain is the DNS suffix of Service hosts
+	// (<service>.<namespace>.<domain>); default [DefaultKubernetesServiceDomain].
+	ServiceDomain string `yaml:"serviceDomain"`
+}
+
+func (o KubernetesOptions) withDefaults() KubernetesOptions {
+	if o.ComponentLabel == "" {
+		o.ComponentLabel = DefaultKubernetesComponentLabel
+	}
+	if o.APILabel == "" {
+		o.APILabel = DefaultKubernetesAPILabel
+	}
+	if o.ServiceDomain == "" {
+		o.ServiceDomain = DefaultKubernetesServiceDomain
+	}
+	return o
+}
+
+// KubernetesID returns the id of the resource imported from the Kubernetes
+// object kind/namespace/name of cluster; namespace is empty for cluster-scoped
+// objects. Re-imports of the same object update the same resource.
+func KubernetesID(cluster, kind, namespace, name string) uuid.UUID {
+	return uuid.NewSHA1(NameNamespace, []byte("kubernetes:"+cluster+"/"+kind+"/"+namespace+"/"+name))
+}
+
+// k8sObject is a Kubernetes object, or a List of them (kubectl get -o yaml).
+type k8sObject struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Labels      map[string]string `yaml:"labels"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec   map[string]any `yaml:"spec"`
+	Status map[string]any `yaml:"status"`
+	Items  []k8sObject    `yaml:"items"`
+}
+
+// label returns the label key of o, else its annotation key.
+func (o *k8sObject) label(key string) string {
+	if v := strings.TrimSpace(o.Metadata.Labels[key]); v != "" {
+		return v
+	}
+	return strings.TrimSpace(o.Metadata.Annotations[key])
+}
+
+// k8sWorkloads are the kinds that become ComponentInstances.
+var k8sWorkloads = map[string]bool{"Deployment": true, "StatefulSet": true, "DaemonSet": true}
+
+// DecodeKubernetesDocuments decodes exported Kubernetes manifests (a YAML
+// stream of objects or Lists of them) into landscape [Document] values:
+//
+//   - a Namespace becomes a Context, child of the cluster's Context when opts.Cluster is set;
+//   - a Node becomes a Node;
+//   - a Deployment, StatefulSet or DaemonSet becomes a ComponentInstance of the Component its
+//     ComponentLabel names;
+//   - a Service and each host and path of an Ingress become an ApiInstance of the API its
+//     APILabel names, with emeland.io/endpoint.* annotations.
+//
+// Component and API names are name references (see [ResolveNames]). Workloads
+// and endpoints without them, and other kinds, are skipped. Ids are
+// [KubernetesID]s, so re-imports are idempotent.
+func DecodeKubernetesDocuments(data []byte, opts KubernetesOptions) ([]Document, error) {
+	opts = opts.withDefaults()
+	objects, err := decodeK8sObjects(data)
+	if err != nil {
+		return nil, err
+	}
+
+	services := map[string]*k8sObject{}
+	for i := range objects {
+		if o := &objects[i]; o.Kind == "Service" {
+			services[o.Metadata.Namespace+"/"+o.Metadata.Name] = o
+		}
+	}
+
+	var contexts, nodes, instances, endpoints []Document
+	if opts.Cluster != "" {
+		contexts = append(contexts, kubernetesDocument(events.ContextResource, map[string]any{
+			"contextId":   KubernetesID(opts.Cluster, "Cluster", "", opts.Cluster).String(),
+			"displayName": opts.Cluster,
+			"annotations": map[string]any{KubernetesClusterKey: opts.Cluster},
+		}))
+	}
+	for i := range objects {
+		o := &objects[i]
+		id := KubernetesID(opts.Cluster, o.Kind, o.Metadata.Namespace, o.Metadata.Name)
+		switch {
+		case o.Kind == "Namespace":
+			spec := map[string]any{"contextId": id.String(), "displayName": o.Metadata.Name, "annotations": k8sAnnotations(o, opts)}
+			if opts.Cluster != "" {
+				spec["parent"] = KubernetesID(opts.Cluster, "Cluster", "", opts.Cluster).String()
+			}
+			contexts = append(contexts, kubernetesDocument(events.ContextResource, spec))
+		case o.Kind == "Node":
+			spec := map[string]any{"nodeId": id.String(), "displayName": o.Metadata.Name, "annotations": k8sAnnotations(o, opts)}
+			if opts.NodeType != "" {
+				spec["nodeType"] = opts.NodeType
+			}
+			nodes = append(nodes, kubernetesDocument(events.NodeResource, spec))
+		case k8sWorkloads[o.Kind]:
+			component := o.label(opts.ComponentLabel)
+			if component == "" {
+				continue
+			}
+			instances = append(instances, kubernetesDocument(events.ComponentInstanceResource, map[string]any{
+				"instanceId":  id.String(),
+				"displayName": o.Metadata.Name,
+				"component":   component,
+				"annotations": k8sAnnotations(o, opts),
+			}))
+		case o.Kind == "Service":
+			api := o.label(opts.APILabel)
+			if api == "" {
+				continue
+			}
+			ann := k8sAnnotations(o, opts)
+			for k, v := range serviceEndpoint(o, opts) {
+				ann[k] = v
+			}
+			endpoints = append(endpoints, kubernetesDocument(events.APIInstanceResource, map[string]any{
+				"instanceId":  id.String(),
+				"displayName": o.Metadata.Name,
+				"api":         api,
+				"annotations": ann,
+			}))
+		case o.Kind == "Ingress":
+			endpoints = append(endpoints, ingressEndpoints(o, services, opts)...)
+		}
+	}
+
+	docs := append(append(append(contexts, nodes...), instances...), endpoints...)
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("no Kubernetes objects of an imported kind found")
+	}
+	return docs, nil
+}
+
+func kubernetesDocument(rt events.ResourceType, spec map[string]any) Document {
+	return Document{Version: DefaultCSVVersion, Kind: DocumentKind(rt), Spec: spec}
+}
+
+func k8sAnnotations(o *k8sObject, opts KubernetesOptions) map[string]any {
+	ann := map[string]any{
+		KubernetesKindKey: o.Kind,
+		KubernetesNameKey: o.Metadata.Name,
+	}
+	if opts.Cluster != "" {
+		ann[KubernetesClusterKey] = opts.Cluster
+	}
+	if o.Metadata.Namespace != "" {
+		ann[KubernetesNamespaceKey] = o.Metadata.Namespace
+	}
+	return ann
+}
+
+// serviceEndpoint returns the endpoint annotations of a Service: its load
+// balancer address if it has one, else its cluster DNS name, and its first
+// port. The protocol is https when the port's appProtocol or name says so or
+// the port is 443.
+func serviceEndpoint(o *k8sObject, opts KubernetesOptions) map[string]any {
+	host := o.Metadata.Name + "." + o.Metadata.Namespace + "." + opts.ServiceDomain
+	if lb, ok := nestedMap(o.Status, "loadBalancer"); ok {
+		if ingress, ok := lb["ingress"].([]any); ok && len(ingress) > 0 {
+			if first, ok := ingress[0].(map[string]any); ok {
+				if h := stringValue(first, "hostname"); h != "" {
+					host = h
+				} else if ip := stringValue(first, "ip"); ip != "" {
+					host = ip
+				}
+			}
+		}
+	}
+	out := map[string]any{endpointProtocolKey: "http", endpointHostKey: host}
+	if ports, ok := o.Spec["ports"].([]any); ok && len(ports) > 0 {
+		if p, ok := ports[0].(map[string]any); ok {
+			port := fmt.Sprint(p["port"])
+			out[endpointPortKey] = port
+			hint := strings.ToLower(stringValue(p, "appProtocol") + " " + stringValue(p, "name"))
+			if strings.Contains(hint, "https") || port == "443" {
+				out[endpointProtocolKey] = "https"
+			}
+		}
+	}
+	return out
+}
+
+// ingressEndpoints returns an ApiInstance per host and path of an Ingress.
+// The API is the Ingress's APILabel, else that of the path's backend Service.
+func ingressEndpoints(o *k8sObject, services map[string]*k8sObject, opts KubernetesOptions) []Document {
+	tls := map[string]bool{}
+	if entries, ok := o.Spec["tls"].([]any); ok {
+		for _, e := range entries {
+			if m, ok := e.(map[string]any); ok {
+				if hosts, ok := m["hosts"].([]any); ok {
+					for _, h := range hosts {
+						tls[fmt.Sprint(h)] = true
+					}
+				}
+			}
+		}
+	}
+	rules, _ := o.Spec["rules"].([]any)
+	var out []Document
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		host := stringValue(rule, "host")
+		if host == "" {
+			continue
+		}
+		httpRule, _ := nestedMap(rule, "http")
+		paths, _ := httpRule["paths"].([]any)
+		for _, p := range paths {
+			path, ok := p.(map[string]any)
+			if !ok {
+				continue
+			}
+			api := o.label(opts.APILabel)
+			if api == "" {
+				if svc, ok := nestedMap(path, "backend", "service"); ok {
+					if s := services[o.Metadata.Namespace+"/"+stringValue(svc, "name")]; s != nil {
+						api = s.label(opts.APILabel)
+					}
+				}
+			}
+			if api == "" {
+				continue
+			}
+			urlPath := stringValue(path, "path")
+			if urlPath == "" {
+				urlPath = "/"
+			}
+			ann := k8sAnnotations(o, opts)
+			ann[endpointHostKey] = host
+			ann[endpointPathKey] = urlPath
+			if tls[host] {
+				ann[endpointProtocolKey] = "https"
+				ann[endpointPortKey] = "443"
+			} else {
+				ann[endpointProtocolKey] = "http"
+				ann[endpointPortKey] = "80"
+			}
+			out = append(out, kubernetesDocument(events.APIInstanceResource, map[string]any{
+				"instanceId":  KubernetesID(opts.Cluster, o.Kind, o.Metadata.Namespace, o.Metadata.Name+"/"+host+urlPath).String(),
+				"displayName": o.Metadata.Name + " " + host + urlPath,
+				"api":         api,
+				"annotations": ann,
+			}))
+		}
+	}
+	return out
+}
+
+// decodeK8sObjects reads the objects of a YAML (or JSON) stream, flattening
+// Lists.
+func decodeK8sObjects(data []byte) ([]k8sObject, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var objects []k8sObject
+	var flatten func(o k8sObject)
+	flatten = func(o k8sObject) {
+		if strings.HasSuffix(o.Kind, "List") {
+			for _, item := range o.Items {
+				flatten(item)
+			}
+			return
+		}
+		objects = append(objects, o)
+	}
+	for i := 0; ; i++ {
+		var o k8sObject
+		if err := dec.Decode(&o); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		if o.Kind == "" && o.APIVersion == "" {
+			continue // empty document
+		}
+		if o.Kind == "" || o.APIVersion == "" {
+			return nil, fmt.Errorf("document %d: not a Kubernetes object (needs apiVersion and kind)", i)
+		}
+		flatten(o)
+	}
+	for _, o := range objects {
+		if strings.TrimSpace(o.Metadata.Name) == "" {
+			return nil, fmt.Errorf("%s without metadata.name", o.Kind)
+		}
+	}
+	return objects, nil
+}
+
+func nestedMap(m map[string]any, keys ...string) (map[string]any, bool) {
+	for _, k := range keys {
+		next, ok := m[k].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		m = next
+	}
+	return m, true
+}
+
+func stringValue(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return strings.TrimSpace(s)
+}
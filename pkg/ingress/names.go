@@ -0,0 +1,750 @@
+package ingress
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+)
+
+// Documents may refer to other resources by name instead of UUID. A reference
+// field holding a string that is not a UUID, or an object {name: ...}, names
+// a resource of the field's kind: an alias of the file (see
+// [Document.Aliases]), a document of the same file, or a resource of the
+// model with that display name. A Context may also be named by its path, the
+// names from an ancestor down joined with "/", e.g. "prod/eu".
+//
+// A document without its primary id gets one derived from its kind, name and
+// parent (see [GeneratedID]), so applying the same file again updates the
+// same resources.
+
+var (
+	// ErrNameNotFound is wrapped by the errors of name references no
+	// document or model resource matches.
+	ErrNameNotFound = errors.New("no resource with name")
+	// ErrAmbiguousName is wrapped by the errors of name references more than
+	// one document or model resource matches.
+	ErrAmbiguousName = errors.New("ambiguous name")
+)
+
+// NameNamespace is the namespace of the UUID v5 ids [GeneratedID] derives.
+var NameNamespace = uuid.MustParse("8f5b7c1e-52a4-4d0e-9a57-3c6f0e2d9b41")
+
+// GeneratedID returns the id of a document of kind rt without its primary id:
+// a UUID v5 of the kind, the ids of the resources it belongs to (see
+// scopeFields: the parent Context or System, the System of an API or
+// Component, the type and the place of an instance) and its name. Scopes
+// that are uuid.Nil, i.e. not set by the document, are left out.
+func GeneratedID(rt events.ResourceType, name string, scopes ...uuid.UUID) uuid.UUID {
+	seed := rt.WireKind() + "/"
+	for _, scope := range scopes {
+		if scope != uuid.Nil {
+			seed += scope.String() + "/"
+		}
+	}
+	return uuid.NewSHA1(NameNamespace, []byte(seed+name))
+}
+
+// refShape is how a reference field is laid out in a spec.
+type refShape int
+
+const (
+	// refScalar: spec[key] is a UUID, a name or {name: ...}.
+	refScalar refShape = iota
+	// refList: spec[key] is an array of scalars, or of objects holding idKey.
+	refList
+	// refNested: spec[key] is an object holding idKey, or {name: ...}.
+	refNested
+	// refResources: spec[key] is an array of {resourceType, resourceId} or
+	// {resourceType, name}; the target is per item.
+	refResources
+)
+
+// refField describes one reference field of a kind.
+type refField struct {
+	shape  refShape
+	keys   []string // alternative spec keys; the first one set is used
+	idKey  string   // refList / refNested: key of the id within an object
+	target events.ResourceType
+	// byName lets a refNested object be {name: ...} in place of idKey.
+	byName bool
+}
+
+// referenceFields lists the fields of each kind that may name a resource.
+var referenceFields = map[events.ResourceType][]refField{
+	events.ContextResource: {
+		{keys: []string{"parent"}, target: events.ContextResource},
+		{keys: []string{"type"}, target: events.ContextTypeResource},
+	},
+	events.SystemResource: {
+		{keys: []string{"parent"}, target: events.SystemResource},
+	},
+	events.APIResource: {
+		{keys: []string{"system", "systemId"}, target: events.SystemResource},
+	},
+	events.ComponentResource: {
+		{keys: []string{"system", "systemId"}, target: events.SystemResource},
+		{shape: refList, keys: []string{"consumes"}, idKey: "apiId", target: events.APIResource},
+		{shape: refList, keys: []string{"provides"}, idKey: "apiId", target: events.APIResource},
+	},
+	events.SystemInstanceResource: {
+		{keys: []string{"system", "systemId"}, target: events.SystemResource},
+		{keys: []string{"context", "contextId"}, target: events.ContextResource},
+	},
+	events.APIInstanceResource: {
+		{keys: []string{"api", "apiId"}, target: events.APIResource},
+		{keys: []string{"systemInstance", "systemInstanceId"}, target: events.SystemInstanceResource},
+	},
+	events.ComponentInstanceResource: {
+		{keys: []string{"component", "componentId"}, target: events.ComponentResource},
+		{keys: []string{"systemInstance", "systemInstanceId"}, target: events.SystemInstanceResource},
+	},
+	events.NodeResource: {
+		{keys: []string{"nodeTypeId", "nodeType"}, target: events.NodeTypeResource},
+	},
+	events.ProductResource: {
+		{keys: []string{"vendor"}, target: events.OrgUnitResource},
+	},
+	events.GroupResource: {
+		{shape: refList, keys: []string{"members"}, target: events.IdentityResource},
+		{keys: []string{"orgUnit"}, target: events.OrgUnitResource},
+	},
+	events.IdentityResource: {
+		{keys: []string{"orgUnit"}, target: events.OrgUnitResource},
+	},
+	events.RoleSpecResource: {
+		{shape: refList, keys: []string{"permissions"}, target: events.PermissionSpecResource},
+	},
+	events.PermissionResource: {
+		{keys: []string{"spec"}, target: events.PermissionSpecResource},
+	},
+	events.RoleResource: {
+		{keys: []string{"spec", "roleSpecId"}, target: events.RoleSpecResource},
+		{keys: []string{"context", "contextId"}, target: events.ContextResource},
+		{shape: refList, keys: []string{"permissions"}, target: events.PermissionResource},
+		{shape: refResources, keys: []string{"resources"}},
+	},
+	events.BindingResource: {
+		{keys: []string{"role", "roleId"}, target: events.RoleResource},
+		{shape: refNested, keys: []string{"subject"}, idKey: "groupId", target: events.GroupResource},
+		{shape: refNested, keys: []string{"subject"}, idKey: "identityId", target: events.IdentityResource},
+	},
+	events.FindingResource: {
+		{shape: refResources, keys: []string{"resources"}},
+	},
+	events.ArtifactInstanceResource: {
+		{keys: []string{"artifact"}, target: events.ArtifactResource},
+		{keys: []string{"componentInstance"}, target: events.ComponentInstanceResource},
+	},
+	events.CapacityResource: {
+		{shape: refNested, keys: []string{"resourceTypeRef"}, idKey: "capacityResourceTypeId", target: events.CapacityResourceTypeResource, byName: true},
+		{shape: refNested, keys: []string{"contextRef"}, idKey: "contextId", target: events.ContextResource, byName: true},
+	},
+}
+
+// scopeFields names, per kind, the reference fields whose targets are part of
+// a generated id, so equally named resources of different parents differ. An
+// instance is scoped by what it instantiates and where it runs: two equally
+// named instances of one System in different Contexts are different
+// resources.
+var scopeFields = map[events.ResourceType][]refField{
+	events.ContextResource:           referenceFields[events.ContextResource][:1],
+	events.SystemResource:            referenceFields[events.SystemResource][:1],
+	events.APIResource:               referenceFields[events.APIResource][:1],
+	events.ComponentResource:         referenceFields[events.ComponentResource][:1],
+	events.SystemInstanceResource:    referenceFields[events.SystemInstanceResource][:2],
+	events.APIInstanceResource:       referenceFields[events.APIInstanceResource][:2],
+	events.ComponentInstanceResource: referenceFields[events.ComponentInstanceResource][:2],
+	events.ArtifactInstanceResource:  referenceFields[events.ArtifactInstanceResource][:2],
+}
+
+// idFields lists the spec keys that may hold a kind's own id; the primary id
+// field comes first.
+func idFields(rt events.ResourceType) []string {
+	switch rt {
+	case events.SystemInstanceResource:
+		return []string{"instanceId", "systemInstanceId"}
+	case events.APIInstanceResource:
+		return []string{"instanceId", "apiInstanceId"}
+	case events.ComponentInstanceResource:
+		return []string{"instanceId", "componentInstanceId"}
+	}
+	if field, ok := primaryIDField[rt]; ok {
+		return []string{field}
+	}
+	return nil
+}
+
+// ResolveNames returns docs with every name reference replaced by the UUID
+// it resolves to and every missing primary id generated. Names resolve
+// against the aliases and documents of docs first, then against m. The
+// caller's documents are left alone. Documents whose references do not
+// resolve are returned unchanged and reported in the DocumentError slice.
+func ResolveNames(docs []Document, m model.Model) ([]Document, []DocumentError) {
+	r := newNameResolver(docs, m)
+	out := make([]Document, len(docs))
+	var failed []DocumentError
+	for i, doc := range docs {
+		spec, err := r.resolveDocument(i)
+		if err != nil {
+			failed = append(failed, DocumentError{Index: i, Err: err})
+			out[i] = doc
+			continue
+		}
+		doc.Spec = spec
+		doc.Aliases = nil
+		out[i] = doc
+	}
+	return out, failed
+}
+
+// resolveState tracks lazy resolution of a document's scope and id.
+type resolveState int
+
+const (
+	unresolved resolveState = iota
+	resolving
+	resolved
+)
+
+// nameEntry is a document as a candidate for name references.
+type nameEntry struct {
+	rt   events.ResourceType
+	name string // display name, empty when the document has none
+
+	scopeState resolveState
+	scopes     []uuid.UUID // one per scopeFields entry, uuid.Nil when unset
+	scopeErr   error
+
+	idState resolveState
+	id      uuid.UUID
+	idErr   error
+}
+
+// modelEntry is a model resource as a candidate for name references.
+type modelEntry struct {
+	id     uuid.UUID
+	name   string
+	parent uuid.UUID // Contexts only
+}
+
+type nameResolver struct {
+	m       model.Model
+	docs    []Document
+	entries []*nameEntry
+	aliases map[string]string
+	// aliasErrs holds, per document, an alias conflicting with an earlier
+	// document's.
+	aliasErrs map[int]error
+	// explicit holds, per kind, the ids documents set themselves; model
+	// resources with those ids are superseded by the documents.
+	explicit map[events.ResourceType]map[uuid.UUID]bool
+	models   map[events.ResourceType][]modelEntry
+}
+
+func newNameResolver(docs []Document, m model.Model) *nameResolver {
+	r := &nameResolver{
+		m:         m,
+		docs:      docs,
+		entries:   make([]*nameEntry, len(docs)),
+		aliases:   map[string]string{},
+		aliasErrs: map[int]error{},
+		explicit:  map[events.ResourceType]map[uuid.UUID]bool{},
+		models:    map[events.ResourceType][]modelEntry{},
+	}
+	for i, doc := range docs {
+		rt := doc.Kind.ResourceType()
+		e := &nameEntry{rt: rt}
+		if doc.Spec != nil {
+			e.name, _ = displayName(doc.Spec)
+			if id, ok, err := explicitID(doc.Spec, rt); err == nil && ok {
+				if r.explicit[rt] == nil {
+					r.explicit[rt] = map[uuid.UUID]bool{}
+				}
+				r.explicit[rt][id] = true
+			}
+		}
+		r.entries[i] = e
+
+		keys := make([]string, 0, len(doc.Aliases))
+		for k := range doc.Aliases {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := strings.TrimSpace(doc.Aliases[k])
+			if cur, ok := r.aliases[k]; ok && cur != v {
+				if _, seen := r.aliasErrs[i]; !seen {
+					r.aliasErrs[i] = fmt.Errorf("alias %q redefined as %q, was %q", k, v, cur)
+				}
+				continue
+			}
+			r.aliases[k] = v
+		}
+	}
+	return r
+}
+
+// explicitID returns the id spec sets for itself; ok is false when it sets
+// none.
+func explicitID(spec map[string]any, rt events.ResourceType) (uuid.UUID, bool, error) {
+	for _, key := range idFields(rt) {
+		if s, ok := stringField(spec, key); ok && strings.TrimSpace(s) != "" {
+			id, err := parseUUIDField(spec, key)
+			return id, true, err
+		}
+	}
+	return uuid.Nil, false, nil
+}
+
+// resolveDocument returns a copy of document i's spec with its id set and its
+// references resolved.
+func (r *nameResolver) resolveDocument(i int) (map[string]any, error) {
+	if err := r.aliasErrs[i]; err != nil {
+		return nil, err
+	}
+	doc := r.docs[i]
+	if doc.Spec == nil {
+		return nil, nil
+	}
+	rt := doc.Kind.ResourceType()
+	spec := cloneValue(doc.Spec).(map[string]any)
+
+	if _, ok, _ := explicitID(spec, rt); !ok && len(idFields(rt)) > 0 && r.entries[i].name != "" {
+		id, err := r.documentID(i)
+		if err != nil {
+			return nil, err
+		}
+		spec[idFields(rt)[0]] = id.String()
+	}
+
+	for _, f := range referenceFields[rt] {
+		if err := r.rewrite(spec, f); err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
+// documentScopes resolves the scope fields of document i, one id per
+// scopeFields entry of its kind, uuid.Nil for a field the document does not
+// set.
+func (r *nameResolver) documentScopes(i int) ([]uuid.UUID, error) {
+	e := r.entries[i]
+	switch e.scopeState {
+	case resolved:
+		return e.scopes, e.scopeErr
+	case resolving:
+		return nil, fmt.Errorf("document %d: reference cycle", i)
+	}
+	e.scopeState = resolving
+	fields := scopeFields[e.rt]
+	e.scopes = make([]uuid.UUID, len(fields))
+	for j, f := range fields {
+		if r.docs[i].Spec == nil {
+			break
+		}
+		key, v, ok := firstSet(r.docs[i].Spec, f.keys)
+		if !ok {
+			continue
+		}
+		if e.scopes[j], e.scopeErr = r.resolveRef(v, f.target); e.scopeErr != nil {
+			e.scopeErr = fmt.Errorf("%s: %w", key, e.scopeErr)
+			break
+		}
+	}
+	e.scopeState = resolved
+	return e.scopes, e.scopeErr
+}
+
+// documentParent returns the parent of Context document i, uuid.Nil when it
+// sets none.
+func (r *nameResolver) documentParent(i int) (uuid.UUID, error) {
+	scopes, err := r.documentScopes(i)
+	if err != nil || len(scopes) == 0 {
+		return uuid.Nil, err
+	}
+	return scopes[0], nil
+}
+
+// documentID returns the id of document i: the one it sets, else a generated
+// one.
+func (r *nameResolver) documentID(i int) (uuid.UUID, error) {
+	e := r.entries[i]
+	switch e.idState {
+	case resolved:
+		return e.id, e.idErr
+	case resolving:
+		return uuid.Nil, fmt.Errorf("document %d: reference cycle", i)
+	}
+	e.idState = resolving
+	if id, ok, err := explicitID(r.docs[i].Spec, e.rt); ok {
+		e.id, e.idErr = id, err
+	} else if e.name == "" {
+		e.idErr = fmt.Errorf("document %d: no id and no name to generate one from", i)
+	} else if scopes, err := r.documentScopes(i); err != nil {
+		e.idErr = err
+	} else {
+		e.id = GeneratedID(e.rt, e.name, scopes...)
+	}
+	e.idState = resolved
+	return e.id, e.idErr
+}
+
+// rewrite replaces the name references of field f in spec by UUID strings.
+func (r *nameResolver) rewrite(spec map[string]any, f refField) error {
+	key, v, ok := firstSet(spec, f.keys)
+	if !ok {
+		return nil
+	}
+	switch f.shape {
+	case refScalar:
+		id, err := r.resolveRef(v, f.target)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		spec[key] = id.String()
+	case refList:
+		arr, ok := v.([]any)
+		if !ok {
+			return nil // reported by apply
+		}
+		for i, item := range arr {
+			ref := item
+			obj, isObj := item.(map[string]any)
+			if isObj && f.idKey != "" {
+				if idv, ok := obj[f.idKey]; ok {
+					ref = idv
+				}
+			}
+			id, err := r.resolveRef(ref, f.target)
+			if err != nil {
+				return fmt.Errorf("%s[%d]: %w", key, i, err)
+			}
+			if isObj && f.idKey != "" {
+				delete(obj, "name")
+				obj[f.idKey] = id.String()
+			} else {
+				arr[i] = id.String()
+			}
+		}
+	case refNested:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		ref, ok := obj[f.idKey]
+		if !ok || ref == nil {
+			if _, named := obj["name"]; !named || !f.byName {
+				return nil
+			}
+			ref = map[string]any{"name": obj["name"]}
+		}
+		id, err := r.resolveRef(ref, f.target)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", key, f.idKey, err)
+		}
+		delete(obj, "name")
+		obj[f.idKey] = id.String()
+	case refResources:
+		arr, ok := v.([]any)
+		if !ok {
+			return nil
+		}
+		for i, item := range arr {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			rtStr, ok := stringField(obj, "resourceType")
+			if !ok {
+				continue
+			}
+			rt, err := parseResourceTypeForRef(rtStr)
+			if err != nil {
+				continue
+			}
+			ref, ok := obj["resourceId"]
+			if !ok || ref == nil {
+				if _, named := obj["name"]; !named {
+					continue
+				}
+				ref = map[string]any{"name": obj["name"]}
+			}
+			id, err := r.resolveRef(ref, rt)
+			if err != nil {
+				return fmt.Errorf("%s[%d]: %w", key, i, err)
+			}
+			delete(obj, "name")
+			obj["resourceId"] = id.String()
+		}
+	}
+	return nil
+}
+
+// firstSet returns the first of keys set to a non-empty value in spec.
+func firstSet(spec map[string]any, keys []string) (string, any, bool) {
+	for _, key := range keys {
+		v, ok := spec[key]
+		if !ok || v == nil {
+			continue
+		}
+		if s, ok := v.(string); ok && strings.TrimSpace(s) == "" {
+			continue
+		}
+		return key, v, true
+	}
+	return "", nil, false
+}
+
+// resolveRef resolves a reference value to a resource of kind target: a UUID
+// string, an alias, a name or path, or {name: ...}.
+func (r *nameResolver) resolveRef(v any, target events.ResourceType) (uuid.UUID, error) {
+	var name string
+	switch t := v.(type) {
+	case string:
+		name = strings.TrimSpace(t)
+		if id, err := uuid.Parse(name); err == nil {
+			return id, nil
+		}
+		if alias, ok := r.aliases[name]; ok {
+			name = alias
+			if id, err := uuid.Parse(name); err == nil {
+				return id, nil
+			}
+		}
+	case map[string]any:
+		s, ok := stringField(t, "name")
+		if !ok || strings.TrimSpace(s) == "" {
+			return uuid.Nil, fmt.Errorf("reference object must have a name")
+		}
+		name = strings.TrimSpace(s)
+	default:
+		return uuid.Nil, fmt.Errorf("must be a UUID, a name or an object with a name, not %T", v)
+	}
+	if name == "" {
+		return uuid.Nil, fmt.Errorf("empty reference")
+	}
+	return r.lookup(target, name)
+}
+
+// lookup resolves name to the single document or model resource of kind rt
+// carrying it.
+func (r *nameResolver) lookup(rt events.ResourceType, name string) (uuid.UUID, error) {
+	if rt == events.ContextResource && strings.Contains(name, "/") {
+		return r.lookupPath(name)
+	}
+	ids, err := r.named(rt, name, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return single(rt, name, ids)
+}
+
+// lookupPath resolves a Context path segment by segment: the first segment is
+// any Context of that name, each further one a child of the previous.
+func (r *nameResolver) lookupPath(path string) (uuid.UUID, error) {
+	var cur uuid.UUID
+	for i, seg := range strings.Split(path, "/") {
+		seg = strings.TrimSpace(seg)
+		if seg == "" {
+			return uuid.Nil, fmt.Errorf("context path %q has an empty segment", path)
+		}
+		var parent *uuid.UUID
+		if i > 0 {
+			p := cur
+			parent = &p
+		}
+		ids, err := r.named(events.ContextResource, seg, parent)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		label := strings.Join(strings.Split(path, "/")[:i+1], "/")
+		if cur, err = single(events.ContextResource, label, ids); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	return cur, nil
+}
+
+// named returns the ids of the documents and model resources of kind rt
+// named name, restricted to children of parent when it is set.
+func (r *nameResolver) named(rt events.ResourceType, name string, parent *uuid.UUID) ([]uuid.UUID, error) {
+	seen := map[uuid.UUID]bool{}
+	var ids []uuid.UUID
+	for i, e := range r.entries {
+		if e.rt != rt || e.name != name {
+			continue
+		}
+		if parent != nil {
+			scope, err := r.documentParent(i)
+			if err != nil {
+				return nil, err
+			}
+			if scope != *parent {
+				continue
+			}
+		}
+		id, err := r.documentID(i)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	models, err := r.modelEntries(rt)
+	if err != nil {
+		return nil, err
+	}
+	for _, me := range models {
+		if me.name != name || seen[me.id] || r.explicit[rt][me.id] {
+			continue
+		}
+		if parent != nil && me.parent != *parent {
+			continue
+		}
+		seen[me.id] = true
+		ids = append(ids, me.id)
+	}
+	return ids, nil
+}
+
+func single(rt events.ResourceType, name string, ids []uuid.UUID) (uuid.UUID, error) {
+	switch len(ids) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("%w %s %q", ErrNameNotFound, rt.WireKind(), name)
+	case 1:
+		return ids[0], nil
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	sort.Strings(strs)
+	return uuid.Nil, fmt.Errorf("%w: %s %q matches %s", ErrAmbiguousName, rt.WireKind(), name, strings.Join(strs, ", "))
+}
+
+// namedResource is what every model resource offers for name lookups.
+type namedResource interface {
+	GetResourceId() uuid.UUID
+	GetDisplayName() string
+}
+
+func toEntries[T namedResource](items []T, err error) ([]modelEntry, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make([]modelEntry, 0, len(items))
+	for _, item := range items {
+		out = append(out, modelEntry{id: item.GetResourceId(), name: item.GetDisplayName()})
+	}
+	return out, nil
+}
+
+// modelEntries lists the model's resources of kind rt, cached per resolver.
+func (r *nameResolver) modelEntries(rt events.ResourceType) ([]modelEntry, error) {
+	if entries, ok := r.models[rt]; ok {
+		return entries, nil
+	}
+	if r.m == nil {
+		return nil, nil
+	}
+	var entries []modelEntry
+	var err error
+	switch rt {
+	case events.ContextResource:
+		ctxs, lerr := r.m.GetContexts()
+		if err = lerr; err == nil {
+			for _, c := range ctxs {
+				entries = append(entries, modelEntry{id: c.GetContextId(), name: c.GetDisplayName(), parent: c.GetParentId()})
+			}
+		}
+	case events.ContextTypeResource:
+		entries, err = toEntries(r.m.GetContextTypes())
+	case events.NodeResource:
+		entries, err = toEntries(r.m.GetNodes())
+	case events.NodeTypeResource:
+		entries, err = toEntries(r.m.GetNodeTypes())
+	case events.SystemResource:
+		entries, err = toEntries(r.m.GetSystems())
+	case events.SystemInstanceResource:
+		entries, err = toEntries(r.m.GetSystemInstances())
+	case events.APIResource:
+		entries, err = toEntries(r.m.GetApis())
+	case events.APIInstanceResource:
+		entries, err = toEntries(r.m.GetApiInstances())
+	case events.ComponentResource:
+		entries, err = toEntries(r.m.GetComponents())
+	case events.ComponentInstanceResource:
+		entries, err = toEntries(r.m.GetComponentInstances())
+	case events.OrgUnitResource:
+		entries, err = toEntries(r.m.GetOrgUnits())
+	case events.GroupResource:
+		entries, err = toEntries(r.m.GetGroups())
+	case events.IdentityResource:
+		entries, err = toEntries(r.m.GetIdentities())
+	case events.PermissionSpecResource:
+		entries, err = toEntries(r.m.GetPermissionSpecs())
+	case events.RoleSpecResource:
+		entries, err = toEntries(r.m.GetRoleSpecs())
+	case events.PermissionResource:
+		entries, err = toEntries(r.m.GetPermissions())
+	case events.RoleResource:
+		entries, err = toEntries(r.m.GetRoles())
+	case events.BindingResource:
+		entries, err = toEntries(r.m.GetBindings())
+	case events.ProductResource:
+		entries, err = toEntries(r.m.GetProducts())
+	case events.FindingResource:
+		entries, err = toEntries(r.m.GetFindings())
+	case events.FindingTypeResource:
+		entries, err = toEntries(r.m.GetFindingTypes())
+	case events.ArtifactResource:
+		entries, err = toEntries(r.m.GetArtifacts())
+	case events.ArtifactInstanceResource:
+		entries, err = toEntries(r.m.GetArtifactInstances())
+	case events.FilterRuleResource:
+		entries, err = toEntries(r.m.GetFilterRules())
+	case events.MergeRuleResource:
+		entries, err = toEntries(r.m.GetMergeRules())
+	case events.CapabilityResource:
+		entries, err = toEntries(r.m.GetCapabilities())
+	case events.ParameterResource:
+		entries, err = toEntries(r.m.GetParameters())
+	case events.CapacityResourceTypeResource:
+		entries, err = toEntries(r.m.GetCapacityResourceTypes())
+	case events.CapacityResource:
+		entries, err = toEntries(r.m.GetCapacities())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", rt.WireKind(), err)
+	}
+	r.models[rt] = entries
+	return entries, nil
+}
+
+// cloneValue deep-copies the maps and slices of a decoded spec value.
+func cloneValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, item := range t {
+			out[k] = cloneValue(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, item := range t {
+			out[i] = cloneValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,211 @@
+package ingress_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+// doc builds a landscape document of kind rt.
+func doc(rt events.ResourceType, spec map[string]any) ingress.Document {
+	return ingress.Document{Version: "emeland.io/v1", Kind: ingress.DocumentKind(rt), Spec: spec}
+}
+
+var _ = Describe("ResolveNames", func() {
+	var m model.Model
+
+	BeforeEach(func() {
+		var err error
+		m, err = model.NewModel(events.NewDummySink())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	resolve := func(docs ...ingress.Document) []ingress.Document {
+		out, failed := ingress.ResolveNames(docs, m)
+		Expect(failed).To(BeEmpty())
+		return out
+	}
+
+	Describe("generated ids", func() {
+		It("keeps equally named instances of one System in different Contexts apart", func() {
+			instance := func(ctx string) ingress.Document {
+				return doc(events.SystemInstanceResource, map[string]any{"displayName": "api", "system": "payments", "context": ctx})
+			}
+			docs := []ingress.Document{
+				doc(events.SystemResource, map[string]any{"displayName": "payments"}),
+				doc(events.ContextResource, map[string]any{"displayName": "prod"}),
+				doc(events.ContextResource, map[string]any{"displayName": "staging"}),
+				instance("prod"),
+				instance("staging"),
+			}
+			res := ingress.ApplyAll(docs, m)
+			Expect(res.Failed).To(BeEmpty())
+			instances, err := m.GetSystemInstances()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(HaveLen(2))
+
+			sysID := ingress.GeneratedID(events.SystemResource, "payments")
+			prodID := ingress.GeneratedID(events.ContextResource, "prod")
+			Expect(m.GetSystemInstanceById(ingress.GeneratedID(events.SystemInstanceResource, "api", sysID, prodID))).NotTo(BeNil())
+		})
+
+		It("keeps equally named ApiInstances of one API on different SystemInstances apart", func() {
+			out := resolve(
+				doc(events.APIInstanceResource, map[string]any{"displayName": "rest", "api": "11111111-1111-1111-1111-111111111111", "systemInstance": "22222222-2222-2222-2222-222222222222"}),
+				doc(events.APIInstanceResource, map[string]any{"displayName": "rest", "api": "11111111-1111-1111-1111-111111111111", "systemInstance": "33333333-3333-3333-3333-333333333333"}),
+			)
+			Expect(out[0].Spec["instanceId"]).NotTo(Equal(out[1].Spec["instanceId"]))
+		})
+
+		It("keeps equally named ArtifactInstances of one Artifact on different ComponentInstances apart", func() {
+			out := resolve(
+				doc(events.ArtifactInstanceResource, map[string]any{"displayName": "image", "artifact": "11111111-1111-1111-1111-111111111111", "componentInstance": "22222222-2222-2222-2222-222222222222"}),
+				doc(events.ArtifactInstanceResource, map[string]any{"displayName": "image", "artifact": "11111111-1111-1111-1111-111111111111", "componentInstance": "33333333-3333-3333-3333-333333333333"}),
+			)
+			Expect(out[0].Spec["artifactInstanceId"]).NotTo(Equal(out[1].Spec["artifactInstanceId"]))
+		})
+
+		It("stay stable across re-imports, whether references resolve to documents or the model", func() {
+			file := func() []ingress.Document {
+				return []ingress.Document{
+					doc(events.SystemResource, map[string]any{"displayName": "payments"}),
+					doc(events.ContextResource, map[string]any{"displayName": "prod"}),
+					doc(events.SystemInstanceResource, map[string]any{"displayName": "payments-prod", "system": "payments", "context": "prod"}),
+				}
+			}
+			first := ingress.ApplyAll(file(), m)
+			Expect(first.Failed).To(BeEmpty())
+
+			// The instance alone now resolves its references against the model.
+			again := ingress.ApplyAll(file()[2:], m)
+			Expect(again.Failed).To(BeEmpty())
+			Expect(again.Resources).To(Equal(first.Resources[2:]))
+
+			Expect(ingress.ApplyAll(file(), m).Resources).To(Equal(first.Resources))
+			instances, err := m.GetSystemInstances()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+		})
+
+		It("does not generate an id for a document without a name", func() {
+			out := resolve(doc(events.SystemResource, map[string]any{"description": "anonymous"}))
+			Expect(out[0].Spec).NotTo(HaveKey("systemId"))
+		})
+	})
+
+	Describe("failures", func() {
+		It("reports unresolved names and returns the document unchanged", func() {
+			in := doc(events.APIResource, map[string]any{"displayName": "rest", "system": "nowhere"})
+			out, failed := ingress.ResolveNames([]ingress.Document{in}, m)
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].Index).To(Equal(0))
+			Expect(errors.Is(failed[0], ingress.ErrNameNotFound)).To(BeTrue())
+			Expect(failed[0].Error()).To(ContainSubstring("system"))
+			Expect(out[0].Spec).To(Equal(in.Spec))
+		})
+
+		It("reports names matching several documents or model resources as ambiguous", func() {
+			s := system.NewSystem(uuid.New())
+			s.SetDisplayName("dup")
+			Expect(m.AddSystem(s)).To(Succeed())
+
+			_, failed := ingress.ResolveNames([]ingress.Document{
+				doc(events.SystemResource, map[string]any{"systemId": uuid.NewString(), "displayName": "dup"}),
+				doc(events.APIResource, map[string]any{"displayName": "rest", "system": "dup"}),
+			}, m)
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].Index).To(Equal(1))
+			Expect(errors.Is(failed[0], ingress.ErrAmbiguousName)).To(BeTrue())
+			Expect(failed[0].Error()).To(ContainSubstring(s.GetSystemId().String()))
+		})
+
+		It("lets a document with an explicit id supersede the model resource it updates", func() {
+			s := system.NewSystem(uuid.New())
+			s.SetDisplayName("payments")
+			Expect(m.AddSystem(s)).To(Succeed())
+
+			out := resolve(
+				doc(events.SystemResource, map[string]any{"systemId": s.GetSystemId().String(), "displayName": "payments"}),
+				doc(events.APIResource, map[string]any{"displayName": "rest", "system": "payments"}),
+			)
+			Expect(out[1].Spec["system"]).To(Equal(s.GetSystemId().String()))
+		})
+
+		It("reports unknown Context path segments and reference cycles", func() {
+			_, failed := ingress.ResolveNames([]ingress.Document{
+				doc(events.ContextResource, map[string]any{"displayName": "prod"}),
+				doc(events.SystemInstanceResource, map[string]any{"displayName": "x", "context": "prod/eu"}),
+				doc(events.ContextResource, map[string]any{"displayName": "a", "parent": "b"}),
+				doc(events.ContextResource, map[string]any{"displayName": "b", "parent": "a"}),
+			}, m)
+			Expect(failed).To(HaveLen(3))
+			Expect(failed[0].Index).To(Equal(1))
+			Expect(errors.Is(failed[0], ingress.ErrNameNotFound)).To(BeTrue())
+			Expect(failed[0].Error()).To(ContainSubstring(`"prod/eu"`))
+			Expect(failed[1].Error()).To(ContainSubstring("reference cycle"))
+			Expect(failed[2].Error()).To(ContainSubstring("reference cycle"))
+		})
+
+		It("reports an alias redefined by a later document", func() {
+			first := doc(events.SystemResource, map[string]any{"displayName": "a"})
+			first.Aliases = map[string]string{"pay": "payments"}
+			second := doc(events.SystemResource, map[string]any{"displayName": "b"})
+			second.Aliases = map[string]string{"pay": "billing"}
+			_, failed := ingress.ResolveNames([]ingress.Document{first, second}, m)
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].Index).To(Equal(1))
+			Expect(failed[0].Error()).To(ContainSubstring(`alias "pay" redefined`))
+		})
+	})
+
+	Describe("reference shapes", func() {
+		It("resolves refList items given as names and as objects", func() {
+			out := resolve(
+				doc(events.APIResource, map[string]any{"displayName": "orders"}),
+				doc(events.APIResource, map[string]any{"displayName": "stock"}),
+				doc(events.ComponentResource, map[string]any{
+					"displayName": "shop",
+					"consumes":    []any{map[string]any{"apiId": "orders"}, map[string]any{"apiId": map[string]any{"name": "stock"}}},
+				}),
+				doc(events.IdentityResource, map[string]any{"displayName": "alice"}),
+				doc(events.GroupResource, map[string]any{"displayName": "team", "members": []any{"alice"}}),
+			)
+			orders := ingress.GeneratedID(events.APIResource, "orders").String()
+			stock := ingress.GeneratedID(events.APIResource, "stock").String()
+			Expect(out[2].Spec["consumes"]).To(Equal([]any{map[string]any{"apiId": orders}, map[string]any{"apiId": stock}}))
+			Expect(out[4].Spec["members"]).To(Equal([]any{ingress.GeneratedID(events.IdentityResource, "alice").String()}))
+		})
+
+		It("resolves refNested objects by id key or by name", func() {
+			out := resolve(
+				doc(events.GroupResource, map[string]any{"displayName": "team"}),
+				doc(events.ContextResource, map[string]any{"displayName": "prod"}),
+				doc(events.BindingResource, map[string]any{"displayName": "b", "subject": map[string]any{"groupId": "team"}}),
+				doc(events.CapacityResource, map[string]any{"displayName": "cpu", "contextRef": map[string]any{"name": "prod"}}),
+			)
+			Expect(out[2].Spec["subject"]).To(Equal(map[string]any{"groupId": ingress.GeneratedID(events.GroupResource, "team").String()}))
+			Expect(out[3].Spec["contextRef"]).To(Equal(map[string]any{"contextId": ingress.GeneratedID(events.ContextResource, "prod").String()}))
+		})
+
+		It("resolves refResources items of any kind by name", func() {
+			out := resolve(
+				doc(events.SystemResource, map[string]any{"displayName": "payments"}),
+				doc(events.FindingResource, map[string]any{
+					"findingId":   uuid.NewString(),
+					"displayName": "stale",
+					"resources":   []any{map[string]any{"resourceType": "System", "name": "payments"}},
+				}),
+			)
+			Expect(out[1].Spec["resources"]).To(Equal([]any{map[string]any{
+				"resourceType": "System",
+				"resourceId":   ingress.GeneratedID(events.SystemResource, "payments").String(),
+			}}))
+		})
+	})
+})
@@ -0,0 +1,407 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+)
+
+// Annotations of Artifacts and Products imported from SBOMs.
+const (
+	// SBOMPurlKey holds the package URL of an Artifact, and of a Product the
+	// package URL without version, qualifiers and subpath.
+	SBOMPurlKey = "emeland.io/sbom.purl"
+	// SBOMCPEKey holds the CPE of an Artifact or Product.
+	SBOMCPEKey = "emeland.io/sbom.cpe"
+)
+
+// SBOMOptions are the options of [DecodeCycloneDXDocuments] and
+// [DecodeSPDXDocuments].
+type SBOMOptions struct {
+	// ComponentInstance is the ComponentInstance (UUID or name) the SBOM
+	// describes; when set, every Artifact gets an ArtifactInstance of it.
+	ComponentInstance string `yaml:"componentInstance"`
+}
+
+// SBOMID returns the id of a resource imported from an SBOM; key is the
+// package URL, CPE or name the resource is keyed by, prefixed by its role.
+func SBOMID(key string) uuid.UUID {
+	return uuid.NewSHA1(NameNamespace, []byte("sbom:"+key))
+}
+
+// sbomPackage is a component (CycloneDX) or package (SPDX) of an SBOM.
+type sbomPackage struct {
+	name, version, description string
+	supplier                   string
+	purl, cpe                  string
+	hashes                     map[string]string // normalised algorithm -> hex
+}
+
+// sbomHashAlgorithms are the algorithms an Artifact hash is taken from,
+// strongest first.
+var sbomHashAlgorithms = []string{"sha512", "sha384", "sha256", "sha1", "md5"}
+
+// normalizeHashAlgorithm maps "SHA-256" (CycloneDX) and "SHA256" (SPDX) to "sha256".
+func normalizeHashAlgorithm(alg string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(alg), "-", ""))
+}
+
+// hash returns "<algorithm>:<hex>" of the strongest hash, or "".
+func (p *sbomPackage) hash() string {
+	for _, alg := range sbomHashAlgorithms {
+		if v := p.hashes[alg]; v != "" {
+			return alg + ":" + strings.ToLower(v)
+		}
+	}
+	return ""
+}
+
+// artifactKey identifies the package including its version.
+func (p *sbomPackage) artifactKey() string {
+	switch {
+	case p.purl != "":
+		return p.purl
+	case p.cpe != "":
+		return p.cpe
+	default:
+		return strings.ToLower(p.name) + "@" + p.version
+	}
+}
+
+// productKey identifies the package across versions: the package URL or CPE
+// without version, else the name.
+func (p *sbomPackage) productKey() string {
+	if p.purl != "" {
+		return purlBase(p.purl)
+	}
+	if vendor, product := cpeParts(p.cpe); product != "" {
+		return "cpe:" + strings.ToLower(vendor+":"+product)
+	}
+	return strings.ToLower(p.name)
+}
+
+// vendor returns the supplier, else the vendor of the CPE.
+func (p *sbomPackage) vendor() string {
+	if p.supplier != "" {
+		return p.supplier
+	}
+	vendor, _ := cpeParts(p.cpe)
+	return vendor
+}
+
+// purlBase strips the version, qualifiers and subpath of a package URL.
+func purlBase(purl string) string {
+	purl, _, _ = strings.Cut(purl, "#")
+	purl, _, _ = strings.Cut(purl, "?")
+	if at := strings.LastIndex(purl, "@"); at > strings.LastIndex(purl, "/") {
+		purl = purl[:at]
+	}
+	return purl
+}
+
+// cpeParts returns vendor and product of a CPE 2.3 ("cpe:2.3:a:vendor:product:…")
+// or 2.2 ("cpe:/a:vendor:product:…") name; "*" and "-" are empty.
+func cpeParts(cpe string) (vendor, product string) {
+	rest, ok := strings.CutPrefix(cpe, "cpe:2.3:")
+	if !ok {
+		if rest, ok = strings.CutPrefix(cpe, "cpe:/"); !ok {
+			return "", ""
+		}
+	}
+	parts := strings.Split(rest, ":")
+	field := func(i int) string {
+		if i >= len(parts) || parts[i] == "*" || parts[i] == "-" {
+			return ""
+		}
+		return parts[i]
+	}
+	return field(1), field(2)
+}
+
+// sbomDocuments turns the packages of an SBOM into documents:
+//
+//   - an OrgUnit per vendor,
+//   - an Artifact per package, with the strongest of its hashes,
+//   - a Product per package URL or CPE without version, whose vendor is the OrgUnit
+//     and which has a version per package version listing its Artifacts; as
+//     other SBOMs may list the same package, the versions are merged into
+//     those of the existing Product,
+//   - an ArtifactInstance per Artifact of opts.ComponentInstance, when set.
+func sbomDocuments(format string, pkgs []sbomPackage, opts SBOMOptions) ([]Document, error) {
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("%s: no packages found", format)
+	}
+	doc := func(rt events.ResourceType, spec map[string]any) Document {
+		return Document{Version: DefaultCSVVersion, Kind: DocumentKind(rt), Spec: spec}
+	}
+
+	type product struct {
+		spec     map[string]any
+		versions []string
+		artefact map[string][]any // version -> artifact ids
+	}
+	var vendors, artifacts, instances []Document
+	var products []*product
+	seenVendor, seenArtifact := map[string]bool{}, map[string]bool{}
+	productByKey := map[string]*product{}
+	ci := strings.TrimSpace(opts.ComponentInstance)
+
+	for _, p := range pkgs {
+		artifactID := SBOMID("artifact:" + p.artifactKey()).String()
+		if seenArtifact[artifactID] {
+			continue
+		}
+		seenArtifact[artifactID] = true
+
+		displayName := p.name
+		if p.version != "" {
+			displayName += "@" + p.version
+		}
+		ann := map[string]any{}
+		if p.purl != "" {
+			ann[SBOMPurlKey] = p.purl
+		}
+		if p.cpe != "" {
+			ann[SBOMCPEKey] = p.cpe
+		}
+		spec := map[string]any{"artifactId": artifactID, "displayName": displayName, "annotations": ann}
+		if p.description != "" {
+			spec["description"] = p.description
+		}
+		if h := p.hash(); h != "" {
+			spec["hash"] = h
+		}
+		artifacts = append(artifacts, doc(events.ArtifactResource, spec))
+
+		if ci != "" {
+			instances = append(instances, doc(events.ArtifactInstanceResource, map[string]any{
+				"artifactInstanceId": SBOMID("instance:" + strings.ToLower(ci) + "/" + p.artifactKey()).String(),
+				"displayName":        displayName,
+				"artifact":           artifactID,
+				"componentInstance":  ci,
+			}))
+		}
+
+		key := p.productKey()
+		prod, ok := productByKey[key]
+		if !ok {
+			prodAnn := map[string]any{}
+			if p.purl != "" {
+				prodAnn[SBOMPurlKey] = purlBase(p.purl)
+			} else if p.cpe != "" {
+				prodAnn[SBOMCPEKey] = p.cpe
+			}
+			prod = &product{
+				spec:     map[string]any{"productId": SBOMID("product:" + key).String(), "displayName": p.name, "annotations": prodAnn},
+				artefact: map[string][]any{},
+			}
+			if p.description != "" {
+				prod.spec["description"] = p.description
+			}
+			productByKey[key] = prod
+			products = append(products, prod)
+		}
+		if vendor := p.vendor(); vendor != "" {
+			vendorID := SBOMID("vendor:" + strings.ToLower(vendor)).String()
+			if !seenVendor[vendorID] {
+				seenVendor[vendorID] = true
+				vendors = append(vendors, doc(events.OrgUnitResource, map[string]any{"orgUnitId": vendorID, "displayName": vendor}))
+			}
+			if _, ok := prod.spec["vendor"]; !ok {
+				prod.spec["vendor"] = vendorID
+			}
+		}
+		if _, ok := prod.artefact[p.version]; !ok {
+			prod.versions = append(prod.versions, p.version)
+		}
+		prod.artefact[p.version] = append(prod.artefact[p.version], artifactID)
+	}
+
+	docs := append(vendors, artifacts...)
+	for _, prod := range products {
+		versions := make([]any, len(prod.versions))
+		for i, v := range prod.versions {
+			versions[i] = map[string]any{"artefacts": prod.artefact[v]}
+		}
+		prod.spec["versions"] = versions
+		prod.spec["mergeVersions"] = true
+		docs = append(docs, doc(events.ProductResource, prod.spec))
+	}
+	return append(docs, instances...), nil
+}
+
+// cycloneDXComponent is a component of a CycloneDX BOM.
+type cycloneDXComponent struct {
+	Name        string `json:"name"`
+	Group       string `json:"group"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	Publisher   string `json:"publisher"`
+	Supplier    *struct {
+		Name string `json:"name"`
+	} `json:"supplier"`
+	Manufacturer *struct {
+		Name string `json:"name"`
+	} `json:"manufacturer"`
+	Purl   string `json:"purl"`
+	CPE    string `json:"cpe"`
+	Hashes []struct {
+		Alg     string `json:"alg"`
+		Content string `json:"content"`
+	} `json:"hashes"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+func (c *cycloneDXComponent) collect(out []sbomPackage) []sbomPackage {
+	if strings.TrimSpace(c.Name) != "" {
+		p := sbomPackage{
+			name:        strings.TrimSpace(c.Name),
+			version:     strings.TrimSpace(c.Version),
+			description: strings.TrimSpace(c.Description),
+			purl:        strings.TrimSpace(c.Purl),
+			cpe:         strings.TrimSpace(c.CPE),
+			hashes:      map[string]string{},
+		}
+		if g := strings.TrimSpace(c.Group); g != "" {
+			p.name = g + "/" + p.name
+		}
+		switch {
+		case c.Supplier != nil && strings.TrimSpace(c.Supplier.Name) != "":
+			p.supplier = strings.TrimSpace(c.Supplier.Name)
+		case c.Manufacturer != nil && strings.TrimSpace(c.Manufacturer.Name) != "":
+			p.supplier = strings.TrimSpace(c.Manufacturer.Name)
+		default:
+			p.supplier = strings.TrimSpace(c.Publisher)
+		}
+		for _, h := range c.Hashes {
+			p.hashes[normalizeHashAlgorithm(h.Alg)] = strings.TrimSpace(h.Content)
+		}
+		out = append(out, p)
+	}
+	for i := range c.Components {
+		out = c.Components[i].collect(out)
+	}
+	return out
+}
+
+// DecodeCycloneDXDocuments decodes a CycloneDX JSON BOM into landscape
+// documents (see [FormatCycloneDX]): the component of metadata and all
+// components, nested ones included, become Artifacts and Products. The
+// supplier, else the manufacturer, else the publisher is the vendor.
+func DecodeCycloneDXDocuments(data []byte, opts SBOMOptions) ([]Document, error) {
+	var bom struct {
+		BOMFormat string `json:"bomFormat"`
+		Metadata  struct {
+			Component *cycloneDXComponent `json:"component"`
+		} `json:"metadata"`
+		Components []cycloneDXComponent `json:"components"`
+	}
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, err
+	}
+	if bom.BOMFormat != "CycloneDX" {
+		return nil, fmt.Errorf("not a CycloneDX JSON BOM (bomFormat is %q)", bom.BOMFormat)
+	}
+	var pkgs []sbomPackage
+	if bom.Metadata.Component != nil {
+		pkgs = bom.Metadata.Component.collect(pkgs)
+	}
+	for i := range bom.Components {
+		pkgs = bom.Components[i].collect(pkgs)
+	}
+	return sbomDocuments("CycloneDX", pkgs, opts)
+}
+
+// spdxActor matches SPDX supplier and originator values such as
+// "Organization: ACME Inc. (sbom@acme.example)".
+var spdxActor = regexp.MustCompile(`^\s*(?:Organization|Person|Tool)\s*:\s*(.*?)\s*(?:\([^)]*\))?\s*$`)
+
+// spdxActorName returns the name of an SPDX actor; NOASSERTION and NONE are empty.
+func spdxActorName(actor string) string {
+	name := strings.TrimSpace(actor)
+	if m := spdxActor.FindStringSubmatch(name); m != nil {
+		name = m[1]
+	}
+	if name == "NOASSERTION" || name == "NONE" {
+		return ""
+	}
+	return name
+}
+
+// DecodeSPDXDocuments decodes an SPDX 2 JSON document into landscape
+// documents (see [FormatSPDX]): every package becomes an Artifact and a
+// Product. The purl and cpe23Type (or cpe22Type) external references key
+// them; the supplier, else the originator, is the vendor.
+func DecodeSPDXDocuments(data []byte, opts SBOMOptions) ([]Document, error) {
+	var doc struct {
+		SPDXVersion string `json:"spdxVersion"`
+		Packages    []struct {
+			Name        string `json:"name"`
+			VersionInfo string `json:"versionInfo"`
+			Description string `json:"description"`
+			Summary     string `json:"summary"`
+			Supplier    string `json:"supplier"`
+			Originator  string `json:"originator"`
+			Checksums   []struct {
+				Algorithm     string `json:"algorithm"`
+				ChecksumValue string `json:"checksumValue"`
+			} `json:"checksums"`
+			ExternalRefs []struct {
+				ReferenceType    string `json:"referenceType"`
+				ReferenceLocator string `json:"referenceLocator"`
+			} `json:"externalRefs"`
+		} `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.SPDXVersion, "SPDX-") {
+		return nil, fmt.Errorf("not an SPDX JSON document (spdxVersion is %q)", doc.SPDXVersion)
+	}
+	var pkgs []sbomPackage
+	for _, sp := range doc.Packages {
+		if strings.TrimSpace(sp.Name) == "" {
+			continue
+		}
+		p := sbomPackage{
+			name:        strings.TrimSpace(sp.Name),
+			version:     strings.TrimSpace(sp.VersionInfo),
+			description: strings.TrimSpace(sp.Description),
+			supplier:    spdxActorName(sp.Supplier),
+			hashes:      map[string]string{},
+		}
+		if p.version == "NOASSERTION" {
+			p.version = ""
+		}
+		if p.description == "" {
+			p.description = strings.TrimSpace(sp.Summary)
+		}
+		if p.supplier == "" {
+			p.supplier = spdxActorName(sp.Originator)
+		}
+		for _, c := range sp.Checksums {
+			p.hashes[normalizeHashAlgorithm(c.Algorithm)] = strings.TrimSpace(c.ChecksumValue)
+		}
+		for _, ref := range sp.ExternalRefs {
+			loc := strings.TrimSpace(ref.ReferenceLocator)
+			switch ref.ReferenceType {
+			case "purl":
+				if p.purl == "" {
+					p.purl = loc
+				}
+			case "cpe23Type":
+				p.cpe = loc
+			case "cpe22Type":
+				if p.cpe == "" {
+					p.cpe = loc
+				}
+			}
+		}
+		pkgs = append(pkgs, p)
+	}
+	return sbomDocuments("SPDX", pkgs, opts)
+}
@@ -0,0 +1,43 @@
+// Package schemas embeds the JSON Schema (draft 2020-12) of each landscape
+// document kind. tools/gen writes them from the same field lists
+// [ingress.ParseOptions.Strict] checks against; editors can use the files for
+// completion and validation of landscape files.
+package schemas
+
+import (
+	"embed"
+	"sort"
+	"strings"
+)
+
+const suffix = ".schema.json"
+
+//go:embed *.schema.json
+var files embed.FS
+
+// Get returns the schema of a whole document of the given kind, matched
+// case-insensitively. ok is false for kinds without one.
+func Get(kind string) (schema []byte, ok bool) {
+	kind = strings.TrimSpace(kind)
+	for _, k := range Kinds() {
+		if strings.EqualFold(k, kind) {
+			data, err := files.ReadFile(k + suffix)
+			return data, err == nil
+		}
+	}
+	return nil, false
+}
+
+// Kinds returns the document kinds with a schema, sorted.
+func Kinds() []string {
+	entries, err := files.ReadDir(".")
+	if err != nil {
+		return nil
+	}
+	kinds := make([]string, 0, len(entries))
+	for _, e := range entries {
+		kinds = append(kinds, strings.TrimSuffix(e.Name(), suffix))
+	}
+	sort.Strings(kinds)
+	return kinds
+}
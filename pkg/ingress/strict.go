@@ -0,0 +1,110 @@
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnknownField is wrapped by the errors strict parsing (see
+// [ParseOptions.Strict]) returns for keys no document kind reads.
+var ErrUnknownField = errors.New("unknown field")
+
+// documentKeys are the top-level keys of a YAML or JSON document.
+var documentKeys = map[string]bool{"version": true, "kind": true, "spec": true, "aliases": true}
+
+// checkStrict rejects top-level document keys other than [documentKeys] and
+// spec keys the document's kind does not read. Kinds without a key list are
+// not checked.
+func checkStrict(format Format, data []byte, docs []Document) error {
+	var raw []map[string]any
+	var err error
+	switch format {
+	case FormatYAML:
+		raw, err = rawYAMLDocuments(data)
+	case FormatJSON:
+		raw, err = rawJSONDocuments(data)
+	}
+	if err != nil {
+		return err
+	}
+	for i, r := range raw {
+		if unknown := unknownKeys(r, documentKeys); len(unknown) > 0 {
+			return fmt.Errorf("document %d: %w %s", i, ErrUnknownField, strings.Join(unknown, ", "))
+		}
+	}
+
+	for i, doc := range docs {
+		keys, ok := documentSpecKeys[doc.Kind.ResourceType()]
+		if !ok {
+			continue
+		}
+		known := make(map[string]bool, len(keys))
+		for _, k := range keys {
+			known[k] = true
+		}
+		if unknown := unknownKeys(doc.Spec, known); len(unknown) > 0 {
+			return fmt.Errorf("document %d: %w %s in %s spec", i, ErrUnknownField, strings.Join(unknown, ", "), doc.Kind.ResourceType())
+		}
+	}
+	return nil
+}
+
+// unknownKeys returns the keys of m not in known, quoted and sorted.
+func unknownKeys(m map[string]any, known map[string]bool) []string {
+	var out []string
+	for k := range m {
+		if !known[k] {
+			out = append(out, fmt.Sprintf("%q", k))
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// rawYAMLDocuments decodes the non-empty documents of a YAML stream as maps.
+func rawYAMLDocuments(data []byte) ([]map[string]any, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var out []map[string]any
+	for {
+		var m map[string]any
+		if err := dec.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return out, nil
+			}
+			return nil, err
+		}
+		if len(m) > 0 {
+			out = append(out, m)
+		}
+	}
+}
+
+// rawJSONDocuments decodes one JSON object, an array of objects, or JSONL as
+// maps, like [DecodeJSONDocuments].
+func rawJSONDocuments(data []byte) ([]map[string]any, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var out []map[string]any
+		err := json.Unmarshal(trimmed, &out)
+		return out, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	var out []map[string]any
+	for {
+		var m map[string]any
+		if err := dec.Decode(&m); err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return nil, err
+		}
+		out = append(out, m)
+	}
+}
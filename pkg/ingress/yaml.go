@@ -30,6 +30,7 @@ func DecodeDocuments(data []byte) ([]Document, error) {
 	dec := yaml.NewDecoder(bytes.NewReader(data))
 
 	var docs []Document
+	aliases := map[string]string{}
 	for {
 		var doc Document
 		err := dec.Decode(&doc)
@@ -39,8 +40,12 @@ func DecodeDocuments(data []byte) ([]Document, error) {
 			}
 			return nil, err
 		}
-		// Ignore completely empty YAML documents (e.g. stray `---` separators).
+		// Ignore completely empty YAML documents (e.g. stray `---` separators);
+		// a document with only aliases sets them for the file.
 		if strings.TrimSpace(doc.Version) == "" && doc.Kind.ResourceType() == events.UnknownResourceType && doc.Spec == nil {
+			for k, v := range doc.Aliases {
+				aliases[k] = v
+			}
 			continue
 		}
 		if strings.TrimSpace(doc.Version) == "" {
@@ -57,5 +62,5 @@ func DecodeDocuments(data []byte) ([]Document, error) {
 	if len(docs) == 0 {
 		return nil, fmt.Errorf("no YAML documents found")
 	}
-	return docs, nil
+	return withFileAliases(docs, aliases), nil
 }
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+)
+
+// FilterCollector implements [prometheus.Collector] and exposes the per-filter
+// statistics of an [eventfilter.Chain]. Every series is labelled with the
+// filter id (the id of its FilterRule) and display name.
+type FilterCollector struct {
+	chain    eventfilter.Chain
+	in       *prometheus.Desc
+	out      *prometheus.Desc
+	panics   *prometheus.Desc
+	duration *prometheus.Desc
+	enabled  *prometheus.Desc
+}
+
+// NewFilterCollector returns a collector that reads chain statistics on each scrape.
+func NewFilterCollector(chain eventfilter.Chain) *FilterCollector {
+	labels := []string{"filter_id", "filter"}
+	return &FilterCollector{
+		chain: chain,
+		in: prometheus.NewDesc(
+			"emeland_filter_events_in_total",
+			"Number of events passed to a filter in the event filter chain.",
+			labels, nil,
+		),
+		out: prometheus.NewDesc(
+			"emeland_filter_events_out_total",
+			"Number of events returned by a filter in the event filter chain.",
+			labels, nil,
+		),
+		panics: prometheus.NewDesc(
+			"emeland_filter_panics_total",
+			"Number of filter calls that panicked; their event was passed on unchanged.",
+			labels, nil,
+		),
+		duration: prometheus.NewDesc(
+			"emeland_filter_duration_seconds",
+			"Time spent in a filter per event.",
+			labels, nil,
+		),
+		enabled: prometheus.NewDesc(
+			"emeland_filter_enabled",
+			"Whether a filter is enabled (1) or disabled (0).",
+			labels, nil,
+		),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (c *FilterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.in
+	ch <- c.out
+	ch <- c.panics
+	ch <- c.duration
+	ch <- c.enabled
+}
+
+// Collect implements [prometheus.Collector].
+func (c *FilterCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, st := range c.chain.Stats() {
+		id := uuid.UUID(st.ID).String()
+		ch <- prometheus.MustNewConstMetric(c.in, prometheus.CounterValue, float64(st.EventsIn), id, st.DisplayName)
+		ch <- prometheus.MustNewConstMetric(c.out, prometheus.CounterValue, float64(st.EventsOut), id, st.DisplayName)
+		ch <- prometheus.MustNewConstMetric(c.panics, prometheus.CounterValue, float64(st.Panics), id, st.DisplayName)
+
+		buckets := make(map[float64]uint64, len(eventfilter.LatencyBuckets))
+		for i, bound := range eventfilter.LatencyBuckets {
+			buckets[bound] = st.LatencyBucketCounts[i]
+		}
+		ch <- prometheus.MustNewConstHistogram(c.duration, st.EventsIn, st.LatencySum, buckets, id, st.DisplayName)
+
+		enabled := 1.0
+		if st.Disabled {
+			enabled = 0
+		}
+		ch <- prometheus.MustNewConstMetric(c.enabled, prometheus.GaugeValue, enabled, id, st.DisplayName)
+	}
+}
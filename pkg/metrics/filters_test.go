@@ -0,0 +1,79 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.emeland.io/modelsrv/pkg/eventfilter"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/metrics"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+func TestFilterCollector(t *testing.T) {
+	chain := eventfilter.NewChain(nil)
+	m, err := model.NewModel(eventfilter.NewFilteringSink(chain, events.NewListSink()))
+	require.NoError(t, err)
+	chain.SetModel(m)
+	id := chain.RegisterFilter(eventfilter.Filter{
+		DisplayName: "Drop systems",
+		Fn: func(_ model.Model, ev events.Event) []events.Event {
+			if ev.ResourceType == events.SystemResource {
+				return nil
+			}
+			return []events.Event{ev}
+		},
+	})
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(metrics.NewFilterCollector(chain))
+
+	sys := system.NewSystem(uuid.New())
+	sys.SetDisplayName("s1")
+	require.NoError(t, m.AddSystem(sys))
+
+	// The filter saw the creation of its own FilterRule and the System.
+	filterID := uuid.UUID(id).String()
+	assert.Equal(t, 2.0, getFilterValue(t, reg, "emeland_filter_events_in_total", filterID))
+	assert.Equal(t, 1.0, getFilterValue(t, reg, "emeland_filter_events_out_total", filterID))
+	assert.Equal(t, 1.0, getFilterValue(t, reg, "emeland_filter_enabled", filterID))
+
+	chain.SetEnabled(id, false)
+	assert.Equal(t, 0.0, getFilterValue(t, reg, "emeland_filter_enabled", filterID))
+
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	for _, mf := range mfs {
+		if mf.GetName() == "emeland_filter_duration_seconds" {
+			assert.Equal(t, uint64(2), mf.GetMetric()[0].GetHistogram().GetSampleCount())
+		}
+	}
+}
+
+func getFilterValue(t *testing.T, reg *prometheus.Registry, name, filterID string) float64 {
+	t.Helper()
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if lp.GetName() == "filter_id" && lp.GetValue() == filterID {
+					if m.GetCounter() != nil {
+						return m.GetCounter().GetValue()
+					}
+					return m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	t.Fatalf("metric %s{filter_id=%q} not found", name, filterID)
+	return 0
+}
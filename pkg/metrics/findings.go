@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.emeland.io/modelsrv/pkg/findingsummary"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// FindingCollector implements [prometheus.Collector] and exposes the finding
+// breakdown of [findingsummary.Summarize] as gauges. The status gauge counts
+// all findings; the other gauges count active (open or acknowledged) findings
+// only, so suppressed and resolved findings do not trigger alerts.
+type FindingCollector struct {
+	m            model.Model
+	byStatus     *prometheus.Desc
+	byType       *prometheus.Desc
+	bySeverity   *prometheus.Desc
+	byOwnerGroup *prometheus.Desc
+	byContext    *prometheus.Desc
+	byKind       *prometheus.Desc
+}
+
+// NewFindingCollector returns a collector that summarises the findings in m on
+// each scrape.
+func NewFindingCollector(m model.Model) *FindingCollector {
+	return &FindingCollector{
+		m: m,
+		byStatus: prometheus.NewDesc(
+			"emeland_findings",
+			"Number of findings per triage status.",
+			[]string{"status"}, nil,
+		),
+		byType: prometheus.NewDesc(
+			"emeland_active_findings_by_type",
+			"Number of open or acknowledged findings per FindingType.",
+			[]string{"finding_type_id", "finding_type"}, nil,
+		),
+		bySeverity: prometheus.NewDesc(
+			"emeland_active_findings_by_severity",
+			"Number of open or acknowledged findings per severity.",
+			[]string{"severity"}, nil,
+		),
+		byOwnerGroup: prometheus.NewDesc(
+			"emeland_active_findings_by_owner_group",
+			"Number of open or acknowledged findings per owner group of the subject resource; empty group for unowned subjects.",
+			[]string{"group", "group_name"}, nil,
+		),
+		byContext: prometheus.NewDesc(
+			"emeland_active_findings_by_context",
+			"Number of open or acknowledged findings in each Context subtree.",
+			[]string{"context_id", "context"}, nil,
+		),
+		byKind: prometheus.NewDesc(
+			"emeland_active_findings_by_resource_type",
+			"Number of open or acknowledged findings per subject resource type.",
+			[]string{"type"}, nil,
+		),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (c *FindingCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.byStatus
+	ch <- c.byType
+	ch <- c.bySeverity
+	ch <- c.byOwnerGroup
+	ch <- c.byContext
+	ch <- c.byKind
+}
+
+// Collect implements [prometheus.Collector].
+func (c *FindingCollector) Collect(ch chan<- prometheus.Metric) {
+	all, err := c.m.GetFindings()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for _, b := range findingsummary.Summarize(c.m, all, now).ByStatus {
+		ch <- prometheus.MustNewConstMetric(c.byStatus, prometheus.GaugeValue, float64(b.Count), b.Key)
+	}
+
+	active := make([]finding.Finding, 0, len(all))
+	for _, f := range all {
+		if finding.StatusOf(f, now).Active() {
+			active = append(active, f)
+		}
+	}
+	s := findingsummary.Summarize(c.m, active, now)
+	for _, b := range s.ByFindingType {
+		ch <- prometheus.MustNewConstMetric(c.byType, prometheus.GaugeValue, float64(b.Count), b.Key, b.Name)
+	}
+	for _, b := range s.BySeverity {
+		ch <- prometheus.MustNewConstMetric(c.bySeverity, prometheus.GaugeValue, float64(b.Count), b.Key)
+	}
+	for _, b := range s.ByOwnerGroup {
+		ch <- prometheus.MustNewConstMetric(c.byOwnerGroup, prometheus.GaugeValue, float64(b.Count), b.Key, b.Name)
+	}
+	for _, b := range s.ByContext {
+		ch <- prometheus.MustNewConstMetric(c.byContext, prometheus.GaugeValue, float64(b.Count), b.Key, b.Name)
+	}
+	for _, b := range s.ByResourceType {
+		ch <- prometheus.MustNewConstMetric(c.byKind, prometheus.GaugeValue, float64(b.Count), b.Key)
+	}
+}
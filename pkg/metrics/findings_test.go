@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/metrics"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+func TestFindingCollector(t *testing.T) {
+	m, err := model.NewModel(events.NewListSink())
+	require.NoError(t, err)
+
+	c := metrics.NewFindingCollector(m)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c)
+	assert.Equal(t, 0, testutil.CollectAndCount(c), "no findings, no series")
+
+	sys := system.NewSystem(uuid.New())
+	sys.SetDisplayName("billing")
+	require.NoError(t, m.AddSystem(sys))
+	ft := finding.NewFindingType(uuid.New())
+	ft.SetDisplayName("OwnerMissing")
+	ft.SetSeverity(finding.SeverityHigh)
+	require.NoError(t, m.AddFindingType(ft))
+
+	for _, status := range []finding.Status{finding.StatusOpen, finding.StatusOpen, finding.StatusSuppressed} {
+		f := finding.NewFinding(uuid.New())
+		f.SetDisplayName("check")
+		f.SetFindingTypeById(ft.GetFindingTypeId())
+		f.SetResources([]*common.ResourceRef{{ResourceId: sys.GetSystemId(), ResourceType: events.SystemResource}})
+		if status != finding.StatusOpen {
+			f.SetTriage(&finding.Triage{Status: status, ChangedAt: time.Now()})
+		}
+		require.NoError(t, m.AddFinding(f))
+	}
+
+	assert.Equal(t, 2.0, getLabelledValue(t, reg, "emeland_findings", "status", "open"))
+	assert.Equal(t, 1.0, getLabelledValue(t, reg, "emeland_findings", "status", "suppressed"))
+	assert.Equal(t, 2.0, getLabelledValue(t, reg, "emeland_active_findings_by_severity", "severity", "high"))
+	assert.Equal(t, 2.0, getLabelledValue(t, reg, "emeland_active_findings_by_type", "finding_type", "OwnerMissing"))
+	assert.Equal(t, 2.0, getLabelledValue(t, reg, "emeland_active_findings_by_resource_type", "type", "System"))
+	assert.Equal(t, 2.0, getLabelledValue(t, reg, "emeland_active_findings_by_owner_group", "group", ""))
+}
+
+func getLabelledValue(t *testing.T, reg *prometheus.Registry, name, label, value string) float64 {
+	t.Helper()
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, lp := range m.GetLabel() {
+				if lp.GetName() == label && lp.GetValue() == value {
+					return m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	t.Fatalf("metric %s{%s=%q} not found", name, label, value)
+	return 0
+}
@@ -15,6 +15,7 @@ import (
 	uuid "github.com/google/uuid"
 	events "go.emeland.io/modelsrv/pkg/events"
 	annotations "go.emeland.io/modelsrv/pkg/model/annotations"
+	finding "go.emeland.io/modelsrv/pkg/model/finding"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -126,6 +127,20 @@ func (mr *MockFindingTypeMockRecorder) GetResourceName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceName", reflect.TypeOf((*MockFindingType)(nil).GetResourceName))
 }
 
+// GetSeverity mocks base method.
+func (m *MockFindingType) GetSeverity() finding.Severity {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeverity")
+	ret0, _ := ret[0].(finding.Severity)
+	return ret0
+}
+
+// GetSeverity indicates an expected call of GetSeverity.
+func (mr *MockFindingTypeMockRecorder) GetSeverity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeverity", reflect.TypeOf((*MockFindingType)(nil).GetSeverity))
+}
+
 // Register mocks base method.
 func (m *MockFindingType) Register(sink events.EventSink) {
 	m.ctrl.T.Helper()
@@ -173,3 +188,15 @@ func (mr *MockFindingTypeMockRecorder) SetDisplayName(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisplayName", reflect.TypeOf((*MockFindingType)(nil).SetDisplayName), arg0)
 }
+
+// SetSeverity mocks base method.
+func (m *MockFindingType) SetSeverity(arg0 finding.Severity) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSeverity", arg0)
+}
+
+// SetSeverity indicates an expected call of SetSeverity.
+func (mr *MockFindingTypeMockRecorder) SetSeverity(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeverity", reflect.TypeOf((*MockFindingType)(nil).SetSeverity), arg0)
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/common"
+)
+
+// heldEvent is an event a [batchSink] holds back until its batch ends.
+type heldEvent struct {
+	resType    events.ResourceType
+	op         events.Operation
+	resourceId uuid.UUID
+	objects    []any
+}
+
+// batchSink passes events to the model's sink, except while a batch is open:
+// then it holds them back and releases them in order once the batch commits.
+type batchSink struct {
+	inner events.EventSink
+	// batchMu serializes batches.
+	batchMu sync.Mutex
+
+	mu   sync.Mutex
+	open bool
+	held []heldEvent
+}
+
+var _ events.EventSink = (*batchSink)(nil)
+
+func newBatchSink(inner events.EventSink) *batchSink {
+	return &batchSink{inner: inner}
+}
+
+// Receive implements [events.EventSink].
+func (s *batchSink) Receive(resType events.ResourceType, op events.Operation, resourceId uuid.UUID, object ...any) error {
+	s.mu.Lock()
+	if s.open {
+		s.held = append(s.held, heldEvent{resType: resType, op: op, resourceId: resourceId, objects: object})
+		s.mu.Unlock()
+		return nil
+	}
+	s.mu.Unlock()
+	return s.inner.Receive(resType, op, resourceId, object...)
+}
+
+func (s *batchSink) begin() {
+	s.batchMu.Lock()
+	s.mu.Lock()
+	s.open = true
+	s.mu.Unlock()
+}
+
+// end closes the batch. Held events of resources in drop are discarded, the
+// others are released in order. Events received while releasing, e.g. the
+// findings of filters reacting to a released event, are queued behind the
+// held ones so the sink sees every event in order.
+func (s *batchSink) end(drop map[common.ResourceRef]bool) {
+	defer s.batchMu.Unlock()
+	for {
+		s.mu.Lock()
+		held := s.held
+		s.held = nil
+		if len(held) == 0 {
+			s.open = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+		for _, ev := range held {
+			if drop[common.ResourceRef{ResourceId: ev.resourceId, ResourceType: ev.resType}] {
+				continue
+			}
+			if err := s.inner.Receive(ev.resType, ev.op, ev.resourceId, ev.objects...); err != nil {
+				fmt.Println("Error receiving ", ev.resType, "| ", ev.op, " event: ", err)
+			}
+		}
+		drop = nil // only the batch's own events are dropped
+	}
+}
+
+// Tx is the handle of a running [Batch].
+type Tx struct {
+	m     Model
+	saved []savedResource
+	seen  map[common.ResourceRef]bool
+}
+
+type savedResource struct {
+	ref common.ResourceRef
+	obj any // nil when the resource did not exist
+}
+
+// Save records the current state of the resource ref so that a failing batch
+// can restore it. Call it before changing the resource; later calls for the
+// same resource are ignored.
+func (tx *Tx) Save(ref common.ResourceRef) {
+	if tx.seen[ref] {
+		return
+	}
+	tx.seen[ref] = true
+	tx.saved = append(tx.saved, savedResource{ref: ref, obj: ResourceObject(tx.m, &ref)})
+}
+
+// rollback puts every saved resource back, newest first.
+func (tx *Tx) rollback() error {
+	var firstErr error
+	for i := len(tx.saved) - 1; i >= 0; i-- {
+		s := tx.saved[i]
+		ev := events.Event{ResourceType: s.ref.ResourceType, ResourceId: s.ref.ResourceId, Operation: events.DeleteOperation}
+		if s.obj != nil {
+			ev.Operation = events.UpdateOperation
+			ev.Objects = []any{s.obj}
+		}
+		if err := tx.m.Apply(ev); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("restoring %s %s: %w", s.ref.ResourceType, s.ref.ResourceId, err)
+		}
+	}
+	return firstErr
+}
+
+// Batch runs fn as one unit of change to m. The events of a model created by
+// [NewModel] are held back while fn runs and released in order once it
+// returns nil, so event consumers never see a half-applied batch. If fn
+// returns an error, every resource fn saved through [Tx.Save] is restored,
+// the batch's events are dropped and the error is returned. Batches on the
+// same model run one at a time and must not be nested; other writers are not
+// blocked, but their events wait for the batch to end.
+func Batch(m Model, fn func(tx *Tx) error) error {
+	tx := &Tx{m: m, seen: map[common.ResourceRef]bool{}}
+	var drop map[common.ResourceRef]bool
+	if md, ok := m.(*modelData); ok {
+		md.emit.begin()
+		defer func() { md.emit.end(drop) }()
+	}
+	if err := fn(tx); err != nil {
+		if rbErr := tx.rollback(); rbErr != nil {
+			err = fmt.Errorf("%w (rollback: %v)", err, rbErr)
+		}
+		drop = tx.seen
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/system"
+)
+
+var _ = Describe("Batch", func() {
+	var (
+		sink     *events.ListSink
+		m        model.Model
+		existing system.System
+	)
+
+	BeforeEach(func() {
+		sink = events.NewListSink()
+		md, err := model.NewModel(sink)
+		Expect(err).NotTo(HaveOccurred())
+		m = md
+		existing = system.NewSystem(uuid.New())
+		existing.SetDisplayName("before")
+		Expect(m.AddSystem(existing)).To(Succeed())
+	})
+
+	ref := func(s system.System) common.ResourceRef {
+		return common.ResourceRef{ResourceId: s.GetSystemId(), ResourceType: events.SystemResource}
+	}
+
+	It("releases the events of a committed batch only after it ends", func() {
+		created := system.NewSystem(uuid.New())
+		updated := system.NewSystem(existing.GetSystemId())
+		updated.SetDisplayName("after")
+		before := len(sink.GetEvents())
+
+		Expect(model.Batch(m, func(tx *model.Tx) error {
+			tx.Save(ref(created))
+			Expect(m.AddSystem(created)).To(Succeed())
+			tx.Save(ref(updated))
+			Expect(m.AddSystem(updated)).To(Succeed())
+			Expect(sink.GetEvents()).To(HaveLen(before), "events are held while the batch runs")
+			return nil
+		})).To(Succeed())
+
+		evs := sink.GetEvents()[before:]
+		Expect(evs).To(HaveLen(2))
+		Expect(evs[0].Operation).To(Equal(events.CreateOperation))
+		Expect(evs[0].ResourceId).To(Equal(created.GetSystemId()))
+		Expect(evs[1].Operation).To(Equal(events.UpdateOperation))
+		Expect(m.GetSystemById(existing.GetSystemId()).GetDisplayName()).To(Equal("after"))
+	})
+
+	It("restores saved resources and drops the events of a failed batch", func() {
+		created := system.NewSystem(uuid.New())
+		updated := system.NewSystem(existing.GetSystemId())
+		updated.SetDisplayName("after")
+		before := len(sink.GetEvents())
+		boom := errors.New("boom")
+
+		err := model.Batch(m, func(tx *model.Tx) error {
+			tx.Save(ref(created))
+			Expect(m.AddSystem(created)).To(Succeed())
+			tx.Save(ref(updated))
+			Expect(m.AddSystem(updated)).To(Succeed())
+			return boom
+		})
+		Expect(err).To(MatchError(boom))
+
+		Expect(m.GetSystemById(created.GetSystemId())).To(BeNil())
+		Expect(m.GetSystemById(existing.GetSystemId()).GetDisplayName()).To(Equal("before"))
+		Expect(sink.GetEvents()).To(HaveLen(before))
+	})
+
+	It("releases the events of unrelated changes made during a failed batch", func() {
+		other := system.NewSystem(uuid.New())
+		before := len(sink.GetEvents())
+
+		Expect(model.Batch(m, func(tx *model.Tx) error {
+			Expect(m.AddSystem(other)).To(Succeed())
+			return errors.New("boom")
+		})).NotTo(Succeed())
+
+		Expect(sink.GetEvents()[before:]).To(ConsistOf(HaveField("ResourceId", other.GetSystemId())))
+	})
+})
@@ -0,0 +1,42 @@
+package filterrule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Action is what a declarative [FilterRule] does with an event whose
+// Expression evaluates to true.
+type Action string
+
+const (
+	// ActionNone documents a filter without acting on events. Filter rules
+	// mirrored from compiled filters carry no action.
+	ActionNone Action = ""
+	// ActionDrop suppresses the matching event.
+	ActionDrop Action = "drop"
+	// ActionFinding raises a Finding of the rule's FindingType on the matching
+	// resource and deletes it again once the expression no longer matches.
+	ActionFinding Action = "finding"
+)
+
+// ParseAction parses s case-insensitively. "raiseFinding" is accepted for
+// [ActionFinding].
+func ParseAction(s string) (Action, error) {
+	switch a := strings.ToLower(strings.TrimSpace(s)); a {
+	case "", "none":
+		return ActionNone, nil
+	case string(ActionDrop):
+		return ActionDrop, nil
+	case string(ActionFinding), "raisefinding":
+		return ActionFinding, nil
+	default:
+		return ActionNone, fmt.Errorf("unknown filter rule action %q", s)
+	}
+}
+
+// IsDeclarative reports whether fr carries an expression to be compiled into
+// the filter chain, as opposed to only documenting a compiled filter.
+func IsDeclarative(fr FilterRule) bool {
+	return fr != nil && strings.TrimSpace(fr.GetExpression()) != ""
+}
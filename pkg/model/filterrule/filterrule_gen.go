@@ -26,6 +26,24 @@ type FilterRule interface {
 	GetDescription() string
 	SetDescription(string)
 
+	GetResourceType() string
+	SetResourceType(string)
+
+	GetExpression() string
+	SetExpression(string)
+
+	GetAction() Action
+	SetAction(Action)
+
+	GetFindingType() string
+	SetFindingType(string)
+
+	GetMessage() string
+	SetMessage(string)
+
+	GetDisabled() bool
+	SetDisabled(bool)
+
 	Register(sink events.EventSink)
 }
 
@@ -33,9 +51,15 @@ type filterruleData struct {
 	sink         events.EventSink
 	isRegistered bool
 
-	RuleId      uuid.UUID
-	DisplayName string
-	Description string
+	RuleId       uuid.UUID
+	DisplayName  string
+	Description  string
+	ResourceType string
+	Expression   string
+	Action       Action
+	FindingType  string
+	Message      string
+	Disabled     bool
 }
 
 // NewFilterRule constructs an unregistered resource; call [FilterRule.Register] after adding to the model.
@@ -91,6 +115,90 @@ func (o *filterruleData) SetDescription(val string) {
 	}
 }
 
+// GetResourceType implements [ResourceType].
+func (o *filterruleData) GetResourceType() string {
+	return o.ResourceType
+}
+
+// SetResourceType implements [FilterRule].
+func (o *filterruleData) SetResourceType(val string) {
+	o.ResourceType = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
+// GetExpression implements [Expression].
+func (o *filterruleData) GetExpression() string {
+	return o.Expression
+}
+
+// SetExpression implements [FilterRule].
+func (o *filterruleData) SetExpression(val string) {
+	o.Expression = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
+// GetAction implements [Action].
+func (o *filterruleData) GetAction() Action {
+	return o.Action
+}
+
+// SetAction implements [FilterRule].
+func (o *filterruleData) SetAction(val Action) {
+	o.Action = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
+// GetFindingType implements [FindingType].
+func (o *filterruleData) GetFindingType() string {
+	return o.FindingType
+}
+
+// SetFindingType implements [FilterRule].
+func (o *filterruleData) SetFindingType(val string) {
+	o.FindingType = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
+// GetMessage implements [Message].
+func (o *filterruleData) GetMessage() string {
+	return o.Message
+}
+
+// SetMessage implements [FilterRule].
+func (o *filterruleData) SetMessage(val string) {
+	o.Message = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
+// GetDisabled implements [Disabled].
+func (o *filterruleData) GetDisabled() bool {
+	return o.Disabled
+}
+
+// SetDisabled implements [FilterRule].
+func (o *filterruleData) SetDisabled(val bool) {
+	o.Disabled = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FilterRuleResource, events.UpdateOperation, o.RuleId, o)
+	}
+}
+
 // Register implements [FilterRule].
 func (o *filterruleData) Register(sink events.EventSink) {
 	o.sink = sink
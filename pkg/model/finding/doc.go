@@ -33,6 +33,14 @@
 // ContextType is added to the model), the filter deletes the corresponding
 // finding automatically.
 //
+// # Severity and triage
+//
+// A FindingType carries a [Severity]; [SeverityForKind] supplies the default
+// for well-known kinds. A Finding carries an optional [Triage] recording its
+// [Status] (open, acknowledged, suppressed or resolved), who changed it and
+// why. The model keeps the triage when a filter re-adds a finding under the
+// same id, and keeps an active suppression across delete and re-detection.
+//
 // # Fields
 //
 // Each Finding carries:
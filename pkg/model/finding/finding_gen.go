@@ -31,6 +31,9 @@ type Finding interface {
 	GetResources() []*common.ResourceRef
 	SetResources([]*common.ResourceRef)
 
+	GetTriage() *Triage
+	SetTriage(*Triage)
+
 	GetAnnotations() annotations.Annotations
 	SetAnnotations(annotations.Annotations)
 
@@ -52,6 +55,7 @@ type findingData struct {
 	Description string
 	TypeRef     *FindingTypeRef
 	Resources   []*common.ResourceRef
+	Triage      *Triage
 	Annotations annotations.Annotations
 }
 
@@ -124,6 +128,20 @@ func (o *findingData) SetResources(val []*common.ResourceRef) {
 	}
 }
 
+// GetTriage implements [Triage].
+func (o *findingData) GetTriage() *Triage {
+	return o.Triage
+}
+
+// SetTriage implements [Finding].
+func (o *findingData) SetTriage(val *Triage) {
+	o.Triage = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FindingResource, events.UpdateOperation, o.FindingId, o)
+	}
+}
+
 // GetAnnotations implements [Annotations].
 func (o *findingData) GetAnnotations() annotations.Annotations {
 	return o.Annotations
@@ -1,6 +1,10 @@
 package finding
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 // FindingKind is the canonical string identifier for a category of findings.
 // It is used to derive stable [FindingType] UUIDs via [TypeIDForKind] so that
@@ -39,6 +43,35 @@ const (
 	// MissingResourceReference is raised when a subject lacks a required EmELand
 	// reference (e.g. ApiInstance without an API ref). Resources layout: [subject].
 	MissingResourceReference FindingKind = "MissingResourceReference"
+
+	// ProductVersionDeprecated is raised when an ArtifactInstance runs an artefact
+	// of a ProductionVersion whose DeprecatedFrom date has passed.
+	ProductVersionDeprecated FindingKind = "ProductVersionDeprecated"
+
+	// ProductVersionTerminated is raised when an ArtifactInstance runs an artefact
+	// of a ProductionVersion whose TerminatedFrom date has passed.
+	ProductVersionTerminated FindingKind = "ProductVersionTerminated"
+
+	// ProductVersionNotYetAvailable is raised when an ArtifactInstance runs an
+	// artefact of a ProductionVersion whose AvailableFrom date lies in the future.
+	ProductVersionNotYetAvailable FindingKind = "ProductVersionNotYetAvailable"
+
+	// FilterRuleInvalid is raised on a declarative FilterRule whose expression
+	// or settings do not compile. The rule stays registered but inactive.
+	FilterRuleInvalid FindingKind = "FilterRuleInvalid"
+
+	// FilterFailed is raised on the FilterRule of a filter that panicked while
+	// processing an event. The chain passed the event on unchanged.
+	FilterFailed FindingKind = "FilterFailed"
+
+	// WriteDenied is raised when a principal writing outside the API, e.g.
+	// through a sensor Source, may not change a resource. The write was not
+	// applied.
+	WriteDenied FindingKind = "WriteDenied"
+
+	// SourceApplyFailed is raised when a file of an atomic sensor Source was
+	// rejected as a whole. None of its documents were applied.
+	SourceApplyFailed FindingKind = "SourceApplyFailed"
 )
 
 // findingTypeNamespace is the UUID v5 namespace used to derive stable
@@ -53,6 +86,41 @@ func TypeIDForKind(kind FindingKind) uuid.UUID {
 	return uuid.NewSHA1(findingTypeNamespace, []byte(kind))
 }
 
+// TypeStore is the part of the model [EnsureType] looks up and registers
+// FindingTypes in.
+type TypeStore interface {
+	GetFindingTypeByName(name string) FindingType
+	GetFindingTypeById(id uuid.UUID) FindingType
+	AddFindingType(findingType FindingType) error
+}
+
+// EnsureType returns the id of the FindingType for kind: a type registered
+// under the kind's name, else the one with [TypeIDForKind], which is
+// registered with the kind's description and severity when missing. The id
+// is returned even if registering fails, so findings can still reference it.
+func EnsureType(m TypeStore, kind FindingKind) (uuid.UUID, error) {
+	name := string(kind)
+	if ft := m.GetFindingTypeByName(name); ft != nil {
+		return ft.GetFindingTypeId(), nil
+	}
+
+	id := TypeIDForKind(kind)
+	if ft := m.GetFindingTypeById(id); ft != nil {
+		return id, nil
+	}
+
+	ft := NewFindingType(id)
+	ft.SetDisplayName(name)
+	if desc := DescriptionForKind(kind); desc != "" {
+		ft.SetDescription(desc)
+	}
+	ft.SetSeverity(SeverityForKind(kind))
+	if err := m.AddFindingType(ft); err != nil {
+		return id, fmt.Errorf("registering FindingType %s: %w", kind, err)
+	}
+	return id, nil
+}
+
 // DescriptionForKind returns the canonical human-readable description for a
 // well-known [FindingKind]. Unknown kinds return an empty string.
 func DescriptionForKind(kind FindingKind) string {
@@ -73,6 +141,20 @@ func DescriptionForKind(kind FindingKind) string {
 		return "A resource references another resource by UUID that is not registered in the local model."
 	case MissingResourceReference:
 		return "A resource lacks a required EmELand reference to another resource."
+	case ProductVersionDeprecated:
+		return "An ArtifactInstance runs an artefact of a product version that is deprecated."
+	case ProductVersionTerminated:
+		return "An ArtifactInstance runs an artefact of a product version that has been terminated."
+	case ProductVersionNotYetAvailable:
+		return "An ArtifactInstance runs an artefact of a product version that is not yet available."
+	case FilterRuleInvalid:
+		return "A declarative FilterRule has an expression or settings that do not compile; the rule is inactive."
+	case FilterFailed:
+		return "A filter in the event filter chain panicked; the event was passed on unchanged."
+	case WriteDenied:
+		return "A principal without write permission tried to change a resource; the change was not applied."
+	case SourceApplyFailed:
+		return "A file of an atomic sensor Source could not be applied; none of its documents were applied."
 	default:
 		return ""
 	}
@@ -0,0 +1,128 @@
+package finding
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Severity ranks how urgently a [FindingType] needs attention.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// ParseSeverity parses s case-insensitively. The empty string parses to "".
+func ParseSeverity(s string) (Severity, error) {
+	switch sev := Severity(strings.ToLower(strings.TrimSpace(s))); sev {
+	case "", SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical:
+		return sev, nil
+	default:
+		return "", fmt.Errorf("unknown severity %q", s)
+	}
+}
+
+// Rank orders severities from 0 (unset) to 5 (critical).
+func (s Severity) Rank() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityLow:
+		return 2
+	case SeverityMedium:
+		return 3
+	case SeverityHigh:
+		return 4
+	case SeverityCritical:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// SeverityForKind returns the default severity of a well-known [FindingKind].
+// Unknown kinds return [SeverityMedium].
+func SeverityForKind(kind FindingKind) Severity {
+	switch kind {
+	case CertificateExpired, ProductVersionTerminated:
+		return SeverityCritical
+	case CertificateExpiringSoon, CertificateProbeFailed, ProductVersionDeprecated, ReferencedResourceNotFound, FilterFailed, WriteDenied, SourceApplyFailed:
+		return SeverityHigh
+	case ProductVersionNotYetAvailable:
+		return SeverityLow
+	default:
+		return SeverityMedium
+	}
+}
+
+// Status is the triage state of a [Finding].
+type Status string
+
+const (
+	// StatusOpen is the state of every newly detected finding.
+	StatusOpen Status = "open"
+	// StatusAcknowledged marks a finding someone has seen and taken ownership of.
+	StatusAcknowledged Status = "acknowledged"
+	// StatusSuppressed hides a finding, optionally until [Triage.SuppressedUntil].
+	StatusSuppressed Status = "suppressed"
+	// StatusResolved marks a finding as fixed. A re-detection reopens it.
+	StatusResolved Status = "resolved"
+)
+
+// ParseStatus parses s case-insensitively. "ack" is accepted for acknowledged.
+func ParseStatus(s string) (Status, error) {
+	switch st := Status(strings.ToLower(strings.TrimSpace(s))); st {
+	case StatusOpen, StatusAcknowledged, StatusSuppressed, StatusResolved:
+		return st, nil
+	case "ack":
+		return StatusAcknowledged, nil
+	default:
+		return "", fmt.Errorf("unknown finding status %q", s)
+	}
+}
+
+// Triage records who moved a [Finding] to its current [Status] and why. A
+// finding without triage is open.
+type Triage struct {
+	Status          Status     `json:"status"`
+	Reason          string     `json:"reason,omitempty"`
+	By              string     `json:"by,omitempty"`
+	ChangedAt       time.Time  `json:"changedAt"`
+	SuppressedUntil *time.Time `json:"suppressedUntil,omitempty"`
+}
+
+// EffectiveStatus returns the status of t at now. A nil triage is open, and a
+// suppression whose SuppressedUntil has passed reads as open again.
+func (t *Triage) EffectiveStatus(now time.Time) Status {
+	if t == nil || t.Status == "" {
+		return StatusOpen
+	}
+	if t.Status == StatusSuppressed && t.SuppressedUntil != nil && !now.Before(*t.SuppressedUntil) {
+		return StatusOpen
+	}
+	return t.Status
+}
+
+// Suppresses reports whether t keeps a finding suppressed at now.
+func (t *Triage) Suppresses(now time.Time) bool {
+	return t.EffectiveStatus(now) == StatusSuppressed
+}
+
+// Active reports whether s still needs attention, i.e. is open or
+// acknowledged.
+func (s Status) Active() bool {
+	return s == StatusOpen || s == StatusAcknowledged
+}
+
+// StatusOf returns the effective status of f at now.
+func StatusOf(f Finding, now time.Time) Status {
+	if f == nil {
+		return StatusOpen
+	}
+	return f.GetTriage().EffectiveStatus(now)
+}
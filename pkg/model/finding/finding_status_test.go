@@ -0,0 +1,52 @@
+package finding_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+func TestParseSeverity(t *testing.T) {
+	sev, err := finding.ParseSeverity(" High ")
+	require.NoError(t, err)
+	assert.Equal(t, finding.SeverityHigh, sev)
+
+	_, err = finding.ParseSeverity("urgent")
+	assert.Error(t, err)
+
+	assert.Less(t, finding.SeverityLow.Rank(), finding.SeverityCritical.Rank())
+	assert.Equal(t, 0, finding.Severity("").Rank())
+}
+
+func TestParseStatus(t *testing.T) {
+	st, err := finding.ParseStatus("ack")
+	require.NoError(t, err)
+	assert.Equal(t, finding.StatusAcknowledged, st)
+
+	_, err = finding.ParseStatus("closed")
+	assert.Error(t, err)
+}
+
+func TestTriageEffectiveStatus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	var none *finding.Triage
+	assert.Equal(t, finding.StatusOpen, none.EffectiveStatus(now))
+
+	forever := &finding.Triage{Status: finding.StatusSuppressed}
+	assert.True(t, forever.Suppresses(now))
+
+	pending := &finding.Triage{Status: finding.StatusSuppressed, SuppressedUntil: &later}
+	assert.Equal(t, finding.StatusSuppressed, pending.EffectiveStatus(now))
+
+	lapsed := &finding.Triage{Status: finding.StatusSuppressed, SuppressedUntil: &earlier}
+	assert.Equal(t, finding.StatusOpen, lapsed.EffectiveStatus(now))
+
+	acked := &finding.Triage{Status: finding.StatusAcknowledged}
+	assert.Equal(t, finding.StatusAcknowledged, acked.EffectiveStatus(now))
+}
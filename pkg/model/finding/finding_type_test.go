@@ -86,3 +86,30 @@ func TestFindingTypeBasic(t *testing.T) {
 		assert.Equal(t, expectedEvent.resourceId, eventsList[i].ResourceId, "event %d: expected resource ID %v, got %v", i+1, expectedEvent.resourceId, eventsList[i].ResourceId)
 	}
 }
+
+func TestEnsureType(t *testing.T) {
+	testModel, err := model.NewModel(events.NewListSink())
+	assert.NoError(t, err)
+
+	id, err := finding.EnsureType(testModel, finding.WriteDenied)
+	assert.NoError(t, err)
+	assert.Equal(t, finding.TypeIDForKind(finding.WriteDenied), id)
+	ft := testModel.GetFindingTypeById(id)
+	if assert.NotNil(t, ft) {
+		assert.Equal(t, string(finding.WriteDenied), ft.GetDisplayName())
+		assert.Equal(t, finding.DescriptionForKind(finding.WriteDenied), ft.GetDescription())
+	}
+
+	again, err := finding.EnsureType(testModel, finding.WriteDenied)
+	assert.NoError(t, err)
+	assert.Equal(t, id, again)
+
+	// A type registered under the kind's name is reused whatever its id.
+	custom := finding.NewFindingType(uuid.New())
+	custom.SetDisplayName(string(finding.FilterFailed))
+	assert.NoError(t, testModel.AddFindingType(custom))
+	id, err = finding.EnsureType(testModel, finding.FilterFailed)
+	assert.NoError(t, err)
+	assert.Equal(t, custom.GetFindingTypeId(), id)
+	assert.Nil(t, testModel.GetFindingTypeById(finding.TypeIDForKind(finding.FilterFailed)))
+}
@@ -27,6 +27,9 @@ type FindingType interface {
 	GetDescription() string
 	SetDescription(string)
 
+	GetSeverity() Severity
+	SetSeverity(Severity)
+
 	GetAnnotations() annotations.Annotations
 	SetAnnotations(annotations.Annotations)
 
@@ -40,6 +43,7 @@ type findingtypeData struct {
 	FindingTypeId uuid.UUID
 	DisplayName   string
 	Description   string
+	Severity      Severity
 	Annotations   annotations.Annotations
 }
 
@@ -98,6 +102,20 @@ func (o *findingtypeData) SetDescription(val string) {
 	}
 }
 
+// GetSeverity implements [Severity].
+func (o *findingtypeData) GetSeverity() Severity {
+	return o.Severity
+}
+
+// SetSeverity implements [FindingType].
+func (o *findingtypeData) SetSeverity(val Severity) {
+	o.Severity = val
+
+	if o.isRegistered {
+		o.sink.Receive(events.FindingTypeResource, events.UpdateOperation, o.FindingTypeId, o)
+	}
+}
+
 // GetAnnotations implements [Annotations].
 func (o *findingtypeData) GetAnnotations() annotations.Annotations {
 	return o.Annotations
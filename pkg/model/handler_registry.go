@@ -12,6 +12,7 @@ type deleteHandler func(m Model, id uuid.UUID) error
 type notFoundCheck func(err error) bool
 type existsHandler func(m Model, id uuid.UUID) bool
 type displayNameHandler func(m Model, id uuid.UUID) string
+type getHandler func(m Model, id uuid.UUID) any
 
 type resourceHandler struct {
 	upsert      upsertHandler
@@ -19,6 +20,7 @@ type resourceHandler struct {
 	notFound    notFoundCheck
 	exists      existsHandler
 	displayName displayNameHandler
+	get         getHandler
 }
 
 var handlerRegistry = map[events.ResourceType]resourceHandler{}
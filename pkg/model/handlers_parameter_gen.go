@@ -37,5 +37,12 @@ func init() {
 			}
 			return v.GetDisplayName()
 		},
+		get: func(m Model, id uuid.UUID) any {
+			v := m.GetParameterById(id)
+			if any(v) == nil {
+				return nil
+			}
+			return v
+		},
 	})
 }
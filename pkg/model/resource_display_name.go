@@ -31,3 +31,31 @@ func ResourceDisplayName(m Model, ref *common.ResourceRef) string {
 	}
 	return h.displayName(m, ref.ResourceId)
 }
+
+// ResourceObject returns the registered resource a reference points to, or nil
+// when it is not in the model. The result is the model type of the resource
+// (e.g. system.System) and can be inspected through its accessor interfaces.
+func ResourceObject(m Model, ref *common.ResourceRef) any {
+	if m == nil || ref == nil || ref.ResourceId == uuid.Nil {
+		return nil
+	}
+	h, ok := lookupHandler(ref.ResourceType)
+	if !ok || h.get == nil {
+		return nil
+	}
+	return h.get(m, ref.ResourceId)
+}
+
+// FindResource returns a reference to the resource registered under id,
+// whatever its type, or nil when no resource has that id.
+func FindResource(m Model, id uuid.UUID) *common.ResourceRef {
+	if m == nil || id == uuid.Nil {
+		return nil
+	}
+	for rt, h := range handlerRegistry {
+		if h.exists != nil && h.exists(m, id) {
+			return &common.ResourceRef{ResourceId: id, ResourceType: rt}
+		}
+	}
+	return nil
+}
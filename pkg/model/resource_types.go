@@ -1,51 +1,64 @@
 package model
 
-// ResourceTypeInfo describes a resource type and provides a function to count its instances.
+// ResourceTypeInfo describes a resource type and provides functions to count
+// and list its instances.
 type ResourceTypeInfo struct {
 	Name  string
 	Count func() (int, error)
+	List  func() ([]any, error)
 }
 
-// ResourceTypes returns all resource types known to the model with their count functions.
+// ResourceTypes returns all resource types known to the model with their count and list functions.
 // This is the single source of truth — metrics, handlers, and other consumers should
 // derive their resource-type lists from here.
 func ResourceTypes(m Model) []ResourceTypeInfo {
 	return []ResourceTypeInfo{
-		{"Node", countFunc(m.GetNodes)},
-		{"NodeType", countFunc(m.GetNodeTypes)},
-		{"Context", countFunc(m.GetContexts)},
-		{"ContextType", countFunc(m.GetContextTypes)},
-		{"System", countFunc(m.GetSystems)},
-		{"SystemInstance", countFunc(m.GetSystemInstances)},
-		{"API", countFunc(m.GetApis)},
-		{"ApiInstance", countFunc(m.GetApiInstances)},
-		{"Component", countFunc(m.GetComponents)},
-		{"ComponentInstance", countFunc(m.GetComponentInstances)},
-		{"Finding", countFunc(m.GetFindings)},
-		{"FindingType", countFunc(m.GetFindingTypes)},
-		{"Artifact", countFunc(m.GetArtifacts)},
-		{"ArtifactInstance", countFunc(m.GetArtifactInstances)},
-		{"OrgUnit", countFunc(m.GetOrgUnits)},
-		{"Group", countFunc(m.GetGroups)},
-		{"Identity", countFunc(m.GetIdentities)},
-		{"PermissionSpec", countFunc(m.GetPermissionSpecs)},
-		{"RoleSpec", countFunc(m.GetRoleSpecs)},
-		{"Permission", countFunc(m.GetPermissions)},
-		{"Role", countFunc(m.GetRoles)},
-		{"Binding", countFunc(m.GetBindings)},
-		{"Product", countFunc(m.GetProducts)},
-		{"FilterRule", countFunc(m.GetFilterRules)},
-		{"MergeRule", countFunc(m.GetMergeRules)},
-		{"Capability", countFunc(m.GetCapabilities)},
-		{"Parameter", countFunc(m.GetParameters)},
-		{"CapacityResourceType", countFunc(m.GetCapacityResourceTypes)},
-		{"Capacity", countFunc(m.GetCapacities)},
+		typeInfo("Node", m.GetNodes),
+		typeInfo("NodeType", m.GetNodeTypes),
+		typeInfo("Context", m.GetContexts),
+		typeInfo("ContextType", m.GetContextTypes),
+		typeInfo("System", m.GetSystems),
+		typeInfo("SystemInstance", m.GetSystemInstances),
+		typeInfo("API", m.GetApis),
+		typeInfo("ApiInstance", m.GetApiInstances),
+		typeInfo("Component", m.GetComponents),
+		typeInfo("ComponentInstance", m.GetComponentInstances),
+		typeInfo("Finding", m.GetFindings),
+		typeInfo("FindingType", m.GetFindingTypes),
+		typeInfo("Artifact", m.GetArtifacts),
+		typeInfo("ArtifactInstance", m.GetArtifactInstances),
+		typeInfo("OrgUnit", m.GetOrgUnits),
+		typeInfo("Group", m.GetGroups),
+		typeInfo("Identity", m.GetIdentities),
+		typeInfo("PermissionSpec", m.GetPermissionSpecs),
+		typeInfo("RoleSpec", m.GetRoleSpecs),
+		typeInfo("Permission", m.GetPermissions),
+		typeInfo("Role", m.GetRoles),
+		typeInfo("Binding", m.GetBindings),
+		typeInfo("Product", m.GetProducts),
+		typeInfo("FilterRule", m.GetFilterRules),
+		typeInfo("MergeRule", m.GetMergeRules),
+		typeInfo("Capability", m.GetCapabilities),
+		typeInfo("Parameter", m.GetParameters),
+		typeInfo("CapacityResourceType", m.GetCapacityResourceTypes),
+		typeInfo("Capacity", m.GetCapacities),
 	}
 }
 
-func countFunc[T any](fn func() ([]T, error)) func() (int, error) {
-	return func() (int, error) {
-		items, err := fn()
-		return len(items), err
+func typeInfo[T any](name string, fn func() ([]T, error)) ResourceTypeInfo {
+	return ResourceTypeInfo{
+		Name: name,
+		Count: func() (int, error) {
+			items, err := fn()
+			return len(items), err
+		},
+		List: func() ([]any, error) {
+			items, err := fn()
+			out := make([]any, 0, len(items))
+			for _, item := range items {
+				out = append(out, item)
+			}
+			return out, err
+		},
 	}
 }
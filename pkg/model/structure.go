@@ -9,6 +9,7 @@ import (
 	"maps"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.emeland.io/modelsrv/pkg/events"
@@ -311,8 +312,11 @@ type Model interface {
 }
 
 type modelData struct {
-	mu       sync.RWMutex
-	sink     events.EventSink
+	mu   sync.RWMutex
+	sink events.EventSink
+	// emit receives the model's events; it forwards them to sink unless a
+	// [Batch] holds them back.
+	emit     *batchSink
 	handlers map[events.ResourceType]resourceHandler
 
 	nodeTypesByUUID map[uuid.UUID]node.NodeType
@@ -334,7 +338,11 @@ type modelData struct {
 	// Finding.Resources (subject and referenced-but-missing). Maintained by
 	// AddFinding / DeleteFindingById for O(candidates) resolve lookups.
 	findingsByResourceID map[uuid.UUID]map[uuid.UUID]struct{}
-	findingTypesByUUID   map[uuid.UUID]finding.FindingType
+	// findingSuppressions keeps the triage of suppressed findings after they
+	// are deleted so that a re-detection under the same id stays suppressed.
+	// Entries go when the suppression lapses or the finding's type is deleted.
+	findingSuppressions map[uuid.UUID]suppression
+	findingTypesByUUID  map[uuid.UUID]finding.FindingType
 
 	artifactsByUUID         map[uuid.UUID]artifact.Artifact
 	artifactInstancesByUUID map[uuid.UUID]artifact.ArtifactInstance
@@ -371,6 +379,7 @@ func NewModel(sink events.EventSink) (*modelData, error) {
 
 	model := &modelData{
 		sink:     sink,
+		emit:     newBatchSink(sink),
 		handlers: maps.Clone(handlerRegistry),
 
 		nodesByUUID:     make(map[uuid.UUID]node.Node),
@@ -389,6 +398,7 @@ func NewModel(sink events.EventSink) (*modelData, error) {
 
 		findingsByUUID:       make(map[uuid.UUID]finding.Finding),
 		findingsByResourceID: make(map[uuid.UUID]map[uuid.UUID]struct{}),
+		findingSuppressions:  make(map[uuid.UUID]suppression),
 		findingTypesByUUID:   make(map[uuid.UUID]finding.FindingType),
 
 		artifactsByUUID:         make(map[uuid.UUID]artifact.Artifact),
@@ -448,7 +458,7 @@ func addEventEnabled[T any](
 		if _, exists := store[id]; exists {
 			op = events.UpdateOperation
 		}
-		setRegistered(obj, m.sink)
+		setRegistered(obj, m.emit)
 		store[id] = obj
 		return op, id, nil
 	}()
@@ -457,7 +467,7 @@ func addEventEnabled[T any](
 	}
 	// Do not hold m.mu during sink.Receive: filters (e.g. phase0) call back into Model
 	// with Get* which would need RLock and deadlock on the same goroutine.
-	if err := m.sink.Receive(resourceType, op, id, obj); err != nil {
+	if err := m.emit.Receive(resourceType, op, id, obj); err != nil {
 		fmt.Println("Error receiving ", resourceType, "| ", op, " event: ", err)
 	}
 	return nil
@@ -484,7 +494,7 @@ func deleteEventEnabled[T any](
 		return err
 	}
 
-	if err := m.sink.Receive(resourceType, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(resourceType, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", resourceType, "| ", events.DeleteOperation, " event: ", err)
 	}
 	return nil
@@ -534,7 +544,7 @@ func (m *modelData) DeleteContextById(id uuid.UUID) error {
 		return err
 	}
 
-	if err := m.sink.Receive(events.ContextResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.ContextResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.ContextResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 
@@ -582,7 +592,7 @@ func (m *modelData) DeleteContextTypeById(id uuid.UUID) error {
 		return err
 	}
 
-	if err := m.sink.Receive(events.ContextTypeResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.ContextTypeResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.ContextTypeResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 
@@ -673,7 +683,7 @@ func (m *modelData) DeleteSystemById(id uuid.UUID) error {
 		return err
 	}
 
-	if err := m.sink.Receive(events.SystemResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.SystemResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.SystemResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 
@@ -832,7 +842,16 @@ func (m *modelData) GetFindings() ([]finding.Finding, error) {
 }
 
 // AddFinding implements Model.
+//
+// A finding added without triage inherits the triage of the finding it
+// replaces, unless that one was resolved (a re-detection reopens it), or the
+// retained suppression of a previously deleted finding with the same id.
 func (m *modelData) AddFinding(f finding.Finding) error {
+	if f.GetTriage() == nil {
+		if t := m.carriedTriage(f); t != nil {
+			f.SetTriage(t)
+		}
+	}
 	op, id, err := func() (events.Operation, uuid.UUID, error) {
 		m.mu.Lock()
 		defer m.mu.Unlock()
@@ -845,7 +864,7 @@ func (m *modelData) AddFinding(f finding.Finding) error {
 			op = events.UpdateOperation
 			unindexFindingLocked(m, old)
 		}
-		f.Register(m.sink)
+		f.Register(m.emit)
 		m.findingsByUUID[id] = f
 		indexFindingLocked(m, f)
 		return op, id, nil
@@ -854,7 +873,7 @@ func (m *modelData) AddFinding(f finding.Finding) error {
 		return err
 	}
 	// Do not hold m.mu during sink.Receive: filters call back into Model.
-	if err := m.sink.Receive(events.FindingResource, op, id, f); err != nil {
+	if err := m.emit.Receive(events.FindingResource, op, id, f); err != nil {
 		fmt.Println("Error receiving ", events.FindingResource, "| ", op, " event: ", err)
 	}
 	return nil
@@ -871,18 +890,59 @@ func (m *modelData) DeleteFindingById(id uuid.UUID) error {
 		}
 		unindexFindingLocked(m, old)
 		delete(m.findingsByUUID, id)
+		now := time.Now()
+		for sid, sup := range m.findingSuppressions {
+			if !sup.triage.Suppresses(now) {
+				delete(m.findingSuppressions, sid)
+			}
+		}
+		if t := old.GetTriage(); t.Suppresses(now) {
+			m.findingSuppressions[id] = suppression{triage: t, findingType: old.GetFindingTypeId()}
+		}
 		return nil
 	}()
 	if err != nil {
 		return err
 	}
 
-	if err := m.sink.Receive(events.FindingResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.FindingResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.FindingResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 	return nil
 }
 
+// carriedTriage returns the triage f inherits when it is added, or nil.
+func (m *modelData) carriedTriage(f finding.Finding) *finding.Triage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := f.GetFindingId()
+	if old, exists := m.findingsByUUID[id]; exists {
+		if old == f {
+			return nil
+		}
+		if t := old.GetTriage(); t != nil && t.Status != finding.StatusResolved {
+			return t
+		}
+		return nil
+	}
+	sup, ok := m.findingSuppressions[id]
+	if !ok {
+		return nil
+	}
+	delete(m.findingSuppressions, id)
+	if !sup.triage.Suppresses(time.Now()) {
+		return nil
+	}
+	return sup.triage
+}
+
+// suppression is the triage of a deleted, suppressed finding and its type.
+type suppression struct {
+	triage      *finding.Triage
+	findingType uuid.UUID
+}
+
 // GetFindingById implements Model.
 func (m *modelData) GetFindingById(id uuid.UUID) finding.Finding {
 	m.mu.RLock()
@@ -979,13 +1039,18 @@ func (m *modelData) DeleteFindingTypeById(id uuid.UUID) error {
 		}
 
 		delete(m.findingTypesByUUID, id)
+		for sid, sup := range m.findingSuppressions {
+			if sup.findingType == id {
+				delete(m.findingSuppressions, sid)
+			}
+		}
 		return nil
 	}()
 	if err != nil {
 		return err
 	}
 
-	if err := m.sink.Receive(events.FindingTypeResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.FindingTypeResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.FindingTypeResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 
@@ -82,7 +82,7 @@ func (m *modelData) AddCapacity(c mdlcap.Capacity) error {
 			}
 		}
 
-		c.Register(m.sink)
+		c.Register(m.emit)
 		m.capacitiesByUUID[id] = c
 		m.capacitiesByTuple[key] = id
 		return op, id, nil
@@ -91,7 +91,7 @@ func (m *modelData) AddCapacity(c mdlcap.Capacity) error {
 		return err
 	}
 
-	if err := m.sink.Receive(events.CapacityResource, op, id, c); err != nil {
+	if err := m.emit.Receive(events.CapacityResource, op, id, c); err != nil {
 		fmt.Println("Error receiving ", events.CapacityResource, "| ", op, " event: ", err)
 	}
 	return nil
@@ -115,7 +115,7 @@ func (m *modelData) DeleteCapacityById(id uuid.UUID) error {
 		return err
 	}
 
-	if err := m.sink.Receive(events.CapacityResource, events.DeleteOperation, id); err != nil {
+	if err := m.emit.Receive(events.CapacityResource, events.DeleteOperation, id); err != nil {
 		fmt.Println("Error receiving ", events.CapacityResource, "| ", events.DeleteOperation, " event: ", err)
 	}
 	return nil
@@ -2,6 +2,7 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -219,3 +220,63 @@ func TestNewModelRejectsNilSink(t *testing.T) {
 	_, err := model.NewModel(nil)
 	require.Error(t, err)
 }
+
+func TestAddFindingCarriesTriage(t *testing.T) {
+	m, _ := newStoreModel(t)
+
+	fid := uuid.New()
+	first := finding.NewFinding(fid)
+	first.SetDisplayName("detected")
+	require.NoError(t, m.AddFinding(first))
+
+	m.GetFindingById(fid).SetTriage(&finding.Triage{Status: finding.StatusAcknowledged, By: "alice", ChangedAt: time.Now()})
+
+	// Re-detection overwrites the finding without triage: acknowledgement survives.
+	redetected := finding.NewFinding(fid)
+	redetected.SetDisplayName("detected again")
+	require.NoError(t, m.AddFinding(redetected))
+	got := m.GetFindingById(fid)
+	require.NotNil(t, got.GetTriage())
+	assert.Equal(t, finding.StatusAcknowledged, got.GetTriage().Status)
+	assert.Equal(t, "alice", got.GetTriage().By)
+
+	// A resolved finding reopens on re-detection.
+	got.SetTriage(&finding.Triage{Status: finding.StatusResolved, ChangedAt: time.Now()})
+	require.NoError(t, m.AddFinding(finding.NewFinding(fid)))
+	assert.Nil(t, m.GetFindingById(fid).GetTriage())
+	assert.Equal(t, finding.StatusOpen, finding.StatusOf(m.GetFindingById(fid), time.Now()))
+}
+
+func TestDeletedFindingKeepsSuppression(t *testing.T) {
+	m, _ := newStoreModel(t)
+
+	suppressed := uuid.New()
+	acked := uuid.New()
+	for _, id := range []uuid.UUID{suppressed, acked} {
+		require.NoError(t, m.AddFinding(finding.NewFinding(id)))
+	}
+	m.GetFindingById(suppressed).SetTriage(&finding.Triage{Status: finding.StatusSuppressed, Reason: "known issue", ChangedAt: time.Now()})
+	m.GetFindingById(acked).SetTriage(&finding.Triage{Status: finding.StatusAcknowledged, ChangedAt: time.Now()})
+
+	require.NoError(t, m.DeleteFindingById(suppressed))
+	require.NoError(t, m.DeleteFindingById(acked))
+	require.NoError(t, m.AddFinding(finding.NewFinding(suppressed)))
+	require.NoError(t, m.AddFinding(finding.NewFinding(acked)))
+
+	require.NotNil(t, m.GetFindingById(suppressed).GetTriage())
+	assert.Equal(t, "known issue", m.GetFindingById(suppressed).GetTriage().Reason)
+	assert.Nil(t, m.GetFindingById(acked).GetTriage())
+}
+
+func TestDeletedFindingDropsLapsedSuppression(t *testing.T) {
+	m, _ := newStoreModel(t)
+
+	fid := uuid.New()
+	require.NoError(t, m.AddFinding(finding.NewFinding(fid)))
+	past := time.Now().Add(-time.Minute)
+	m.GetFindingById(fid).SetTriage(&finding.Triage{Status: finding.StatusSuppressed, ChangedAt: time.Now(), SuppressedUntil: &past})
+
+	require.NoError(t, m.DeleteFindingById(fid))
+	require.NoError(t, m.AddFinding(finding.NewFinding(fid)))
+	assert.Nil(t, m.GetFindingById(fid).GetTriage())
+}
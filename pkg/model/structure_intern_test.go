@@ -3,10 +3,13 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/finding"
 )
 
 // These are tests that are only possible within the package itself
@@ -38,3 +41,28 @@ func TestGetCurrentEventSequenceId(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "forty-two", seq)
 }
+
+func TestFindingSuppressionsAreDropped(t *testing.T) {
+	m, err := NewModel(events.NewDummySink())
+	require.NoError(t, err)
+
+	typeID := uuid.New()
+	require.NoError(t, m.AddFindingType(finding.NewFindingType(typeID)))
+	suppress := func(id uuid.UUID, until time.Time) {
+		f := finding.NewFinding(id)
+		f.SetFindingTypeById(typeID)
+		require.NoError(t, m.AddFinding(f))
+		m.GetFindingById(id).SetTriage(&finding.Triage{Status: finding.StatusSuppressed, ChangedAt: time.Now(), SuppressedUntil: &until})
+		require.NoError(t, m.DeleteFindingById(id))
+	}
+
+	lapsing, kept := uuid.New(), uuid.New()
+	suppress(lapsing, time.Now().Add(20*time.Millisecond))
+	time.Sleep(30 * time.Millisecond)
+	suppress(kept, time.Now().Add(time.Hour))
+	assert.NotContains(t, m.findingSuppressions, lapsing, "lapsed suppressions go on the next delete")
+	assert.Contains(t, m.findingSuppressions, kept)
+
+	require.NoError(t, m.DeleteFindingTypeById(typeID))
+	assert.Empty(t, m.findingSuppressions, "suppressions go with their finding type")
+}
@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/model/common"
+)
+
+// listPaths are the REST list endpoints of the document kinds, relative to
+// the API base URL. A single resource is read at the list path plus its id.
+var listPaths = map[events.ResourceType]string{
+	events.ContextResource:              "/landscape/contexts",
+	events.ContextTypeResource:          "/landscape/contextTypes",
+	events.NodeResource:                 "/landscape/nodes",
+	events.NodeTypeResource:             "/landscape/nodeTypes",
+	events.SystemResource:               "/landscape/systems",
+	events.SystemInstanceResource:       "/landscape/system-instances",
+	events.APIResource:                  "/landscape/apis",
+	events.APIInstanceResource:          "/landscape/api-instances",
+	events.ComponentResource:            "/landscape/components",
+	events.ComponentInstanceResource:    "/landscape/component-instances",
+	events.OrgUnitResource:              "/landscape/orgUnits",
+	events.GroupResource:                "/landscape/groups",
+	events.IdentityResource:             "/landscape/identities",
+	events.PermissionSpecResource:       "/landscape/permissionSpecs",
+	events.RoleSpecResource:             "/landscape/roleSpecs",
+	events.PermissionResource:           "/landscape/permissions",
+	events.RoleResource:                 "/landscape/roles",
+	events.BindingResource:              "/landscape/bindings",
+	events.ProductResource:              "/landscape/products",
+	events.FindingResource:              "/landscape/findings",
+	events.FindingTypeResource:          "/landscape/findingTypes",
+	events.ArtifactResource:             "/landscape/artifacts",
+	events.ArtifactInstanceResource:     "/landscape/artifactInstances",
+	events.FilterRuleResource:           "/landscape/filter-rules",
+	events.MergeRuleResource:            "/landscape/merge-rules",
+	events.CapabilityResource:           "/landscape/capabilities",
+	events.ParameterResource:            "/landscape/parameters",
+	events.CapacityResourceTypeResource: "/landscape/capacityResourceTypes",
+	events.CapacityResource:             "/landscape/capacities",
+}
+
+// ServerBaseline is a [Baseline] read from the REST API of a running server.
+// Resources the caller may not read are not listed, so references to them
+// are reported as dangling.
+type ServerBaseline struct {
+	URL    string       // API base URL, e.g. http://localhost:8082/api
+	Client *http.Client // nil uses http.DefaultClient
+	Token  string       // sent as a Bearer token when set
+	Tenant string       // sent as X-Tenant when set
+}
+
+var _ Baseline = ServerBaseline{}
+
+// List implements [Baseline].
+func (s ServerBaseline) List(rt events.ResourceType) ([]common.InstanceListItem, error) {
+	path, ok := listPaths[rt]
+	if !ok {
+		return nil, fmt.Errorf("no list endpoint for %s", rt.WireKind())
+	}
+	body, err := s.get(path)
+	if err != nil {
+		return nil, err
+	}
+	// List views name their id field after the resource.
+	var raw []map[string]any
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	items := make([]common.InstanceListItem, 0, len(raw))
+	for _, r := range raw {
+		var item common.InstanceListItem
+		for _, key := range []string{"instanceId", "findingId", "findingTypeId", "nodeId", "id"} {
+			if v, ok := r[key].(string); ok {
+				item.Id, _ = uuid.Parse(v)
+				break
+			}
+		}
+		if item.Id == uuid.Nil {
+			continue
+		}
+		item.Name, _ = r["displayName"].(string)
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Get implements [Baseline].
+func (s ServerBaseline) Get(rt events.ResourceType, id uuid.UUID) (map[string]any, error) {
+	path, ok := listPaths[rt]
+	if !ok {
+		return nil, fmt.Errorf("no endpoint for %s", rt.WireKind())
+	}
+	body, err := s.get(path + "/" + id.String())
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	return out, nil
+}
+
+var errNotFound = errors.New("not found")
+
+// get reads path below the API base URL; a 404 yields errNotFound.
+func (s ServerBaseline) get(path string) ([]byte, error) {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(s.URL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+	if s.Tenant != "" {
+		req.Header.Set("X-Tenant", s.Tenant)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected HTTP 200 but received %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
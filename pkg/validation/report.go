@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// WriteText writes the report for people: one section per file, then the
+// findings no file owns and the deletes.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, f := range r.Files {
+		status := "ok"
+		if len(f.Problems) > 0 {
+			status = fmt.Sprintf("%d problem(s)", len(f.Problems))
+		}
+		name := f.Name
+		if f.Source != "" {
+			name = f.Source + " " + f.Name
+		}
+		fmt.Fprintf(tw, "%s: %d document(s), %s\n", name, f.Documents, status)
+		for _, p := range f.Problems {
+			where := "file"
+			if p.Document >= 0 {
+				where = fmt.Sprintf("document %d", p.Document)
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", p.Kind, where, p.Message)
+		}
+		for _, fd := range f.Findings {
+			fmt.Fprintf(tw, "  finding\t%s\t%s\n", fd.Kind, fd.Description)
+		}
+		for _, c := range f.Changes {
+			fmt.Fprintf(tw, "  %s\t%s %s\t%s\n", c.Op, c.Kind, c.Id, c.Name)
+		}
+	}
+	if len(r.Findings) > 0 {
+		fmt.Fprintln(tw, "other findings:")
+		for _, fd := range r.Findings {
+			fmt.Fprintf(tw, "  finding\t%s\t%s\n", fd.Kind, fd.Description)
+		}
+	}
+	if len(r.Deletes) > 0 {
+		fmt.Fprintln(tw, "pruned:")
+		for _, c := range r.Deletes {
+			fmt.Fprintf(tw, "  %s\t%s %s\t%s\n", c.Op, c.Kind, c.Id, c.Name)
+		}
+	}
+	fmt.Fprintf(tw, "%d file(s), %d problem(s)\n", len(r.Files), r.Problems())
+	return tw.Flush()
+}
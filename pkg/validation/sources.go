@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.emeland.io/modelsrv/pkg/filesensor"
+)
+
+// ReadSources reads every file the sources list, with the parser options the
+// sensor would use: source by source, files sorted by name.
+func ReadSources(ctx context.Context, sources []filesensor.OpenSource) ([]File, error) {
+	var files []File
+	for _, s := range sources {
+		parser := s.Parser
+		if parser == nil {
+			parser = filesensor.StaticParserConfig{}
+		}
+		metas, err := s.Source.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: listing files: %w", s.Config.URI, err)
+		}
+		sort.Slice(metas, func(i, j int) bool { return metas[i].Name < metas[j].Name })
+		for _, meta := range metas {
+			opts, err := parser.OptionsFor(meta.Name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", s.Config.URI, meta.Name, err)
+			}
+			if opts.ContentType == "" {
+				opts.ContentType = meta.ContentType
+			}
+			data, err := s.Source.Read(ctx, meta.Name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: reading %s: %w", s.Config.URI, meta.Name, err)
+			}
+			files = append(files, File{Name: meta.Name, Source: s.Config.URI, Data: data, Options: opts, Prune: s.Config.Prune})
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,435 @@
+// Package validation dry-runs landscape files. It parses them with [ingress],
+// applies them to a scratch [backend.Backend] whose filter chain raises the
+// usual findings, and reports per file what applying the files would do,
+// optionally compared with the landscape of a running server. Nothing is
+// written to the server.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/model/finding"
+)
+
+// File is one file to validate.
+type File struct {
+	Name    string
+	Source  string // URI of the Source the file was read from, if any
+	Data    []byte
+	Options ingress.ParseOptions
+	// Prune is set when the Source deletes the resources its files no longer
+	// define (prune: true).
+	Prune bool
+}
+
+// Baseline is the landscape the files are compared against, usually that of
+// a running server (see [ServerBaseline]).
+type Baseline interface {
+	// List returns the resources of type rt.
+	List(rt events.ResourceType) ([]common.InstanceListItem, error)
+	// Get returns the OpenAPI wire form of resource id of type rt, or nil
+	// when the Baseline does not hold it.
+	Get(rt events.ResourceType, id uuid.UUID) (map[string]any, error)
+}
+
+// Options tune [Run].
+type Options struct {
+	// Baseline, when set, turns the report's Changes into creates, updates
+	// and no-ops against it, reports what the Sources of files with Prune
+	// would delete, and spares references to resources it holds from being
+	// reported as dangling.
+	Baseline Baseline
+}
+
+// ProblemKind classifies a [Problem].
+type ProblemKind string
+
+const (
+	// ParseError: the file could not be decoded; none of its documents apply.
+	ParseError ProblemKind = "ParseError"
+	// UnknownKind: the file names a kind that is not a landscape document kind.
+	UnknownKind ProblemKind = "UnknownKind"
+	// InvalidDocument: the document decoded but could not be applied.
+	InvalidDocument ProblemKind = "InvalidDocument"
+	// DanglingReference: the document refers to a resource neither the files
+	// nor the Baseline define.
+	DanglingReference ProblemKind = "DanglingReference"
+)
+
+// Problem is a mistake in a file that keeps it from being applied as written.
+type Problem struct {
+	Kind ProblemKind `json:"kind"`
+	// Document is the 0-based index of the document within the file, -1 when
+	// the problem concerns the whole file.
+	Document int    `json:"document"`
+	Message  string `json:"message"`
+}
+
+// Finding is a finding applying the files would raise.
+type Finding struct {
+	Kind        string `json:"kind"`
+	Severity    string `json:"severity,omitempty"`
+	Description string `json:"description"`
+	// Resources names the resources of the finding as "<kind>/<id>".
+	Resources []string `json:"resources,omitempty"`
+}
+
+// ChangeOp is what applying the files does to a resource of the Baseline.
+type ChangeOp string
+
+const (
+	Create    ChangeOp = "create"
+	Update    ChangeOp = "update"
+	Unchanged ChangeOp = "unchanged"
+	Delete    ChangeOp = "delete"
+)
+
+// Change is one resource applying the files would create, update, leave as
+// it is or delete.
+type Change struct {
+	Op   ChangeOp  `json:"op"`
+	Kind string    `json:"kind"`
+	Id   uuid.UUID `json:"id"`
+	Name string    `json:"name,omitempty"`
+}
+
+// FileReport is the outcome of validating one [File].
+type FileReport struct {
+	Name      string    `json:"name"`
+	Source    string    `json:"source,omitempty"`
+	Documents int       `json:"documents"`
+	Problems  []Problem `json:"problems,omitempty"`
+	Findings  []Finding `json:"findings,omitempty"`
+	Changes   []Change  `json:"changes,omitempty"`
+}
+
+// Report is the outcome of [Run].
+type Report struct {
+	Files []FileReport `json:"files"`
+	// Findings lists the findings raised on resources no file defines.
+	Findings []Finding `json:"findings,omitempty"`
+	// Deletes lists the Baseline resources pruning would delete: those whose
+	// provenance annotations name a Source of a [File] with Prune, that no
+	// file defines any more. Resources applied before the Source recorded
+	// provenance, and kinds no file defines, are not considered.
+	Deletes []Change `json:"deletes,omitempty"`
+}
+
+// Problems returns the number of problems over all files.
+func (r *Report) Problems() int {
+	n := 0
+	for _, f := range r.Files {
+		n += len(f.Problems)
+	}
+	return n
+}
+
+// danglingKinds are the finding kinds whose second resource is a referenced
+// resource that does not exist.
+var danglingKinds = map[finding.FindingKind]bool{
+	finding.ContextTypeMissing:         true,
+	finding.ContextParentNotFound:      true,
+	finding.NodeTypeMissing:            true,
+	finding.ReferencedResourceNotFound: true,
+}
+
+// Run validates files in order, as a Source applying them one after another
+// would, and reports the outcome. The error is reserved for failures of the
+// scratch backend or the Baseline; mistakes in the files are reported.
+func Run(files []File, opts Options) (*Report, error) {
+	b, err := backend.New()
+	if err != nil {
+		return nil, err
+	}
+	m := b.GetModel()
+	v := &validator{
+		m:        m,
+		opts:     opts,
+		owner:    map[common.ResourceRef]docPos{},
+		baseline: map[events.ResourceType]map[uuid.UUID]string{},
+		wire:     map[common.ResourceRef]map[string]any{},
+		pruning:  map[string]bool{},
+	}
+
+	report := &Report{Files: make([]FileReport, len(files))}
+	for i, f := range files {
+		report.Files[i] = v.apply(i, f)
+	}
+	if err := v.collectFindings(report); err != nil {
+		return nil, err
+	}
+	if opts.Baseline != nil {
+		if err := v.collectChanges(report); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// docPos locates a document: file index and document index within the file.
+type docPos struct{ file, doc int }
+
+type validator struct {
+	m    model.Model
+	opts Options
+	// owner maps each resource the files define to the last document
+	// defining it; defined lists them in definition order.
+	owner   map[common.ResourceRef]docPos
+	defined []common.ResourceRef
+	// baseline caches the Baseline by resource type: id -> display name.
+	baseline map[events.ResourceType]map[uuid.UUID]string
+	// wire caches Baseline resources fetched with Get.
+	wire map[common.ResourceRef]map[string]any
+	// pruning holds the URIs of the Sources of files with Prune.
+	pruning map[string]bool
+}
+
+func (v *validator) apply(index int, f File) FileReport {
+	out := FileReport{Name: f.Name, Source: f.Source}
+	if f.Prune && f.Source != "" {
+		v.pruning[f.Source] = true
+	}
+	docs, err := ingress.Parse(f.Name, f.Data, f.Options)
+	if err != nil {
+		kind := ParseError
+		if errors.Is(err, ingress.ErrUnsupportedKind) {
+			kind = UnknownKind
+		}
+		out.Problems = append(out.Problems, Problem{Kind: kind, Document: -1, Message: err.Error()})
+		return out
+	}
+	out.Documents = len(docs)
+	// Names resolve over the whole file before its documents apply one by one.
+	docs, unresolved := ingress.ResolveNames(docs, v.m)
+	skip := map[int]bool{}
+	for _, docErr := range unresolved {
+		skip[docErr.Index] = true
+		out.Problems = append(out.Problems, Problem{Kind: InvalidDocument, Document: docErr.Index, Message: docErr.Err.Error()})
+	}
+	for i, doc := range docs {
+		if skip[i] {
+			continue
+		}
+		res := ingress.ApplyAll([]ingress.Document{doc}, v.m)
+		for _, docErr := range res.Failed {
+			out.Problems = append(out.Problems, Problem{Kind: InvalidDocument, Document: i, Message: docErr.Err.Error()})
+		}
+		for _, ref := range res.Resources {
+			if _, ok := v.owner[ref]; !ok {
+				v.defined = append(v.defined, ref)
+			}
+			v.owner[ref] = docPos{file: index, doc: i}
+		}
+	}
+	return out
+}
+
+// collectFindings attributes the findings the scratch backend raised to the
+// file defining their subject. Findings defined by the files themselves are
+// resources, not results.
+func (v *validator) collectFindings(report *Report) error {
+	findings, err := v.m.GetFindings()
+	if err != nil {
+		return err
+	}
+	sort.Slice(findings, func(i, j int) bool { return findings[i].GetDescription() < findings[j].GetDescription() })
+	for _, f := range findings {
+		if _, ok := v.owner[common.ResourceRef{ResourceId: f.GetFindingId(), ResourceType: events.FindingResource}]; ok {
+			continue
+		}
+		out := Finding{Description: f.GetDescription()}
+		if ft := v.m.GetFindingTypeById(f.GetFindingTypeId()); ft != nil {
+			out.Kind = ft.GetDisplayName()
+			out.Severity = string(ft.GetSeverity())
+		}
+		var refs []common.ResourceRef
+		for _, ref := range f.GetResources() {
+			if ref != nil {
+				refs = append(refs, *ref)
+				out.Resources = append(out.Resources, ref.ResourceType.WireKind()+"/"+ref.ResourceId.String())
+			}
+		}
+		pos := docPos{file: -1, doc: -1}
+		if len(refs) > 0 {
+			if p, ok := v.owner[refs[0]]; ok {
+				pos = p
+			}
+		}
+
+		if danglingKinds[finding.FindingKind(out.Kind)] && len(refs) > 1 {
+			known, err := v.inBaseline(refs[1])
+			if err != nil {
+				return err
+			}
+			if known {
+				continue // the server has it
+			}
+			if pos.file >= 0 {
+				fr := &report.Files[pos.file]
+				fr.Problems = append(fr.Problems, Problem{Kind: DanglingReference, Document: pos.doc, Message: out.Description})
+				continue
+			}
+		}
+		if pos.file >= 0 {
+			report.Files[pos.file].Findings = append(report.Files[pos.file].Findings, out)
+		} else {
+			report.Findings = append(report.Findings, out)
+		}
+	}
+	return nil
+}
+
+// collectChanges compares the defined resources with the Baseline and
+// collects what pruning would delete.
+func (v *validator) collectChanges(report *Report) error {
+	kinds := map[events.ResourceType]bool{}
+	for _, ref := range v.defined {
+		kinds[ref.ResourceType] = true
+		op, err := v.changeOp(ref)
+		if err != nil {
+			return err
+		}
+		fr := &report.Files[v.owner[ref].file]
+		fr.Changes = append(fr.Changes, Change{
+			Op:   op,
+			Kind: ref.ResourceType.WireKind(),
+			Id:   ref.ResourceId,
+			Name: model.ResourceDisplayName(v.m, &ref),
+		})
+	}
+	if len(v.pruning) == 0 {
+		return nil
+	}
+	for rt := range kinds {
+		for id, name := range v.baseline[rt] {
+			ref := common.ResourceRef{ResourceId: id, ResourceType: rt}
+			if _, defined := v.owner[ref]; defined {
+				continue
+			}
+			origin, err := v.origin(ref)
+			if err != nil {
+				return err
+			}
+			if v.pruning[origin] {
+				report.Deletes = append(report.Deletes, Change{Op: Delete, Kind: rt.WireKind(), Id: id, Name: name})
+			}
+		}
+	}
+	sort.Slice(report.Deletes, func(i, j int) bool {
+		a, b := report.Deletes[i], report.Deletes[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Id.String() < b.Id.String()
+	})
+	return nil
+}
+
+// changeOp tells whether applying the files creates ref, updates it or
+// leaves it as the Baseline holds it.
+func (v *validator) changeOp(ref common.ResourceRef) (ChangeOp, error) {
+	known, err := v.inBaseline(ref)
+	if err != nil || !known {
+		return Create, err
+	}
+	current, err := v.get(ref)
+	if err != nil {
+		return "", err
+	}
+	next, err := oapi.ResourceWireMap(ref.ResourceType, model.ResourceObject(v.m, &ref))
+	if err != nil || current == nil {
+		return Update, nil
+	}
+	if reflect.DeepEqual(comparableWire(current), comparableWire(next)) {
+		return Unchanged, nil
+	}
+	return Update, nil
+}
+
+// origin returns the Source URI recorded in the provenance of the Baseline
+// resource ref, or "" when it has none.
+func (v *validator) origin(ref common.ResourceRef) (string, error) {
+	wire, err := v.get(ref)
+	if err != nil {
+		return "", err
+	}
+	return wireAnnotations(wire)[filesensor.AnnotationOriginURI], nil
+}
+
+// get fetches ref from the Baseline once.
+func (v *validator) get(ref common.ResourceRef) (map[string]any, error) {
+	if wire, ok := v.wire[ref]; ok {
+		return wire, nil
+	}
+	wire, err := v.opts.Baseline.Get(ref.ResourceType, ref.ResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s %s: %w", ref.ResourceType.WireKind(), ref.ResourceId, err)
+	}
+	v.wire[ref] = wire
+	return wire, nil
+}
+
+// comparableWire returns wire with its annotations as a map and without the
+// provenance annotations, which change with every apply.
+func comparableWire(wire map[string]any) map[string]any {
+	out := make(map[string]any, len(wire))
+	for k, val := range wire {
+		out[k] = val
+	}
+	delete(out, "annotations")
+	ann := wireAnnotations(wire)
+	for _, key := range []string{filesensor.AnnotationOriginURI, filesensor.AnnotationOriginFile, filesensor.AnnotationOriginRevision} {
+		delete(ann, key)
+	}
+	if len(ann) > 0 {
+		out["annotations"] = ann
+	}
+	return out
+}
+
+// wireAnnotations decodes the annotations list of a wire resource.
+func wireAnnotations(wire map[string]any) map[string]string {
+	out := map[string]string{}
+	list, _ := wire["annotations"].([]any)
+	for _, item := range list {
+		a, _ := item.(map[string]any)
+		key, _ := a["key"].(string)
+		value, _ := a["value"].(string)
+		if key != "" {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// inBaseline reports whether the Baseline holds ref; false without one.
+func (v *validator) inBaseline(ref common.ResourceRef) (bool, error) {
+	if v.opts.Baseline == nil {
+		return false, nil
+	}
+	ids, ok := v.baseline[ref.ResourceType]
+	if !ok {
+		items, err := v.opts.Baseline.List(ref.ResourceType)
+		if err != nil {
+			return false, fmt.Errorf("listing %s: %w", ref.ResourceType.WireKind(), err)
+		}
+		ids = make(map[uuid.UUID]string, len(items))
+		for _, item := range items {
+			ids[item.Id] = item.Name
+		}
+		v.baseline[ref.ResourceType] = ids
+	}
+	_, known := ids[ref.ResourceId]
+	return known, nil
+}
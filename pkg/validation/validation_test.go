@@ -0,0 +1,212 @@
+package validation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.emeland.io/modelsrv/internal/oapi"
+	"go.emeland.io/modelsrv/pkg/backend"
+	"go.emeland.io/modelsrv/pkg/events"
+	"go.emeland.io/modelsrv/pkg/filesensor"
+	"go.emeland.io/modelsrv/pkg/ingress"
+	"go.emeland.io/modelsrv/pkg/model"
+	"go.emeland.io/modelsrv/pkg/model/common"
+	"go.emeland.io/modelsrv/pkg/validation"
+)
+
+const (
+	prodID    = "22222222-2222-2222-2222-222222222222"
+	stagingID = "33333333-3333-3333-3333-333333333333"
+	typeID    = "44444444-4444-4444-4444-444444444444"
+	parentID  = "55555555-5555-5555-5555-555555555555"
+	untypedID = "66666666-6666-6666-6666-666666666666"
+)
+
+func contextDoc(id, name, extra string) string {
+	return "---\nversion: emeland.io/v1\nkind: Context\nspec:\n  contextId: \"" + id + "\"\n  displayName: " + name + "\n" + extra
+}
+
+func contextTypeDoc(id string) string {
+	return "---\nversion: emeland.io/v1\nkind: ContextType\nspec:\n  contextTypeId: \"" + id + "\"\n  displayName: Environment\n"
+}
+
+// mapBaseline is a Baseline of fixed resources.
+type mapBaseline struct {
+	items map[events.ResourceType][]common.InstanceListItem
+	wire  map[uuid.UUID]map[string]any
+}
+
+func (b mapBaseline) List(rt events.ResourceType) ([]common.InstanceListItem, error) {
+	return b.items[rt], nil
+}
+
+func (b mapBaseline) Get(_ events.ResourceType, id uuid.UUID) (map[string]any, error) {
+	return b.wire[id], nil
+}
+
+// wireOf applies data to a fresh backend and returns the wire form of
+// Context id, as a server that applied data would serve it, plus annotations.
+func wireOf(data, id string, annotations map[string]string) map[string]any {
+	b, err := backend.New()
+	Expect(err).NotTo(HaveOccurred())
+	docs, err := ingress.Parse("wire.yaml", []byte(data), ingress.ParseOptions{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(ingress.ApplyAll(docs, b.GetModel()).Failed).To(BeEmpty())
+	ref := common.ResourceRef{ResourceId: uuid.MustParse(id), ResourceType: events.ContextResource}
+	wire, err := oapi.ResourceWireMap(ref.ResourceType, model.ResourceObject(b.GetModel(), &ref))
+	Expect(err).NotTo(HaveOccurred())
+	list, _ := wire["annotations"].([]any)
+	for k, v := range annotations {
+		list = append(list, map[string]any{"key": k, "value": v})
+	}
+	if len(list) > 0 {
+		wire["annotations"] = list
+	}
+	return wire
+}
+
+var _ = Describe("Run", func() {
+	It("reports parse errors, unknown kinds and invalid documents per file", func() {
+		report, err := validation.Run([]validation.File{
+			{Name: "broken.yaml", Data: []byte("version: [unclosed\n")},
+			{Name: "unknown.yaml", Data: []byte("version: emeland.io/v1\nkind: Spaceship\nspec: {}\n")},
+			{Name: "invalid.yaml", Data: []byte(contextTypeDoc(typeID) + "---\nversion: emeland.io/v1\nkind: Context\nspec:\n  description: No id or name\n")},
+		}, validation.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Files).To(HaveLen(3))
+		Expect(report.Files[0].Problems).To(ConsistOf(HaveField("Kind", validation.ParseError)))
+		Expect(report.Files[1].Problems).To(ConsistOf(HaveField("Kind", validation.UnknownKind)))
+		Expect(report.Files[2].Documents).To(Equal(2))
+		Expect(report.Files[2].Problems).To(ConsistOf(And(
+			HaveField("Kind", validation.InvalidDocument),
+			HaveField("Document", 1),
+		)))
+		Expect(report.Problems()).To(Equal(3))
+	})
+
+	It("reports dangling references and the findings the filters would raise", func() {
+		report, err := validation.Run([]validation.File{
+			{Name: "types.yaml", Data: []byte(contextTypeDoc(typeID))},
+			{Name: "contexts.yaml", Data: []byte(
+				contextDoc(prodID, "Production", "  type: \""+typeID+"\"\n") +
+					contextDoc(stagingID, "Staging", "  type: \""+typeID+"\"\n  parent: \""+parentID+"\"\n") +
+					contextDoc(untypedID, "Untyped", ""),
+			)},
+		}, validation.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Files[0].Problems).To(BeEmpty())
+		contexts := report.Files[1]
+		Expect(contexts.Problems).To(ConsistOf(And(
+			HaveField("Kind", validation.DanglingReference),
+			HaveField("Document", 1),
+			HaveField("Message", ContainSubstring(parentID)),
+		)))
+		Expect(contexts.Findings).To(ConsistOf(And(
+			HaveField("Kind", "ContextTypeMissing"),
+			HaveField("Resources", []string{"Context/" + untypedID}),
+		)))
+	})
+
+	It("compares the files with a baseline", func() {
+		const origin = "git+https://example.com/landscape.git"
+		const otherID = "77777777-7777-7777-7777-777777777777"
+		prod := contextDoc(prodID, "Production", "  type: \""+typeID+"\"\n")
+		staging := contextDoc(stagingID, "Staging", "  type: \""+typeID+"\"\n  parent: \""+parentID+"\"\n")
+		provenance := map[string]string{filesensor.AnnotationOriginURI: origin, filesensor.AnnotationOriginFile: "old.yaml"}
+		baseline := mapBaseline{
+			items: map[events.ResourceType][]common.InstanceListItem{
+				events.ContextResource: {
+					{Id: uuid.MustParse(prodID), Name: "Production"},
+					{Id: uuid.MustParse(parentID), Name: "Org"},
+					{Id: uuid.MustParse(untypedID), Name: "Retired"},
+					{Id: uuid.MustParse(otherID), Name: "Elsewhere"},
+				},
+				events.ContextTypeResource: {{Id: uuid.MustParse(typeID), Name: "Environment"}},
+			},
+			wire: map[uuid.UUID]map[string]any{
+				// Same content; provenance annotations do not count as a change.
+				uuid.MustParse(prodID): wireOf(contextTypeDoc(typeID)+prod, prodID, provenance),
+				// Defined by no file, but applied by another Source or by hand.
+				uuid.MustParse(parentID): wireOf(contextDoc(parentID, "Org", ""), parentID, nil),
+				uuid.MustParse(otherID):  wireOf(contextDoc(otherID, "Elsewhere", ""), otherID, map[string]string{filesensor.AnnotationOriginURI: "file:///elsewhere"}),
+				// Applied from the pruning Source, by a file since removed.
+				uuid.MustParse(untypedID): wireOf(contextDoc(untypedID, "Retired", ""), untypedID, provenance),
+			},
+		}
+		files := []validation.File{{Name: "contexts.yaml", Source: origin, Data: []byte(prod + staging)}}
+
+		report, err := validation.Run(files, validation.Options{Baseline: baseline})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Files[0].Problems).To(BeEmpty(), "references to baseline resources are not dangling")
+		Expect(report.Files[0].Changes).To(Equal([]validation.Change{
+			{Op: validation.Unchanged, Kind: "Context", Id: uuid.MustParse(prodID), Name: "Production"},
+			{Op: validation.Create, Kind: "Context", Id: uuid.MustParse(stagingID), Name: "Staging"},
+		}))
+		Expect(report.Deletes).To(BeEmpty(), "the Source does not prune")
+
+		files[0].Prune = true
+		report, err = validation.Run(files, validation.Options{Baseline: baseline})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Deletes).To(Equal([]validation.Change{
+			{Op: validation.Delete, Kind: "Context", Id: uuid.MustParse(untypedID), Name: "Retired"},
+		}))
+
+		var text bytes.Buffer
+		Expect(report.WriteText(&text)).To(Succeed())
+		Expect(text.String()).To(ContainSubstring("contexts.yaml: 2 document(s), ok"))
+		Expect(text.String()).To(ContainSubstring("unchanged  Context " + prodID))
+		Expect(text.String()).To(ContainSubstring("delete  Context " + untypedID))
+	})
+
+	It("reports a changed resource as an update", func() {
+		baseline := mapBaseline{
+			items: map[events.ResourceType][]common.InstanceListItem{
+				events.ContextResource: {{Id: uuid.MustParse(prodID), Name: "Prod"}},
+			},
+			wire: map[uuid.UUID]map[string]any{
+				uuid.MustParse(prodID): wireOf(contextDoc(prodID, "Prod", ""), prodID, nil),
+			},
+		}
+		report, err := validation.Run([]validation.File{{Name: "prod.yaml", Data: []byte(contextDoc(prodID, "Production", ""))}}, validation.Options{Baseline: baseline})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Files[0].Changes).To(ConsistOf(HaveField("Op", validation.Update)))
+	})
+})
+
+var _ = Describe("ServerBaseline", func() {
+	It("lists resources through the REST API", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/landscape/contexts"))
+			_ = json.NewEncoder(w).Encode([]map[string]string{{"instanceId": prodID, "displayName": "Production"}})
+		}))
+		defer srv.Close()
+
+		items, err := validation.ServerBaseline{URL: srv.URL + "/api"}.List(events.ContextResource)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(Equal([]common.InstanceListItem{{Id: uuid.MustParse(prodID), Name: "Production"}}))
+	})
+
+	It("reads single resources and reports missing ones as nil", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/landscape/contexts/"+prodID {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"contextId": prodID, "displayName": "Production"})
+		}))
+		defer srv.Close()
+
+		b := validation.ServerBaseline{URL: srv.URL + "/api"}
+		wire, err := b.Get(events.ContextResource, uuid.MustParse(prodID))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wire).To(HaveKeyWithValue("displayName", "Production"))
+		wire, err = b.Get(events.ContextResource, uuid.MustParse(stagingID))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wire).To(BeNil())
+	})
+})
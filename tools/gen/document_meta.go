@@ -0,0 +1,313 @@
+package main
+
+// DocField is one key of the spec of a landscape document (pkg/ingress).
+type DocField struct {
+	Name string
+	// Type is a JSON Schema type ("string", "boolean") or one of the shared
+	// definitions of document_schema.go ("uuid", "ref", "annotations", …).
+	Type        string
+	Description string
+	Enum        []string
+}
+
+// DocSpec describes the spec keys the ingress apply function of one document
+// kind reads. It drives the JSON Schema of the kind and the key list strict
+// parsing checks against.
+type DocSpec struct {
+	Kind           string // document kind, the events.ResourceType string
+	EventsResource string // events.ResourceType const name
+	Fields         []DocField
+	// Required lists groups of alternative keys; each group needs one of its
+	// keys set.
+	Required [][]string
+}
+
+// Fields every named document kind shares.
+var (
+	docDisplayName = DocField{Name: "displayName", Type: "string", Description: "Display name; a name reference matches it."}
+	docName        = DocField{Name: "name", Type: "string", Description: "Alternative to displayName."}
+	docDescription = DocField{Name: "description", Type: "string"}
+	docAnnotations = DocField{Name: "annotations", Type: "annotations"}
+	docVersion     = DocField{Name: "version", Type: "version"}
+	requireName    = []string{"displayName", "name"}
+)
+
+func docID(name string) DocField {
+	return DocField{Name: name, Type: "uuid", Description: "Id of the resource; generated from kind, name and parent when omitted."}
+}
+
+func docRef(name, target string) DocField {
+	return DocField{Name: name, Type: "ref", Description: "Reference to a " + target + ": UUID, name, alias or {name: ...}."}
+}
+
+var documentSpecs = []DocSpec{
+	{
+		Kind: "ContextType", EventsResource: "ContextTypeResource",
+		Fields:   []DocField{docID("contextTypeId"), docDisplayName, docName, docDescription, docAnnotations},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Context", EventsResource: "ContextResource",
+		Fields: []DocField{
+			docID("contextId"), docDisplayName, docName, docDescription,
+			{Name: "parent", Type: "ref", Description: "Parent Context: UUID, name, path (a/b), alias or {name: ...}."},
+			docRef("type", "ContextType"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "NodeType", EventsResource: "NodeTypeResource",
+		Fields:   []DocField{docID("nodeTypeId"), docDisplayName, docName, docDescription, docAnnotations},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Node", EventsResource: "NodeResource",
+		Fields: []DocField{
+			docID("nodeId"), docDisplayName, docName, docDescription,
+			docRef("nodeTypeId", "NodeType"), docRef("nodeType", "NodeType"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "System", EventsResource: "SystemResource",
+		Fields: []DocField{
+			docID("systemId"), docDisplayName, docName, docDescription,
+			{Name: "abstract", Type: "boolean"},
+			docVersion,
+			docRef("parent", "System"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "API", EventsResource: "APIResource",
+		Fields: []DocField{
+			docID("apiId"), docDisplayName, docName, docDescription,
+			docRef("system", "System"), docRef("systemId", "System"),
+			{Name: "type", Type: "string", Description: "API type, case-insensitive: OpenAPI (default), GraphQL, GRPC, Other or Unknown."},
+			docVersion,
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"system", "systemId"}},
+	},
+	{
+		Kind: "Component", EventsResource: "ComponentResource",
+		Fields: []DocField{
+			docID("componentId"), docDisplayName, docName, docDescription,
+			docVersion,
+			docRef("system", "System"), docRef("systemId", "System"),
+			{Name: "consumes", Type: "apiRefs", Description: "APIs the component consumes."},
+			{Name: "provides", Type: "apiRefs", Description: "APIs the component provides."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"system", "systemId"}},
+	},
+	{
+		Kind: "SystemInstance", EventsResource: "SystemInstanceResource",
+		Fields: []DocField{
+			docID("instanceId"), docID("systemInstanceId"), docDisplayName, docName,
+			docRef("system", "System"), docRef("systemId", "System"),
+			docRef("context", "Context"), docRef("contextId", "Context"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"system", "systemId"}},
+	},
+	{
+		Kind: "APIInstance", EventsResource: "APIInstanceResource",
+		Fields: []DocField{
+			docID("instanceId"), docID("apiInstanceId"), docDisplayName, docName,
+			docRef("api", "API"), docRef("apiId", "API"),
+			docRef("systemInstance", "SystemInstance"), docRef("systemInstanceId", "SystemInstance"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"api", "apiId"}},
+	},
+	{
+		Kind: "ComponentInstance", EventsResource: "ComponentInstanceResource",
+		Fields: []DocField{
+			docID("instanceId"), docID("componentInstanceId"), docDisplayName, docName,
+			docRef("component", "Component"), docRef("componentId", "Component"),
+			docRef("systemInstance", "SystemInstance"), docRef("systemInstanceId", "SystemInstance"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"component", "componentId"}},
+	},
+	{
+		Kind: "Finding", EventsResource: "FindingResource",
+		Fields: []DocField{
+			docID("findingId"), docDisplayName,
+			{Name: "summary", Type: "string", Description: "Alternative to displayName."},
+			docName, docDescription,
+			{Name: "resources", Type: "resourceRefs", Description: "Resources the finding is about."},
+			docAnnotations,
+		},
+		Required: [][]string{{"displayName", "summary", "name"}},
+	},
+	{
+		Kind: "FindingType", EventsResource: "FindingTypeResource",
+		Fields: []DocField{
+			docID("findingTypeId"), docDisplayName, docName, docDescription,
+			{Name: "severity", Type: "string", Description: "Case-insensitive: info, low, medium, high or critical."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Artifact", EventsResource: "ArtifactResource",
+		Fields: []DocField{
+			docID("artifactId"), docDisplayName, docName, docDescription,
+			{Name: "hash", Type: "string"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "ArtifactInstance", EventsResource: "ArtifactInstanceResource",
+		Fields: []DocField{
+			docID("artifactInstanceId"), docDisplayName, docName, docDescription,
+			docRef("artifact", "Artifact"),
+			{Name: "componentInstance", Type: "ref", Description: "ComponentInstance running the artifact: UUID, name, alias or {name: ...}; kept as the emeland.io/artifact.component-instance annotation."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Product", EventsResource: "ProductResource",
+		Fields: []DocField{
+			docID("productId"), docDisplayName, docName, docDescription,
+			docRef("vendor", "OrgUnit"),
+			{Name: "versions", Type: "productVersions"},
+			{Name: "mergeVersions", Type: "boolean", Description: "Add versions to those of the existing Product instead of replacing them."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "OrgUnit", EventsResource: "OrgUnitResource",
+		Fields:   []DocField{docID("orgUnitId"), docDisplayName, docName, docDescription, docAnnotations},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Group", EventsResource: "GroupResource",
+		Fields: []DocField{
+			docID("groupId"), docDisplayName, docName, docDescription,
+			{Name: "members", Type: "refs", Description: "Identities in the group."},
+			docRef("orgUnit", "OrgUnit"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Identity", EventsResource: "IdentityResource",
+		Fields: []DocField{
+			docID("identityId"), docDisplayName, docName, docDescription,
+			docRef("orgUnit", "OrgUnit"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "PermissionSpec", EventsResource: "PermissionSpecResource",
+		Fields:   []DocField{docID("permissionSpecId"), docDisplayName, docName, docDescription, docAnnotations},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "RoleSpec", EventsResource: "RoleSpecResource",
+		Fields: []DocField{
+			docID("roleSpecId"), docDisplayName, docName, docDescription,
+			{Name: "permissions", Type: "refs", Description: "PermissionSpecs of the role spec."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Permission", EventsResource: "PermissionResource",
+		Fields: []DocField{
+			docID("permissionId"), docDisplayName, docName, docDescription,
+			docRef("spec", "PermissionSpec"),
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"spec"}},
+	},
+	{
+		Kind: "Role", EventsResource: "RoleResource",
+		Fields: []DocField{
+			docID("roleId"), docDisplayName, docName, docDescription,
+			docRef("spec", "RoleSpec"), docRef("roleSpecId", "RoleSpec"),
+			docRef("context", "Context"), docRef("contextId", "Context"),
+			{Name: "permissions", Type: "refs", Description: "Permissions granted by the role."},
+			{Name: "resources", Type: "resourceRefs", Description: "Resources the role applies to."},
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"spec", "roleSpecId"}, {"context", "contextId"}},
+	},
+	{
+		Kind: "Binding", EventsResource: "BindingResource",
+		Fields: []DocField{
+			docID("bindingId"), docDisplayName, docName, docDescription,
+			docRef("role", "Role"), docRef("roleId", "Role"),
+			{Name: "subject", Type: "subject"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"role", "roleId"}, {"subject"}},
+	},
+	{
+		Kind: "FilterRule", EventsResource: "FilterRuleResource",
+		Fields: []DocField{
+			docID("ruleId"), docDisplayName, docName, docDescription,
+			{Name: "resourceType", Type: "string", Description: "Kind the rule applies to."},
+			{Name: "expression", Type: "string", Description: "CEL expression; makes the rule declarative."},
+			{Name: "action", Type: "string", Description: "Case-insensitive: none, drop or finding."},
+			{Name: "findingType", Type: "string"},
+			{Name: "message", Type: "string"},
+			{Name: "disabled", Type: "boolean"},
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "MergeRule", EventsResource: "MergeRuleResource",
+		Fields:   []DocField{docID("ruleId"), docDisplayName, docName, docDescription},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Capability", EventsResource: "CapabilityResource",
+		Fields: []DocField{
+			docID("capabilityId"), docDisplayName, docName,
+			{Name: "versions", Type: "capabilityVersions"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Parameter", EventsResource: "ParameterResource",
+		Fields: []DocField{
+			docID("parameterId"), docDisplayName, docName,
+			{Name: "values", Type: "strings"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "CapacityResourceType", EventsResource: "CapacityResourceTypeResource",
+		Fields: []DocField{
+			docID("capacityResourceTypeId"), docDisplayName, docName, docDescription,
+			{Name: "unit", Type: "string"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName},
+	},
+	{
+		Kind: "Capacity", EventsResource: "CapacityResource",
+		Fields: []DocField{
+			docID("capacityId"), docDisplayName, docName, docDescription,
+			{Name: "resourceTypeRef", Type: "capacityResourceTypeRef"},
+			{Name: "contextRef", Type: "contextRef"},
+			{Name: "category", Type: "string", Enum: []string{"requested", "provided", "consumed"}},
+			{Name: "amount", Type: "amount"},
+			docAnnotations,
+		},
+		Required: [][]string{requireName, {"resourceTypeRef"}, {"contextRef"}, {"category"}, {"amount"}},
+	},
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// schemaObject is a JSON Schema node; encoding/json sorts its keys, so the
+// generated files are stable.
+type schemaObject map[string]any
+
+func defRef(name string) schemaObject {
+	return schemaObject{"$ref": "#/$defs/" + name}
+}
+
+func closedObject(props schemaObject, required ...string) schemaObject {
+	out := schemaObject{"type": "object", "properties": props, "additionalProperties": false}
+	if len(required) > 0 {
+		out["required"] = required
+	}
+	return out
+}
+
+func arrayOf(items schemaObject) schemaObject {
+	return schemaObject{"type": "array", "items": items}
+}
+
+// documentDefs are the shared definitions DocField.Type may name.
+var documentDefs = map[string]schemaObject{
+	"uuid": {"type": "string", "format": "uuid"},
+	"ref": {
+		"description": "A UUID, a name or alias resolved against the file and the landscape, or {name: ...}.",
+		"anyOf": []schemaObject{
+			{"type": "string", "minLength": 1},
+			closedObject(schemaObject{"name": schemaObject{"type": "string", "minLength": 1}}, "name"),
+		},
+	},
+	"refs": arrayOf(defRef("ref")),
+	"apiRefs": arrayOf(schemaObject{"anyOf": []schemaObject{
+		defRef("ref"),
+		closedObject(schemaObject{"apiId": defRef("ref")}, "apiId"),
+	}}),
+	"annotations": {
+		"type":                 "object",
+		"additionalProperties": schemaObject{"type": []string{"string", "number", "boolean"}},
+	},
+	"time": {"type": "string", "format": "date-time"},
+	"version": closedObject(schemaObject{
+		"version":        schemaObject{"type": "string"},
+		"availableFrom":  defRef("time"),
+		"deprecatedFrom": defRef("time"),
+		"terminatedFrom": defRef("time"),
+		"retiredFrom":    defRef("time"),
+	}),
+	"resourceRefs": arrayOf(closedObject(schemaObject{
+		"resourceType": schemaObject{"type": "string"},
+		"resourceId":   defRef("ref"),
+		"name":         schemaObject{"type": "string"},
+	}, "resourceType")),
+	"subject": {
+		"type": "object",
+		"properties": schemaObject{
+			"groupId":    defRef("ref"),
+			"identityId": defRef("ref"),
+		},
+		"additionalProperties": false,
+		"oneOf": []schemaObject{
+			{"required": []string{"groupId"}},
+			{"required": []string{"identityId"}},
+		},
+	},
+	"productVersions": arrayOf(closedObject(schemaObject{
+		"availableFrom":  defRef("time"),
+		"deprecatedFrom": defRef("time"),
+		"terminatedFrom": defRef("time"),
+		"artefacts":      arrayOf(defRef("uuid")),
+	})),
+	"capabilityVersions": arrayOf(closedObject(schemaObject{
+		"capabilityVersionId": defRef("uuid"),
+		"version":             defRef("version"),
+	}, "capabilityVersionId")),
+	"strings": arrayOf(schemaObject{"type": "string"}),
+	"capacityResourceTypeRef": closedObject(schemaObject{
+		"capacityResourceTypeId": defRef("ref"),
+		"name":                   schemaObject{"type": "string"},
+	}),
+	"contextRef": closedObject(schemaObject{
+		"contextId": defRef("ref"),
+		"name":      schemaObject{"type": "string"},
+	}),
+	"amount": {"type": []string{"string", "number"}},
+}
+
+// defDeps lists the definitions each definition refers to.
+var defDeps = map[string][]string{
+	"refs":                    {"ref"},
+	"apiRefs":                 {"ref"},
+	"version":                 {"time"},
+	"resourceRefs":            {"ref"},
+	"subject":                 {"ref"},
+	"productVersions":         {"time", "uuid"},
+	"capabilityVersions":      {"uuid", "version", "time"},
+	"capacityResourceTypeRef": {"ref"},
+	"contextRef":              {"ref"},
+}
+
+// documentSchema builds the JSON Schema of a whole document of kind d.
+func documentSchema(d DocSpec) (schemaObject, error) {
+	props := schemaObject{}
+	defs := schemaObject{}
+	var addDef func(name string)
+	addDef = func(name string) {
+		if _, ok := defs[name]; ok {
+			return
+		}
+		defs[name] = documentDefs[name]
+		for _, dep := range defDeps[name] {
+			addDef(dep)
+		}
+	}
+	for _, f := range d.Fields {
+		var p schemaObject
+		switch f.Type {
+		case "string", "boolean", "number", "integer":
+			p = schemaObject{"type": f.Type}
+		default:
+			if _, ok := documentDefs[f.Type]; !ok {
+				return nil, fmt.Errorf("%s.%s: unknown field type %q", d.Kind, f.Name, f.Type)
+			}
+			addDef(f.Type)
+			p = defRef(f.Type)
+		}
+		if f.Description != "" {
+			p["description"] = f.Description
+		}
+		if len(f.Enum) > 0 {
+			p["enum"] = f.Enum
+		}
+		props[f.Name] = p
+	}
+
+	spec := closedObject(props)
+	var required []string
+	var alternatives []schemaObject
+	for _, group := range d.Required {
+		if len(group) == 1 {
+			required = append(required, group[0])
+			continue
+		}
+		anyOf := make([]schemaObject, 0, len(group))
+		for _, key := range group {
+			anyOf = append(anyOf, schemaObject{"required": []string{key}})
+		}
+		alternatives = append(alternatives, schemaObject{"anyOf": anyOf})
+	}
+	if len(required) > 0 {
+		spec["required"] = required
+	}
+	if len(alternatives) > 0 {
+		spec["allOf"] = alternatives
+	}
+
+	out := schemaObject{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   d.Kind + " document",
+		"type":    "object",
+		"properties": schemaObject{
+			"version": schemaObject{"type": "string", "pattern": `^emeland\.io/`},
+			"kind":    schemaObject{"const": d.Kind},
+			"aliases": schemaObject{
+				"description":          "Short names for the name references of the file's documents.",
+				"type":                 "object",
+				"additionalProperties": schemaObject{"type": "string"},
+			},
+			"spec": spec,
+		},
+		"required":             []string{"version", "kind", "spec"},
+		"additionalProperties": false,
+	}
+	if len(defs) > 0 {
+		out["$defs"] = defs
+	}
+	return out, nil
+}
+
+// writeDocumentSchemas writes one <Kind>.schema.json per document kind to dir
+// and removes schemas of kinds no longer listed.
+func writeDocumentSchemas(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, d := range documentSpecs {
+		schema, err := documentSchema(d)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(schema); err != nil {
+			return err
+		}
+		name := d.Kind + ".schema.json"
+		keep[name] = true
+		if err := os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+		fmt.Printf("Generated %s\n", filepath.Join(dir, name))
+	}
+	old, err := filepath.Glob(filepath.Join(dir, "*.schema.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range old {
+		if !keep[filepath.Base(path)] {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
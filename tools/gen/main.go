@@ -42,6 +42,9 @@ var convertFromTemplate string
 //go:embed convert_to.tmpl
 var convertToTemplate string
 
+//go:embed document_keys.tmpl
+var documentKeysTemplate string
+
 type convertGenData struct {
 	Imports []string
 	Specs   []convertGenSpec
@@ -219,6 +222,32 @@ func main() {
 	writeOapi("server_handlers_gen.go", serverHandlerTmpl, allTypes)
 	writeOapi("convert_from_wire_gen.go", convertFromTmpl, buildConvertFromGenData())
 	writeOapi("convert_dto_encode_gen.go", convertToTmpl, buildConvertToGenData())
+
+	// --- Generate landscape document schemas and strict-parse key lists ---
+	ingressDir := filepath.Clean(filepath.Join(filepath.Dir(genFile), "../../pkg/ingress"))
+	if err := writeDocumentSchemas(filepath.Join(ingressDir, "schemas")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing document schemas: %v\n", err)
+		os.Exit(1)
+	}
+	{
+		keysTmpl := template.Must(template.New("document_keys").Parse(documentKeysTemplate))
+		var buf bytes.Buffer
+		if err := keysTmpl.Execute(&buf, documentSpecs); err != nil {
+			fmt.Fprintf(os.Stderr, "Error executing document_keys template: %v\n", err)
+			os.Exit(1)
+		}
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error formatting document keys: %v\n%s\n", err, buf.String())
+			os.Exit(1)
+		}
+		filename := filepath.Join(ingressDir, "document_keys_gen.go")
+		if err := os.WriteFile(filename, formatted, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", filename, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Generated %s\n", filename)
+	}
 }
 
 type replicationEncodeGenData struct {
@@ -207,6 +207,7 @@ var allTypes = []TypeSpec{
 		Fields: []Field{
 			{Name: "DisplayName", Type: "string"},
 			{Name: "Description", Type: "string"},
+			{Name: "Severity", Type: "Severity"},
 			{Name: "Annotations", Type: "annotations.Annotations", HasAnnotations: true},
 		},
 		HasClientTest:           true,
@@ -534,6 +535,7 @@ var allTypes = []TypeSpec{
 			{Name: "Description", Type: "string"},
 			{Name: "TypeRef", Type: "*FindingTypeRef", SkipAccessor: true},
 			{Name: "Resources", Type: "[]*common.ResourceRef"},
+			{Name: "Triage", Type: "*Triage"},
 			{Name: "Annotations", Type: "annotations.Annotations", HasAnnotations: true},
 		},
 		HasClientTest:           true,
@@ -1121,6 +1123,12 @@ var allTypes = []TypeSpec{
 		Fields: []Field{
 			{Name: "DisplayName", Type: "string"},
 			{Name: "Description", Type: "string"},
+			{Name: "ResourceType", Type: "string"},
+			{Name: "Expression", Type: "string"},
+			{Name: "Action", Type: "Action"},
+			{Name: "FindingType", Type: "string"},
+			{Name: "Message", Type: "string"},
+			{Name: "Disabled", Type: "bool"},
 		},
 		HasClientTest:           true,
 		GenClientMethods:        true,